@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"connectrpc.com/connect"
+	pulsarv1 "github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1"
+	"github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1/v1connect"
+	"github.com/barkinrl/pulsar/internal/hoststats"
+)
+
+func main() {
+	// 0. Shutdown signal (SIGINT / SIGTERM)
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// 1. Config
+	serverURL := os.Getenv("PULSAR_URL")
+	if serverURL == "" {
+		serverURL = "http://localhost:8080"
+	}
+	token := os.Getenv("AGENT_TOKEN")
+	if token == "" {
+		log.Fatal("AGENT_TOKEN gerekli (RegisterAgent ile alınır)")
+	}
+	interval := 15 * time.Second
+	if v := os.Getenv("AGENT_INTERVAL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			log.Fatalf("Geçersiz AGENT_INTERVAL: %q", v)
+		}
+		interval = d
+	}
+
+	client := v1connect.NewMonitorServiceClient(&http.Client{Timeout: 10 * time.Second}, serverURL)
+	sampler := hoststats.NewSampler()
+	log.Printf("✅ Agent başladı, %s adresine her %s gönderiliyor", serverURL, interval)
+
+	// 2. Push loop
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Agent durduruluyor...")
+			return
+		case <-ticker.C:
+		}
+
+		req := connect.NewRequest(&pulsarv1.IngestSystemStatsRequest{
+			Sample: sampler.Sample().Raw(),
+		})
+		req.Header().Set("Authorization", "Bearer "+token)
+
+		if _, err := client.IngestSystemStats(ctx, req); err != nil {
+			if connect.CodeOf(err) == connect.CodeUnauthenticated {
+				log.Fatalf("🚨 Token reddedildi: %v", err)
+			}
+			// sample is dropped, the next tick tries again
+			log.Printf("⚠️ Gönderilemedi: %v", err)
+		}
+	}
+}
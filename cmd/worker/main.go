@@ -2,35 +2,35 @@ package main
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"log"
+	"net/http"
 	"os"
-	"runtime"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
 
+	"github.com/barkinrl/pulsar/internal/alerts"
 	"github.com/barkinrl/pulsar/internal/db"
+	"github.com/barkinrl/pulsar/internal/health"
+	"github.com/barkinrl/pulsar/internal/metrics"
+	"github.com/barkinrl/pulsar/internal/secrets"
+	"github.com/barkinrl/pulsar/internal/systemstats"
 	"github.com/barkinrl/pulsar/internal/worker"
 	"github.com/hibiken/asynq"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
-
-	// Gopsutil Packages for System Stats
-	"github.com/shirou/gopsutil/v3/cpu"
-	"github.com/shirou/gopsutil/v3/disk"
-	"github.com/shirou/gopsutil/v3/host"
-	"github.com/shirou/gopsutil/v3/mem"
-	"github.com/shirou/gopsutil/v3/net"
-	"github.com/shirou/gopsutil/v3/process"
-)
-
-
-var (
-	prevNetTime   time.Time
-	prevBytesRecv uint64
-	prevBytesSent uint64
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 )
 
 func main() {
+	// 0. Shutdown signal (SIGINT / SIGTERM)
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
 	// 1. DB Conn
 	dbUrl := os.Getenv("DATABASE_URL")
 	if dbUrl == "" {
@@ -50,7 +50,6 @@ func main() {
 	if err != nil {
 		log.Fatalf("Veritabanı havuzu oluşturulamadı: %v", err)
 	}
-	defer pool.Close()
 
 	queries := db.New(pool)
 	log.Println("✅ Worker veritabanı havuzuna (Pool) bağlandı")
@@ -69,12 +68,45 @@ func main() {
 		Addr: redisAddr,
 	})
 
+	// Background loops, stopped by the shutdown signal
+	var background sync.WaitGroup
+
 	// --- PART A: SCHEDULER ---
 	poller := worker.NewPoller(queries, asynqRedisOpt)
-	go poller.Start(context.Background(), 10*time.Second)
+	background.Add(1)
+	go func() {
+		defer background.Done()
+		poller.Start(ctx, 10*time.Second)
+	}()
 
 	// --- PART B: SYSTEM MONITOR  ---
-	go startSystemMonitor(queries, rdb)
+	metrics.RegisterSystemMetrics()
+	background.Add(1)
+	go func() {
+		defer background.Done()
+		systemstats.NewCollector(queries, rdb, 15*time.Second).Run(ctx)
+	}()
+
+	// --- PART B1: METRICS OF DELETED MONITORS ---
+	background.Add(1)
+	go func() {
+		defer background.Done()
+		worker.NewResultRecorder(queries, rdb).PruneMetrics(ctx, 5*time.Minute)
+	}()
+
+	// --- PART B2: INCIDENT NOTIFIER ---
+	instanceID := os.Getenv("INSTANCE_ID")
+	if instanceID == "" {
+		instanceID, _ = os.Hostname()
+	}
+	notifier := alerts.NewNotifier(os.Getenv("DISCORD_WEBHOOK_URL"))
+	background.Add(1)
+	go func() {
+		defer background.Done()
+		if err := notifier.Run(ctx, rdb, instanceID); err != nil {
+			log.Printf("⚠️ Notifier stopped: %v", err)
+		}
+	}()
 
 	// --- PART C: WORKER SERVER ---
 	srv := asynq.NewServer(
@@ -84,6 +116,8 @@ func main() {
 			Queues: map[string]int{
 				"default": 1,
 			},
+			// In-flight probes get this long to finish on shutdown
+			ShutdownTimeout: 20 * time.Second,
 			ErrorHandler: asynq.ErrorHandlerFunc(func(ctx context.Context, task *asynq.Task, err error) {
 				log.Printf("HATA: Task işlenirken sorun oluştu: %v", err)
 			}),
@@ -91,142 +125,101 @@ func main() {
 	)
 
 	mux := asynq.NewServeMux()
+	mux.Use(metrics.TaskMiddleware)
 
-	processor := worker.NewPingProcessor(queries, rdb)
-	mux.HandleFunc(worker.TypePingMonitor, processor.HandlePingTask)
-
-	log.Printf("👷 Worker Server started... (Redis: %s)", redisAddr)
-	if err := srv.Run(mux); err != nil {
-		log.Fatal(err)
-	}
-}
-
-// --- HELPER: Process States ---
-func getProcessStates() (total, running, sleeping, zombie int32) {
-	procs, err := process.Processes()
+	// Secrets referenced by monitor configs and credentials
+	keys, err := secrets.LoadKeyring()
 	if err != nil {
-		return int32(runtime.NumGoroutine()), int32(runtime.NumGoroutine()), 0, 0
+		log.Fatalf("Secrets anahtarı okunamadı: %v", err)
 	}
-
-	total = int32(len(procs))
-	for _, p := range procs {
-		status, err := p.Status() // []string 
-		if err != nil || len(status) == 0 {
-			continue
+	if keys == nil {
+		log.Println("⚠️ SECRETS_KEY yok: secret kullanan monitörler çalışmaz")
+	}
+	// Tasks the handlers schedule themselves (push deadlines)
+	taskClient := asynq.NewClient(asynqRedisOpt)
+	processor := worker.NewPingProcessor(queries, rdb, secrets.NewStore(queries, keys), taskClient)
+	mux.HandleFunc(worker.TypePingMonitor, processor.HandlePingTask)
+	mux.HandleFunc(worker.TypeICMPMonitor, processor.HandleICMPTask)
+	mux.HandleFunc(worker.TypePushMonitor, processor.HandlePushTask)
+	mux.HandleFunc(worker.TypeGRPCMonitor, processor.HandleGRPCTask)
+	mux.HandleFunc(worker.TypeScriptMonitor, processor.HandleScriptTask)
+
+	// --- PART D: METRICS & HEALTH SERVER ---
+	inspector := asynq.NewInspector(asynqRedisOpt)
+	metrics.RegisterQueueCollector(inspector)
+
+	checker := health.NewChecker()
+	checker.Register("postgres", pool.Ping)
+	checker.Register("redis", func(ctx context.Context) error { return rdb.Ping(ctx).Err() })
+	// srv.Ping only pings Redis (and is nil once the server is closed), so
+	// whether the server runs is tracked here
+	var serving atomic.Bool
+	checker.Register("asynq", func(ctx context.Context) error {
+		if !serving.Load() {
+			return errors.New("asynq server not running")
 		}
+		return srv.Ping()
+	})
+	checker.Register("poller", poller.Check)
 
-		// String karşılaştırması
-		switch status[0] {
-		case "R":
-			running++
-		case "S", "I":
-			sleeping++
-		case "Z", "T", "L":
-			zombie++
-		default:
-			sleeping++
-		}
+	adminPort := os.Getenv("ADMIN_PORT")
+	if adminPort == "" {
+		adminPort = "9090"
 	}
-	return
-}
+	adminMux := http.NewServeMux()
+	adminMux.Handle("/metrics", metrics.Handler())
+	checker.Mount(adminMux)
 
-// --- SYSTEM MONITOR ---
-func startSystemMonitor(queries *db.Queries, rdb *redis.Client) {
-	n, _ := net.IOCounters(false)
-	if len(n) > 0 {
-		prevBytesRecv = n[0].BytesRecv
-		prevBytesSent = n[0].BytesSent
-		prevNetTime = time.Now()
+	adminServer := &http.Server{
+		Addr:    "0.0.0.0:" + adminPort,
+		Handler: h2c.NewHandler(adminMux, &http2.Server{}),
 	}
-
-	ticker := time.NewTicker(15 * time.Second)
-	defer ticker.Stop()
-
-	const THREAD_ALARM_THRESHOLD = 3000
-
-	for range ticker.C {
-		// Verileri Topla
-		v, _ := mem.VirtualMemory()
-		c, _ := cpu.Percent(0, false)
-		d, _ := disk.Usage("/")
-		n, _ := net.IOCounters(false)
-		h, _ := host.Info() // Uptime and OS 
-
-		// Thread Analysis
-		tTotal, tRun, tSleep, tZombie := getProcessStates()
-
-		cpuVal := 0.0
-		if len(c) > 0 {
-			cpuVal = c[0]
+	go func() {
+		log.Printf("📈 Metrics available at http://0.0.0.0:%s/metrics", adminPort)
+		log.Printf("❤️ Health checks at http://0.0.0.0:%s/healthz and /readyz", adminPort)
+		if err := adminServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("⚠️ Admin server error: %v", err)
 		}
+	}()
 
-		// Network Hızı Hesaplama
-		networkSpeedKB := 0.0
-		if len(n) > 0 {
-			now := time.Now()
-			duration := now.Sub(prevNetTime).Seconds()
-			if duration > 0 {
-				deltaRecv := float64(n[0].BytesRecv - prevBytesRecv)
-				deltaSent := float64(n[0].BytesSent - prevBytesSent)
-				// (Byte / Sec) / 1024 => KB/s
-				networkSpeedKB = ((deltaRecv + deltaSent) / duration) / 1024
-			}
-			prevBytesRecv = n[0].BytesRecv
-			prevBytesSent = n[0].BytesSent
-			prevNetTime = now
-		}
+	log.Printf("👷 Worker Server started... (Redis: %s)", redisAddr)
+	if err := srv.Start(mux); err != nil {
+		log.Fatal(err)
+	}
+	serving.Store(true)
 
-		// 1. DB storage
-		ctx := context.Background()
-		_, err := queries.CreateSystemStat(ctx, db.CreateSystemStatParams{
-			CpuPercent:      cpuVal,
-			MemoryPercent:   v.UsedPercent,
-			DiskPercent:     d.UsedPercent,
-			NetKbS:          networkSpeedKB,
-			ThreadsTotal:    tTotal,
-			ThreadsRunning:  tRun,
-			ThreadsSleeping: tSleep,
-			ThreadsZombie:   tZombie,
-		})
-		if err != nil {
-			log.Printf("⚠️ System Stat DB Error: %v", err)
-		}
+	<-ctx.Done()
 
-		// 2. Redis Pub/Sub 
-		isWarning := tTotal > THREAD_ALARM_THRESHOLD
-
-		payload := map[string]interface{}{
-			"type": "system",
-			"data": map[string]interface{}{
-				"cpu": map[string]interface{}{
-					"percent": cpuVal,
-				},
-				"memory": map[string]interface{}{
-					"percent": v.UsedPercent,
-					"used":    float64(v.Used) / 1024 / 1024 / 1024,
-					"total":   float64(v.Total) / 1024 / 1024 / 1024,
-				},
-				"disk": map[string]interface{}{
-					"percent": d.UsedPercent,
-					"used":    float64(d.Used) / 1024 / 1024 / 1024,
-					"total":   float64(d.Total) / 1024 / 1024 / 1024,
-				},
-				"network": map[string]interface{}{
-					"used": networkSpeedKB,
-				},
-				"threads": map[string]interface{}{
-					"total":      tTotal,
-					"running":    tRun,
-					"sleeping":   tSleep,
-					"zombie":     tZombie,
-					"is_warning": isWarning,
-				},
-				"uptime": h.Uptime,
-				"os":     h.Platform,
-			},
-		}
+	// --- GRACEFUL SHUTDOWN ---
+	log.Println("🛑 Shutdown signal received, draining...")
+	checker.Drain()
+	// let load balancers notice /readyz failing before connections close
+	if d := health.DrainDelay(); d > 0 {
+		log.Printf("⏳ Waiting %s before shutting down...", d)
+		time.Sleep(d)
+	}
 
-		msgBytes, _ := json.Marshal(payload)
-		rdb.Publish(ctx, "pulsar:updates", msgBytes)
+	// 1. Stop producing work: poller, system monitor and notifier exit on ctx
+	background.Wait()
+	if err := poller.Close(); err != nil {
+		log.Printf("⚠️ Poller close error: %v", err)
 	}
-}
\ No newline at end of file
+
+	// 2. Let asynq finish in-flight tasks (up to ShutdownTimeout)
+	serving.Store(false)
+	srv.Shutdown()
+	inspector.Close()
+	taskClient.Close()
+
+	// 3. Admin server, then the pools
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := adminServer.Shutdown(shutdownCtx); err != nil {
+		log.Printf("⚠️ Admin server shutdown error: %v", err)
+	}
+	if err := rdb.Close(); err != nil {
+		log.Printf("⚠️ Redis close error: %v", err)
+	}
+	pool.Close()
+	log.Println("👋 Worker stopped")
+}
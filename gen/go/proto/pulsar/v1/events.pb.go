@@ -0,0 +1,242 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: proto/pulsar/v1/events.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Event, what the worker publishes on the pulsar:events Redis stream
+// (binary protobuf). schema_version only changes on breaking changes;
+// adding fields keeps it.
+type Event struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SchemaVersion uint32                 `protobuf:"varint,1,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	PublishedAt   int64                  `protobuf:"varint,2,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"` // unix ms
+	Seq           int64                  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`                                    // bus-wide, assigned by the publisher
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*Event_MonitorUpdate
+	//	*Event_System
+	//	*Event_Incident
+	//	*Event_ContentChange
+	Payload       isEvent_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_proto_pulsar_v1_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Event) GetSchemaVersion() uint32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *Event) GetPublishedAt() int64 {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return 0
+}
+
+func (x *Event) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *Event) GetPayload() isEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Event) GetMonitorUpdate() *MonitorUpdate {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_MonitorUpdate); ok {
+			return x.MonitorUpdate
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetSystem() *SystemStatsResponse {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_System); ok {
+			return x.System
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetIncident() *Incident {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_Incident); ok {
+			return x.Incident
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetContentChange() *ContentChange {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_ContentChange); ok {
+			return x.ContentChange
+		}
+	}
+	return nil
+}
+
+type isEvent_Payload interface {
+	isEvent_Payload()
+}
+
+type Event_MonitorUpdate struct {
+	MonitorUpdate *MonitorUpdate `protobuf:"bytes,10,opt,name=monitor_update,json=monitorUpdate,proto3,oneof"`
+}
+
+type Event_System struct {
+	System *SystemStatsResponse `protobuf:"bytes,11,opt,name=system,proto3,oneof"`
+}
+
+type Event_Incident struct {
+	Incident *Incident `protobuf:"bytes,12,opt,name=incident,proto3,oneof"` // opened or resolved
+}
+
+type Event_ContentChange struct {
+	ContentChange *ContentChange `protobuf:"bytes,13,opt,name=content_change,json=contentChange,proto3,oneof"`
+}
+
+func (*Event_MonitorUpdate) isEvent_Payload() {}
+
+func (*Event_System) isEvent_Payload() {}
+
+func (*Event_Incident) isEvent_Payload() {}
+
+func (*Event_ContentChange) isEvent_Payload() {}
+
+var File_proto_pulsar_v1_events_proto protoreflect.FileDescriptor
+
+const file_proto_pulsar_v1_events_proto_rawDesc = "" +
+	"\n" +
+	"\x1cproto/pulsar/v1/events.proto\x12\tpulsar.v1\x1a\x1dproto/pulsar/v1/monitor.proto\"\xe1\x02\n" +
+	"\x05Event\x12%\n" +
+	"\x0eschema_version\x18\x01 \x01(\rR\rschemaVersion\x12!\n" +
+	"\fpublished_at\x18\x02 \x01(\x03R\vpublishedAt\x12\x10\n" +
+	"\x03seq\x18\x03 \x01(\x03R\x03seq\x12A\n" +
+	"\x0emonitor_update\x18\n" +
+	" \x01(\v2\x18.pulsar.v1.MonitorUpdateH\x00R\rmonitorUpdate\x128\n" +
+	"\x06system\x18\v \x01(\v2\x1e.pulsar.v1.SystemStatsResponseH\x00R\x06system\x121\n" +
+	"\bincident\x18\f \x01(\v2\x13.pulsar.v1.IncidentH\x00R\bincident\x12A\n" +
+	"\x0econtent_change\x18\r \x01(\v2\x18.pulsar.v1.ContentChangeH\x00R\rcontentChangeB\t\n" +
+	"\apayloadB3Z1github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1b\x06proto3"
+
+var (
+	file_proto_pulsar_v1_events_proto_rawDescOnce sync.Once
+	file_proto_pulsar_v1_events_proto_rawDescData []byte
+)
+
+func file_proto_pulsar_v1_events_proto_rawDescGZIP() []byte {
+	file_proto_pulsar_v1_events_proto_rawDescOnce.Do(func() {
+		file_proto_pulsar_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_pulsar_v1_events_proto_rawDesc), len(file_proto_pulsar_v1_events_proto_rawDesc)))
+	})
+	return file_proto_pulsar_v1_events_proto_rawDescData
+}
+
+var file_proto_pulsar_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_pulsar_v1_events_proto_goTypes = []any{
+	(*Event)(nil),               // 0: pulsar.v1.Event
+	(*MonitorUpdate)(nil),       // 1: pulsar.v1.MonitorUpdate
+	(*SystemStatsResponse)(nil), // 2: pulsar.v1.SystemStatsResponse
+	(*Incident)(nil),            // 3: pulsar.v1.Incident
+	(*ContentChange)(nil),       // 4: pulsar.v1.ContentChange
+}
+var file_proto_pulsar_v1_events_proto_depIdxs = []int32{
+	1, // 0: pulsar.v1.Event.monitor_update:type_name -> pulsar.v1.MonitorUpdate
+	2, // 1: pulsar.v1.Event.system:type_name -> pulsar.v1.SystemStatsResponse
+	3, // 2: pulsar.v1.Event.incident:type_name -> pulsar.v1.Incident
+	4, // 3: pulsar.v1.Event.content_change:type_name -> pulsar.v1.ContentChange
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_proto_pulsar_v1_events_proto_init() }
+func file_proto_pulsar_v1_events_proto_init() {
+	if File_proto_pulsar_v1_events_proto != nil {
+		return
+	}
+	file_proto_pulsar_v1_monitor_proto_init()
+	file_proto_pulsar_v1_events_proto_msgTypes[0].OneofWrappers = []any{
+		(*Event_MonitorUpdate)(nil),
+		(*Event_System)(nil),
+		(*Event_Incident)(nil),
+		(*Event_ContentChange)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_pulsar_v1_events_proto_rawDesc), len(file_proto_pulsar_v1_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_pulsar_v1_events_proto_goTypes,
+		DependencyIndexes: file_proto_pulsar_v1_events_proto_depIdxs,
+		MessageInfos:      file_proto_pulsar_v1_events_proto_msgTypes,
+	}.Build()
+	File_proto_pulsar_v1_events_proto = out.File
+	file_proto_pulsar_v1_events_proto_goTypes = nil
+	file_proto_pulsar_v1_events_proto_depIdxs = nil
+}
@@ -9,7 +9,6 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -30,6 +29,15 @@ type Monitor struct {
 	IntervalSeconds int32                  `protobuf:"varint,3,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
 	IsActive        bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
 	LastCheck       int64                  `protobuf:"varint,5,opt,name=last_check,json=lastCheck,proto3" json:"last_check,omitempty"`
+	Type            string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`                      // "http", "icmp", "push", "grpc" or "script"
+	Icmp            *IcmpConfig            `protobuf:"bytes,7,opt,name=icmp,proto3" json:"icmp,omitempty"`                      // type icmp only
+	PushUrl         string                 `protobuf:"bytes,8,opt,name=push_url,json=pushUrl,proto3" json:"push_url,omitempty"` // type push only, where the job reports
+	Push            *PushConfig            `protobuf:"bytes,9,opt,name=push,proto3" json:"push,omitempty"`                      // type push only
+	Grpc            *GrpcConfig            `protobuf:"bytes,10,opt,name=grpc,proto3" json:"grpc,omitempty"`                     // type grpc only
+	Script          *ScriptConfig          `protobuf:"bytes,11,opt,name=script,proto3" json:"script,omitempty"`                 // type script only
+	Content         *ContentCheck          `protobuf:"bytes,12,opt,name=content,proto3" json:"content,omitempty"`               // type http only
+	Redirects       *RedirectPolicy        `protobuf:"bytes,13,opt,name=redirects,proto3" json:"redirects,omitempty"`           // type http only
+	Credentials     *CredentialsInfo       `protobuf:"bytes,14,opt,name=credentials,proto3" json:"credentials,omitempty"`       // what is set, never the secrets themselves
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -99,28 +107,94 @@ func (x *Monitor) GetLastCheck() int64 {
 	return 0
 }
 
-type CreateMonitorRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Url             string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	IntervalSeconds int32                  `protobuf:"varint,2,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *Monitor) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
-func (x *CreateMonitorRequest) Reset() {
-	*x = CreateMonitorRequest{}
+func (x *Monitor) GetIcmp() *IcmpConfig {
+	if x != nil {
+		return x.Icmp
+	}
+	return nil
+}
+
+func (x *Monitor) GetPushUrl() string {
+	if x != nil {
+		return x.PushUrl
+	}
+	return ""
+}
+
+func (x *Monitor) GetPush() *PushConfig {
+	if x != nil {
+		return x.Push
+	}
+	return nil
+}
+
+func (x *Monitor) GetGrpc() *GrpcConfig {
+	if x != nil {
+		return x.Grpc
+	}
+	return nil
+}
+
+func (x *Monitor) GetScript() *ScriptConfig {
+	if x != nil {
+		return x.Script
+	}
+	return nil
+}
+
+func (x *Monitor) GetContent() *ContentCheck {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *Monitor) GetRedirects() *RedirectPolicy {
+	if x != nil {
+		return x.Redirects
+	}
+	return nil
+}
+
+func (x *Monitor) GetCredentials() *CredentialsInfo {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+// ICMP monitors ping the host in url; zero values take the defaults.
+type IcmpConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`                                    // echo requests per check, default 5
+	TimeoutMs     int32                  `protobuf:"varint,2,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`           // per request, default 1000
+	DegradedLoss  float64                `protobuf:"fixed64,3,opt,name=degraded_loss,json=degradedLoss,proto3" json:"degraded_loss,omitempty"` // percent, DEGRADED from here, default 20
+	DownLoss      float64                `protobuf:"fixed64,4,opt,name=down_loss,json=downLoss,proto3" json:"down_loss,omitempty"`             // percent, DOWN from here, default 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IcmpConfig) Reset() {
+	*x = IcmpConfig{}
 	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMonitorRequest) String() string {
+func (x *IcmpConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMonitorRequest) ProtoMessage() {}
+func (*IcmpConfig) ProtoMessage() {}
 
-func (x *CreateMonitorRequest) ProtoReflect() protoreflect.Message {
+func (x *IcmpConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -132,46 +206,62 @@ func (x *CreateMonitorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMonitorRequest.ProtoReflect.Descriptor instead.
-func (*CreateMonitorRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use IcmpConfig.ProtoReflect.Descriptor instead.
+func (*IcmpConfig) Descriptor() ([]byte, []int) {
 	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CreateMonitorRequest) GetUrl() string {
+func (x *IcmpConfig) GetCount() int32 {
 	if x != nil {
-		return x.Url
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateMonitorRequest) GetIntervalSeconds() int32 {
+func (x *IcmpConfig) GetTimeoutMs() int32 {
 	if x != nil {
-		return x.IntervalSeconds
+		return x.TimeoutMs
 	}
 	return 0
 }
 
-type CreateMonitorResponse struct {
+func (x *IcmpConfig) GetDegradedLoss() float64 {
+	if x != nil {
+		return x.DegradedLoss
+	}
+	return 0
+}
+
+func (x *IcmpConfig) GetDownLoss() float64 {
+	if x != nil {
+		return x.DownLoss
+	}
+	return 0
+}
+
+// Push monitors wait for the job to call push_url at least once every
+// interval_seconds; they go DOWN grace_seconds after a missed heartbeat.
+type PushConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Monitor       *Monitor               `protobuf:"bytes,1,opt,name=monitor,proto3" json:"monitor,omitempty"`
+	GraceSeconds  int32                  `protobuf:"varint,1,opt,name=grace_seconds,json=graceSeconds,proto3" json:"grace_seconds,omitempty"` // default 60
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMonitorResponse) Reset() {
-	*x = CreateMonitorResponse{}
+func (x *PushConfig) Reset() {
+	*x = PushConfig{}
 	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMonitorResponse) String() string {
+func (x *PushConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMonitorResponse) ProtoMessage() {}
+func (*PushConfig) ProtoMessage() {}
 
-func (x *CreateMonitorResponse) ProtoReflect() protoreflect.Message {
+func (x *PushConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -183,38 +273,44 @@ func (x *CreateMonitorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMonitorResponse.ProtoReflect.Descriptor instead.
-func (*CreateMonitorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PushConfig.ProtoReflect.Descriptor instead.
+func (*PushConfig) Descriptor() ([]byte, []int) {
 	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateMonitorResponse) GetMonitor() *Monitor {
+func (x *PushConfig) GetGraceSeconds() int32 {
 	if x != nil {
-		return x.Monitor
+		return x.GraceSeconds
 	}
-	return nil
+	return 0
 }
 
-type ListMonitorsRequest struct {
+// How http monitors treat redirects. Breaking the policy makes the check
+// DOWN with a "REDIRECT: ..." status.
+type RedirectPolicy struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          string                 `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`                                      // "follow" (default) or "none": 3xx answers are the result and count as UP
+	MaxHops       int32                  `protobuf:"varint,2,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty"`                // default 10
+	RequireHttps  bool                   `protobuf:"varint,3,opt,name=require_https,json=requireHttps,proto3" json:"require_https,omitempty"` // no https -> http hop, and the final URL must be https
+	SameHost      bool                   `protobuf:"varint,4,opt,name=same_host,json=sameHost,proto3" json:"same_host,omitempty"`             // every hop stays on the monitor's host
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMonitorsRequest) Reset() {
-	*x = ListMonitorsRequest{}
+func (x *RedirectPolicy) Reset() {
+	*x = RedirectPolicy{}
 	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMonitorsRequest) String() string {
+func (x *RedirectPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMonitorsRequest) ProtoMessage() {}
+func (*RedirectPolicy) ProtoMessage() {}
 
-func (x *ListMonitorsRequest) ProtoReflect() protoreflect.Message {
+func (x *RedirectPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -226,32 +322,66 @@ func (x *ListMonitorsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMonitorsRequest.ProtoReflect.Descriptor instead.
-func (*ListMonitorsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RedirectPolicy.ProtoReflect.Descriptor instead.
+func (*RedirectPolicy) Descriptor() ([]byte, []int) {
 	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{3}
 }
 
-type ListMonitorsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Monitors      []*Monitor             `protobuf:"bytes,1,rep,name=monitors,proto3" json:"monitors,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RedirectPolicy) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
 }
 
-func (x *ListMonitorsResponse) Reset() {
-	*x = ListMonitorsResponse{}
+func (x *RedirectPolicy) GetMaxHops() int32 {
+	if x != nil {
+		return x.MaxHops
+	}
+	return 0
+}
+
+func (x *RedirectPolicy) GetRequireHttps() bool {
+	if x != nil {
+		return x.RequireHttps
+	}
+	return false
+}
+
+func (x *RedirectPolicy) GetSameHost() bool {
+	if x != nil {
+		return x.SameHost
+	}
+	return false
+}
+
+// Content change detection for http monitors: the worker reads the whole
+// body, keeps a normalized snapshot and publishes a ContentChange when it
+// differs from the last one.
+type ContentCheck struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Enabled         bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	MaxBytes        int32                  `protobuf:"varint,2,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`                     // body size cap, default 1 MiB
+	IgnoreSelectors []string               `protobuf:"bytes,3,rep,name=ignore_selectors,json=ignoreSelectors,proto3" json:"ignore_selectors,omitempty"` // HTML elements left out: tag, #id, .class, [attr=value]
+	IgnorePatterns  []string               `protobuf:"bytes,4,rep,name=ignore_patterns,json=ignorePatterns,proto3" json:"ignore_patterns,omitempty"`    // regexps cut out of the text
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ContentCheck) Reset() {
+	*x = ContentCheck{}
 	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMonitorsResponse) String() string {
+func (x *ContentCheck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMonitorsResponse) ProtoMessage() {}
+func (*ContentCheck) ProtoMessage() {}
 
-func (x *ListMonitorsResponse) ProtoReflect() protoreflect.Message {
+func (x *ContentCheck) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -263,39 +393,65 @@ func (x *ListMonitorsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMonitorsResponse.ProtoReflect.Descriptor instead.
-func (*ListMonitorsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ContentCheck.ProtoReflect.Descriptor instead.
+func (*ContentCheck) Descriptor() ([]byte, []int) {
 	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListMonitorsResponse) GetMonitors() []*Monitor {
+func (x *ContentCheck) GetEnabled() bool {
 	if x != nil {
-		return x.Monitors
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ContentCheck) GetMaxBytes() int32 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *ContentCheck) GetIgnoreSelectors() []string {
+	if x != nil {
+		return x.IgnoreSelectors
 	}
 	return nil
 }
 
-type DeleteMonitorRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MonitorId     string                 `protobuf:"bytes,1,opt,name=monitor_id,json=monitorId,proto3" json:"monitor_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ContentCheck) GetIgnorePatterns() []string {
+	if x != nil {
+		return x.IgnorePatterns
+	}
+	return nil
 }
 
-func (x *DeleteMonitorRequest) Reset() {
-	*x = DeleteMonitorRequest{}
+// gRPC monitors call grpc.health.v1.Health/Check on the host:port in url.
+// SERVING is UP, anything else DOWN.
+type GrpcConfig struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Service            string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"` // empty = the whole server
+	Tls                bool                   `protobuf:"varint,2,opt,name=tls,proto3" json:"tls,omitempty"`        // plaintext (h2c) otherwise
+	InsecureSkipVerify bool                   `protobuf:"varint,3,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3" json:"insecure_skip_verify,omitempty"`
+	TimeoutMs          int32                  `protobuf:"varint,4,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"` // default 5000
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GrpcConfig) Reset() {
+	*x = GrpcConfig{}
 	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMonitorRequest) String() string {
+func (x *GrpcConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMonitorRequest) ProtoMessage() {}
+func (*GrpcConfig) ProtoMessage() {}
 
-func (x *DeleteMonitorRequest) ProtoReflect() protoreflect.Message {
+func (x *GrpcConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -307,39 +463,64 @@ func (x *DeleteMonitorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMonitorRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMonitorRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GrpcConfig.ProtoReflect.Descriptor instead.
+func (*GrpcConfig) Descriptor() ([]byte, []int) {
 	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *DeleteMonitorRequest) GetMonitorId() string {
+func (x *GrpcConfig) GetService() string {
 	if x != nil {
-		return x.MonitorId
+		return x.Service
 	}
 	return ""
 }
 
-type DeleteMonitorResponse struct {
+func (x *GrpcConfig) GetTls() bool {
+	if x != nil {
+		return x.Tls
+	}
+	return false
+}
+
+func (x *GrpcConfig) GetInsecureSkipVerify() bool {
+	if x != nil {
+		return x.InsecureSkipVerify
+	}
+	return false
+}
+
+func (x *GrpcConfig) GetTimeoutMs() int32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+// Script monitors run HTTP steps in order with one cookie jar, like a user
+// going through login -> search -> checkout. The check is DOWN at the first
+// step that fails.
+type ScriptConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Steps         []*ScriptStep          `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+	TimeoutMs     int32                  `protobuf:"varint,2,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"` // whole script, default 30000
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMonitorResponse) Reset() {
-	*x = DeleteMonitorResponse{}
+func (x *ScriptConfig) Reset() {
+	*x = ScriptConfig{}
 	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMonitorResponse) String() string {
+func (x *ScriptConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMonitorResponse) ProtoMessage() {}
+func (*ScriptConfig) ProtoMessage() {}
 
-func (x *DeleteMonitorResponse) ProtoReflect() protoreflect.Message {
+func (x *ScriptConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -351,39 +532,55 @@ func (x *DeleteMonitorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMonitorResponse.ProtoReflect.Descriptor instead.
-func (*DeleteMonitorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScriptConfig.ProtoReflect.Descriptor instead.
+func (*ScriptConfig) Descriptor() ([]byte, []int) {
 	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *DeleteMonitorResponse) GetSuccess() bool {
+func (x *ScriptConfig) GetSteps() []*ScriptStep {
 	if x != nil {
-		return x.Success
+		return x.Steps
 	}
-	return false
+	return nil
 }
 
-type GetMonitorStatsRequest struct {
+func (x *ScriptConfig) GetTimeoutMs() int32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+// {{name}} in url, headers and body is replaced with an extracted variable,
+// {{secret:<id>}} with a secret. Credential headers (Authorization, Cookie,
+// API keys...) only take secrets.
+type ScriptStep struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MonitorId     string                 `protobuf:"bytes,1,opt,name=monitor_id,json=monitorId,proto3" json:"monitor_id,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"` // default GET
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Headers       map[string]string      `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	Extract       []*ScriptExtract       `protobuf:"bytes,6,rep,name=extract,proto3" json:"extract,omitempty"`
+	Assert        []*ScriptAssert        `protobuf:"bytes,7,rep,name=assert,proto3" json:"assert,omitempty"` // none = the status must be 2xx
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMonitorStatsRequest) Reset() {
-	*x = GetMonitorStatsRequest{}
+func (x *ScriptStep) Reset() {
+	*x = ScriptStep{}
 	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMonitorStatsRequest) String() string {
+func (x *ScriptStep) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMonitorStatsRequest) ProtoMessage() {}
+func (*ScriptStep) ProtoMessage() {}
 
-func (x *GetMonitorStatsRequest) ProtoReflect() protoreflect.Message {
+func (x *ScriptStep) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -395,39 +592,83 @@ func (x *GetMonitorStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMonitorStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetMonitorStatsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScriptStep.ProtoReflect.Descriptor instead.
+func (*ScriptStep) Descriptor() ([]byte, []int) {
 	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetMonitorStatsRequest) GetMonitorId() string {
+func (x *ScriptStep) GetName() string {
 	if x != nil {
-		return x.MonitorId
+		return x.Name
 	}
 	return ""
 }
 
-type GetMonitorStatsResponse struct {
+func (x *ScriptStep) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ScriptStep) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ScriptStep) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *ScriptStep) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *ScriptStep) GetExtract() []*ScriptExtract {
+	if x != nil {
+		return x.Extract
+	}
+	return nil
+}
+
+func (x *ScriptStep) GetAssert() []*ScriptAssert {
+	if x != nil {
+		return x.Assert
+	}
+	return nil
+}
+
+type ScriptExtract struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Stats         []*MonitorStat         `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	Var           string                 `protobuf:"bytes,1,opt,name=var,proto3" json:"var,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"` // "json", "header" or "cookie"
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"` // dotted JSON path (items.0.id), header or cookie name
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMonitorStatsResponse) Reset() {
-	*x = GetMonitorStatsResponse{}
+func (x *ScriptExtract) Reset() {
+	*x = ScriptExtract{}
 	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMonitorStatsResponse) String() string {
+func (x *ScriptExtract) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMonitorStatsResponse) ProtoMessage() {}
+func (*ScriptExtract) ProtoMessage() {}
 
-func (x *GetMonitorStatsResponse) ProtoReflect() protoreflect.Message {
+func (x *ScriptExtract) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -439,44 +680,56 @@ func (x *GetMonitorStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMonitorStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetMonitorStatsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScriptExtract.ProtoReflect.Descriptor instead.
+func (*ScriptExtract) Descriptor() ([]byte, []int) {
 	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetMonitorStatsResponse) GetStats() []*MonitorStat {
+func (x *ScriptExtract) GetVar() string {
 	if x != nil {
-		return x.Stats
+		return x.Var
 	}
-	return nil
+	return ""
 }
 
-// Tek bir ping işleminin detaylı sonucu
-type MonitorStat struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Latency       int32                  `protobuf:"varint,1,opt,name=latency,proto3" json:"latency,omitempty"` // Toplam süre (ms)
-	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`       // HTTP Status Code (200, 404, 500...)
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`    // Status Text (OK, DOWN...)
-	Time          string                 `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`        // Zaman damgası (ISO String)
-	Timing        *MonitorTiming         `protobuf:"bytes,5,opt,name=timing,proto3" json:"timing,omitempty"`    // Waterfall detayları
+func (x *ScriptExtract) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ScriptExtract) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type ScriptAssert struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"` // "status", "header", "json" or "body"
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`     // header name or JSON path
+	Op            string                 `protobuf:"bytes,3,opt,name=op,proto3" json:"op,omitempty"`         // "eq" (default), "ne", "contains", "exists", "lt", "gt"
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MonitorStat) Reset() {
-	*x = MonitorStat{}
+func (x *ScriptAssert) Reset() {
+	*x = ScriptAssert{}
 	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MonitorStat) String() string {
+func (x *ScriptAssert) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MonitorStat) ProtoMessage() {}
+func (*ScriptAssert) ProtoMessage() {}
 
-func (x *MonitorStat) ProtoReflect() protoreflect.Message {
+func (x *ScriptAssert) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -488,72 +741,69 @@ func (x *MonitorStat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MonitorStat.ProtoReflect.Descriptor instead.
-func (*MonitorStat) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScriptAssert.ProtoReflect.Descriptor instead.
+func (*ScriptAssert) Descriptor() ([]byte, []int) {
 	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *MonitorStat) GetLatency() int32 {
-	if x != nil {
-		return x.Latency
-	}
-	return 0
-}
-
-func (x *MonitorStat) GetCode() int32 {
+func (x *ScriptAssert) GetSource() string {
 	if x != nil {
-		return x.Code
+		return x.Source
 	}
-	return 0
+	return ""
 }
 
-func (x *MonitorStat) GetStatus() string {
+func (x *ScriptAssert) GetPath() string {
 	if x != nil {
-		return x.Status
+		return x.Path
 	}
 	return ""
 }
 
-func (x *MonitorStat) GetTime() string {
+func (x *ScriptAssert) GetOp() string {
 	if x != nil {
-		return x.Time
+		return x.Op
 	}
 	return ""
 }
 
-func (x *MonitorStat) GetTiming() *MonitorTiming {
+func (x *ScriptAssert) GetValue() string {
 	if x != nil {
-		return x.Timing
+		return x.Value
 	}
-	return nil
+	return ""
 }
 
-// Waterfall grafiği için detaylı süreler
-type MonitorTiming struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Dns           int32                  `protobuf:"varint,1,opt,name=dns,proto3" json:"dns,omitempty"`
-	Tcp           int32                  `protobuf:"varint,2,opt,name=tcp,proto3" json:"tcp,omitempty"`
-	Tls           int32                  `protobuf:"varint,3,opt,name=tls,proto3" json:"tls,omitempty"`
-	Ttfb          int32                  `protobuf:"varint,4,opt,name=ttfb,proto3" json:"ttfb,omitempty"`
-	Download      int32                  `protobuf:"varint,5,opt,name=download,proto3" json:"download,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CreateMonitorRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Url             string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	IntervalSeconds int32                  `protobuf:"varint,2,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	Type            string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // empty = "http"
+	Icmp            *IcmpConfig            `protobuf:"bytes,4,opt,name=icmp,proto3" json:"icmp,omitempty"`
+	Push            *PushConfig            `protobuf:"bytes,5,opt,name=push,proto3" json:"push,omitempty"`
+	Grpc            *GrpcConfig            `protobuf:"bytes,6,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	Script          *ScriptConfig          `protobuf:"bytes,7,opt,name=script,proto3" json:"script,omitempty"`
+	Content         *ContentCheck          `protobuf:"bytes,8,opt,name=content,proto3" json:"content,omitempty"`
+	Redirects       *RedirectPolicy        `protobuf:"bytes,9,opt,name=redirects,proto3" json:"redirects,omitempty"`
+	Credentials     *ProbeCredentials      `protobuf:"bytes,10,opt,name=credentials,proto3" json:"credentials,omitempty"` // http, script and grpc
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *MonitorTiming) Reset() {
-	*x = MonitorTiming{}
+func (x *CreateMonitorRequest) Reset() {
+	*x = CreateMonitorRequest{}
 	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MonitorTiming) String() string {
+func (x *CreateMonitorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MonitorTiming) ProtoMessage() {}
+func (*CreateMonitorRequest) ProtoMessage() {}
 
-func (x *MonitorTiming) ProtoReflect() protoreflect.Message {
+func (x *CreateMonitorRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -565,73 +815,109 @@ func (x *MonitorTiming) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MonitorTiming.ProtoReflect.Descriptor instead.
-func (*MonitorTiming) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateMonitorRequest.ProtoReflect.Descriptor instead.
+func (*CreateMonitorRequest) Descriptor() ([]byte, []int) {
 	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *MonitorTiming) GetDns() int32 {
+func (x *CreateMonitorRequest) GetUrl() string {
 	if x != nil {
-		return x.Dns
+		return x.Url
 	}
-	return 0
+	return ""
 }
 
-func (x *MonitorTiming) GetTcp() int32 {
+func (x *CreateMonitorRequest) GetIntervalSeconds() int32 {
 	if x != nil {
-		return x.Tcp
+		return x.IntervalSeconds
 	}
 	return 0
 }
 
-func (x *MonitorTiming) GetTls() int32 {
+func (x *CreateMonitorRequest) GetType() string {
 	if x != nil {
-		return x.Tls
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *MonitorTiming) GetTtfb() int32 {
+func (x *CreateMonitorRequest) GetIcmp() *IcmpConfig {
 	if x != nil {
-		return x.Ttfb
+		return x.Icmp
 	}
-	return 0
+	return nil
 }
 
-func (x *MonitorTiming) GetDownload() int32 {
+func (x *CreateMonitorRequest) GetPush() *PushConfig {
 	if x != nil {
-		return x.Download
+		return x.Push
 	}
-	return 0
+	return nil
 }
 
-// --- SİSTEM İSTATİSTİKLERİ ---
-type SystemStatsResponse struct {
+func (x *CreateMonitorRequest) GetGrpc() *GrpcConfig {
+	if x != nil {
+		return x.Grpc
+	}
+	return nil
+}
+
+func (x *CreateMonitorRequest) GetScript() *ScriptConfig {
+	if x != nil {
+		return x.Script
+	}
+	return nil
+}
+
+func (x *CreateMonitorRequest) GetContent() *ContentCheck {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *CreateMonitorRequest) GetRedirects() *RedirectPolicy {
+	if x != nil {
+		return x.Redirects
+	}
+	return nil
+}
+
+func (x *CreateMonitorRequest) GetCredentials() *ProbeCredentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+// How the worker reaches the target: through a proxy and/or with a client
+// certificate (mTLS). Write-only, responses carry a CredentialsInfo. The
+// client key and the proxy password are sealed into secrets; any field may
+// also refer to an existing one as {{secret:<id>}}.
+type ProbeCredentials struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cpu           *ResourceUsage         `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
-	Memory        *ResourceUsage         `protobuf:"bytes,2,opt,name=memory,proto3" json:"memory,omitempty"`
-	Disk          *ResourceUsage         `protobuf:"bytes,3,opt,name=disk,proto3" json:"disk,omitempty"`
-	Network       *ResourceUsage         `protobuf:"bytes,4,opt,name=network,proto3" json:"network,omitempty"`
-	Threads       *ThreadUsage           `protobuf:"bytes,5,opt,name=threads,proto3" json:"threads,omitempty"`
-	Info          *SystemInfo            `protobuf:"bytes,6,opt,name=info,proto3" json:"info,omitempty"`
+	ProxyUrl      string                 `protobuf:"bytes,1,opt,name=proxy_url,json=proxyUrl,proto3" json:"proxy_url,omitempty"`       // http://, https:// or socks5://, user:password@ allowed
+	ClientCert    string                 `protobuf:"bytes,2,opt,name=client_cert,json=clientCert,proto3" json:"client_cert,omitempty"` // PEM, with client_key
+	ClientKey     string                 `protobuf:"bytes,3,opt,name=client_key,json=clientKey,proto3" json:"client_key,omitempty"`    // PEM
+	CaBundle      string                 `protobuf:"bytes,4,opt,name=ca_bundle,json=caBundle,proto3" json:"ca_bundle,omitempty"`       // PEM, trusted next to the system roots
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SystemStatsResponse) Reset() {
-	*x = SystemStatsResponse{}
+func (x *ProbeCredentials) Reset() {
+	*x = ProbeCredentials{}
 	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SystemStatsResponse) String() string {
+func (x *ProbeCredentials) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemStatsResponse) ProtoMessage() {}
+func (*ProbeCredentials) ProtoMessage() {}
 
-func (x *SystemStatsResponse) ProtoReflect() protoreflect.Message {
+func (x *ProbeCredentials) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -643,80 +929,4119 @@ func (x *SystemStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemStatsResponse.ProtoReflect.Descriptor instead.
-func (*SystemStatsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProbeCredentials.ProtoReflect.Descriptor instead.
+func (*ProbeCredentials) Descriptor() ([]byte, []int) {
 	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SystemStatsResponse) GetCpu() *ResourceUsage {
+func (x *ProbeCredentials) GetProxyUrl() string {
 	if x != nil {
-		return x.Cpu
+		return x.ProxyUrl
 	}
-	return nil
+	return ""
 }
 
-func (x *SystemStatsResponse) GetMemory() *ResourceUsage {
+func (x *ProbeCredentials) GetClientCert() string {
 	if x != nil {
-		return x.Memory
+		return x.ClientCert
 	}
-	return nil
+	return ""
 }
 
-func (x *SystemStatsResponse) GetDisk() *ResourceUsage {
+func (x *ProbeCredentials) GetClientKey() string {
 	if x != nil {
-		return x.Disk
+		return x.ClientKey
 	}
-	return nil
+	return ""
 }
 
-func (x *SystemStatsResponse) GetNetwork() *ResourceUsage {
+func (x *ProbeCredentials) GetCaBundle() string {
 	if x != nil {
-		return x.Network
+		return x.CaBundle
+	}
+	return ""
+}
+
+type CredentialsInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProxyUrl          string                 `protobuf:"bytes,1,opt,name=proxy_url,json=proxyUrl,proto3" json:"proxy_url,omitempty"` // password masked
+	ClientCert        bool                   `protobuf:"varint,2,opt,name=client_cert,json=clientCert,proto3" json:"client_cert,omitempty"`
+	ClientCertSubject string                 `protobuf:"bytes,3,opt,name=client_cert_subject,json=clientCertSubject,proto3" json:"client_cert_subject,omitempty"`
+	ClientCertExpires int64                  `protobuf:"varint,4,opt,name=client_cert_expires,json=clientCertExpires,proto3" json:"client_cert_expires,omitempty"` // unix seconds
+	CaBundle          bool                   `protobuf:"varint,5,opt,name=ca_bundle,json=caBundle,proto3" json:"ca_bundle,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CredentialsInfo) Reset() {
+	*x = CredentialsInfo{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CredentialsInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CredentialsInfo) ProtoMessage() {}
+
+func (x *CredentialsInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CredentialsInfo.ProtoReflect.Descriptor instead.
+func (*CredentialsInfo) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CredentialsInfo) GetProxyUrl() string {
+	if x != nil {
+		return x.ProxyUrl
+	}
+	return ""
+}
+
+func (x *CredentialsInfo) GetClientCert() bool {
+	if x != nil {
+		return x.ClientCert
+	}
+	return false
+}
+
+func (x *CredentialsInfo) GetClientCertSubject() string {
+	if x != nil {
+		return x.ClientCertSubject
+	}
+	return ""
+}
+
+func (x *CredentialsInfo) GetClientCertExpires() int64 {
+	if x != nil {
+		return x.ClientCertExpires
+	}
+	return 0
+}
+
+func (x *CredentialsInfo) GetCaBundle() bool {
+	if x != nil {
+		return x.CaBundle
+	}
+	return false
+}
+
+type CreateMonitorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Monitor       *Monitor               `protobuf:"bytes,1,opt,name=monitor,proto3" json:"monitor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMonitorResponse) Reset() {
+	*x = CreateMonitorResponse{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMonitorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMonitorResponse) ProtoMessage() {}
+
+func (x *CreateMonitorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMonitorResponse.ProtoReflect.Descriptor instead.
+func (*CreateMonitorResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateMonitorResponse) GetMonitor() *Monitor {
+	if x != nil {
+		return x.Monitor
 	}
 	return nil
 }
 
-func (x *SystemStatsResponse) GetThreads() *ThreadUsage {
-	if x != nil {
-		return x.Threads
-	}
-	return nil
+type ListMonitorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMonitorsRequest) Reset() {
+	*x = ListMonitorsRequest{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMonitorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMonitorsRequest) ProtoMessage() {}
+
+func (x *ListMonitorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMonitorsRequest.ProtoReflect.Descriptor instead.
+func (*ListMonitorsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{14}
+}
+
+type ListMonitorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Monitors      []*Monitor             `protobuf:"bytes,1,rep,name=monitors,proto3" json:"monitors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMonitorsResponse) Reset() {
+	*x = ListMonitorsResponse{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMonitorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMonitorsResponse) ProtoMessage() {}
+
+func (x *ListMonitorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMonitorsResponse.ProtoReflect.Descriptor instead.
+func (*ListMonitorsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListMonitorsResponse) GetMonitors() []*Monitor {
+	if x != nil {
+		return x.Monitors
+	}
+	return nil
+}
+
+type DeleteMonitorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MonitorId     string                 `protobuf:"bytes,1,opt,name=monitor_id,json=monitorId,proto3" json:"monitor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMonitorRequest) Reset() {
+	*x = DeleteMonitorRequest{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMonitorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMonitorRequest) ProtoMessage() {}
+
+func (x *DeleteMonitorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMonitorRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMonitorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteMonitorRequest) GetMonitorId() string {
+	if x != nil {
+		return x.MonitorId
+	}
+	return ""
+}
+
+type DeleteMonitorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMonitorResponse) Reset() {
+	*x = DeleteMonitorResponse{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMonitorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMonitorResponse) ProtoMessage() {}
+
+func (x *DeleteMonitorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMonitorResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMonitorResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteMonitorResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SetMonitorCredentialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MonitorId     string                 `protobuf:"bytes,1,opt,name=monitor_id,json=monitorId,proto3" json:"monitor_id,omitempty"`
+	Credentials   *ProbeCredentials      `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMonitorCredentialsRequest) Reset() {
+	*x = SetMonitorCredentialsRequest{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMonitorCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMonitorCredentialsRequest) ProtoMessage() {}
+
+func (x *SetMonitorCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMonitorCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*SetMonitorCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetMonitorCredentialsRequest) GetMonitorId() string {
+	if x != nil {
+		return x.MonitorId
+	}
+	return ""
+}
+
+func (x *SetMonitorCredentialsRequest) GetCredentials() *ProbeCredentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+type SetMonitorCredentialsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Monitor       *Monitor               `protobuf:"bytes,1,opt,name=monitor,proto3" json:"monitor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMonitorCredentialsResponse) Reset() {
+	*x = SetMonitorCredentialsResponse{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMonitorCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMonitorCredentialsResponse) ProtoMessage() {}
+
+func (x *SetMonitorCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMonitorCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*SetMonitorCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetMonitorCredentialsResponse) GetMonitor() *Monitor {
+	if x != nil {
+		return x.Monitor
+	}
+	return nil
+}
+
+type GetMonitorStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MonitorId     string                 `protobuf:"bytes,1,opt,name=monitor_id,json=monitorId,proto3" json:"monitor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMonitorStatsRequest) Reset() {
+	*x = GetMonitorStatsRequest{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMonitorStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMonitorStatsRequest) ProtoMessage() {}
+
+func (x *GetMonitorStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMonitorStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetMonitorStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetMonitorStatsRequest) GetMonitorId() string {
+	if x != nil {
+		return x.MonitorId
+	}
+	return ""
+}
+
+type GetMonitorStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         []*MonitorStat         `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMonitorStatsResponse) Reset() {
+	*x = GetMonitorStatsResponse{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMonitorStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMonitorStatsResponse) ProtoMessage() {}
+
+func (x *GetMonitorStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMonitorStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetMonitorStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetMonitorStatsResponse) GetStats() []*MonitorStat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// Tek bir ping işleminin detaylı sonucu
+type MonitorStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latency       int32                  `protobuf:"varint,1,opt,name=latency,proto3" json:"latency,omitempty"` // Toplam süre (ms)
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`       // HTTP Status Code (200, 404, 500...)
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`    // Status Text (OK, DOWN...)
+	Time          string                 `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`        // Zaman damgası (ISO String)
+	Timing        *MonitorTiming         `protobuf:"bytes,5,opt,name=timing,proto3" json:"timing,omitempty"`    // Waterfall detayları
+	Icmp          *IcmpStats             `protobuf:"bytes,6,opt,name=icmp,proto3" json:"icmp,omitempty"`           // icmp monitors only
+	Steps         []*StepResult          `protobuf:"bytes,7,rep,name=steps,proto3" json:"steps,omitempty"`         // script monitors only
+	Redirects     []*RedirectHop         `protobuf:"bytes,8,rep,name=redirects,proto3" json:"redirects,omitempty"` // http monitors, when redirected
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonitorStat) Reset() {
+	*x = MonitorStat{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonitorStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonitorStat) ProtoMessage() {}
+
+func (x *MonitorStat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonitorStat.ProtoReflect.Descriptor instead.
+func (*MonitorStat) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MonitorStat) GetLatency() int32 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+func (x *MonitorStat) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *MonitorStat) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MonitorStat) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *MonitorStat) GetTiming() *MonitorTiming {
+	if x != nil {
+		return x.Timing
+	}
+	return nil
+}
+
+func (x *MonitorStat) GetIcmp() *IcmpStats {
+	if x != nil {
+		return x.Icmp
+	}
+	return nil
+}
+
+func (x *MonitorStat) GetSteps() []*StepResult {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *MonitorStat) GetRedirects() []*RedirectHop {
+	if x != nil {
+		return x.Redirects
+	}
+	return nil
+}
+
+// Live check results, same feed as the "monitor_update" WebSocket messages
+type WatchMonitorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MonitorIds    []string               `protobuf:"bytes,1,rep,name=monitor_ids,json=monitorIds,proto3" json:"monitor_ids,omitempty"` // empty = all monitors
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchMonitorsRequest) Reset() {
+	*x = WatchMonitorsRequest{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchMonitorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchMonitorsRequest) ProtoMessage() {}
+
+func (x *WatchMonitorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchMonitorsRequest.ProtoReflect.Descriptor instead.
+func (*WatchMonitorsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *WatchMonitorsRequest) GetMonitorIds() []string {
+	if x != nil {
+		return x.MonitorIds
+	}
+	return nil
+}
+
+type MonitorUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MonitorId     string                 `protobuf:"bytes,1,opt,name=monitor_id,json=monitorId,proto3" json:"monitor_id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Code          int32                  `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	Latency       int32                  `protobuf:"varint,5,opt,name=latency,proto3" json:"latency,omitempty"` // ms
+	Timing        *MonitorTiming         `protobuf:"bytes,6,opt,name=timing,proto3" json:"timing,omitempty"`
+	Time          string                 `protobuf:"bytes,7,opt,name=time,proto3" json:"time,omitempty"`            // RFC3339
+	Icmp          *IcmpStats             `protobuf:"bytes,8,opt,name=icmp,proto3" json:"icmp,omitempty"`            // icmp monitors only
+	Steps         []*StepResult          `protobuf:"bytes,9,rep,name=steps,proto3" json:"steps,omitempty"`          // script monitors only
+	Redirects     []*RedirectHop         `protobuf:"bytes,10,rep,name=redirects,proto3" json:"redirects,omitempty"` // http monitors, when redirected
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonitorUpdate) Reset() {
+	*x = MonitorUpdate{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonitorUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonitorUpdate) ProtoMessage() {}
+
+func (x *MonitorUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonitorUpdate.ProtoReflect.Descriptor instead.
+func (*MonitorUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MonitorUpdate) GetMonitorId() string {
+	if x != nil {
+		return x.MonitorId
+	}
+	return ""
+}
+
+func (x *MonitorUpdate) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MonitorUpdate) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MonitorUpdate) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *MonitorUpdate) GetLatency() int32 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+func (x *MonitorUpdate) GetTiming() *MonitorTiming {
+	if x != nil {
+		return x.Timing
+	}
+	return nil
+}
+
+func (x *MonitorUpdate) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *MonitorUpdate) GetIcmp() *IcmpStats {
+	if x != nil {
+		return x.Icmp
+	}
+	return nil
+}
+
+func (x *MonitorUpdate) GetSteps() []*StepResult {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *MonitorUpdate) GetRedirects() []*RedirectHop {
+	if x != nil {
+		return x.Redirects
+	}
+	return nil
+}
+
+type IcmpStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RttMin        float64                `protobuf:"fixed64,1,opt,name=rtt_min,json=rttMin,proto3" json:"rtt_min,omitempty"` // ms
+	RttAvg        float64                `protobuf:"fixed64,2,opt,name=rtt_avg,json=rttAvg,proto3" json:"rtt_avg,omitempty"`
+	RttMax        float64                `protobuf:"fixed64,3,opt,name=rtt_max,json=rttMax,proto3" json:"rtt_max,omitempty"`
+	Jitter        float64                `protobuf:"fixed64,4,opt,name=jitter,proto3" json:"jitter,omitempty"`                           // ms, mean difference between consecutive replies
+	PacketLoss    float64                `protobuf:"fixed64,5,opt,name=packet_loss,json=packetLoss,proto3" json:"packet_loss,omitempty"` // percent
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IcmpStats) Reset() {
+	*x = IcmpStats{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IcmpStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IcmpStats) ProtoMessage() {}
+
+func (x *IcmpStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IcmpStats.ProtoReflect.Descriptor instead.
+func (*IcmpStats) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *IcmpStats) GetRttMin() float64 {
+	if x != nil {
+		return x.RttMin
+	}
+	return 0
+}
+
+func (x *IcmpStats) GetRttAvg() float64 {
+	if x != nil {
+		return x.RttAvg
+	}
+	return 0
+}
+
+func (x *IcmpStats) GetRttMax() float64 {
+	if x != nil {
+		return x.RttMax
+	}
+	return 0
+}
+
+func (x *IcmpStats) GetJitter() float64 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+func (x *IcmpStats) GetPacketLoss() float64 {
+	if x != nil {
+		return x.PacketLoss
+	}
+	return 0
+}
+
+// One request of a redirect chain, the monitor's own URL first.
+type RedirectHop struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Code          int32                  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`       // 0 when the request failed
+	Latency       int32                  `protobuf:"varint,3,opt,name=latency,proto3" json:"latency,omitempty"` // ms, until the response headers
+	Timing        *MonitorTiming         `protobuf:"bytes,4,opt,name=timing,proto3" json:"timing,omitempty"`    // download is always 0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedirectHop) Reset() {
+	*x = RedirectHop{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedirectHop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedirectHop) ProtoMessage() {}
+
+func (x *RedirectHop) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedirectHop.ProtoReflect.Descriptor instead.
+func (*RedirectHop) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RedirectHop) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *RedirectHop) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RedirectHop) GetLatency() int32 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+func (x *RedirectHop) GetTiming() *MonitorTiming {
+	if x != nil {
+		return x.Timing
+	}
+	return nil
+}
+
+// The page of a monitor changed since its last snapshot.
+type ContentChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MonitorId     string                 `protobuf:"bytes,1,opt,name=monitor_id,json=monitorId,proto3" json:"monitor_id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	OldHash       string                 `protobuf:"bytes,3,opt,name=old_hash,json=oldHash,proto3" json:"old_hash,omitempty"`
+	NewHash       string                 `protobuf:"bytes,4,opt,name=new_hash,json=newHash,proto3" json:"new_hash,omitempty"` // sha256 of the normalized content
+	Added         int32                  `protobuf:"varint,5,opt,name=added,proto3" json:"added,omitempty"`                   // lines
+	Removed       int32                  `protobuf:"varint,6,opt,name=removed,proto3" json:"removed,omitempty"`
+	Diff          string                 `protobuf:"bytes,7,opt,name=diff,proto3" json:"diff,omitempty"` // unified, previous -> current
+	Time          string                 `protobuf:"bytes,8,opt,name=time,proto3" json:"time,omitempty"` // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContentChange) Reset() {
+	*x = ContentChange{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentChange) ProtoMessage() {}
+
+func (x *ContentChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentChange.ProtoReflect.Descriptor instead.
+func (*ContentChange) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ContentChange) GetMonitorId() string {
+	if x != nil {
+		return x.MonitorId
+	}
+	return ""
+}
+
+func (x *ContentChange) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ContentChange) GetOldHash() string {
+	if x != nil {
+		return x.OldHash
+	}
+	return ""
+}
+
+func (x *ContentChange) GetNewHash() string {
+	if x != nil {
+		return x.NewHash
+	}
+	return ""
+}
+
+func (x *ContentChange) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *ContentChange) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+func (x *ContentChange) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+func (x *ContentChange) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+// One step of a script check; the steps after a failed one are not run.
+type StepResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Code          int32                  `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`       // 0 when the request failed
+	Latency       int32                  `protobuf:"varint,5,opt,name=latency,proto3" json:"latency,omitempty"` // ms
+	Timing        *MonitorTiming         `protobuf:"bytes,6,opt,name=timing,proto3" json:"timing,omitempty"`
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"` // empty = passed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StepResult) Reset() {
+	*x = StepResult{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StepResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepResult) ProtoMessage() {}
+
+func (x *StepResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepResult.ProtoReflect.Descriptor instead.
+func (*StepResult) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *StepResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StepResult) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *StepResult) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *StepResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *StepResult) GetLatency() int32 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+func (x *StepResult) GetTiming() *MonitorTiming {
+	if x != nil {
+		return x.Timing
+	}
+	return nil
+}
+
+func (x *StepResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Waterfall grafiği için detaylı süreler
+type MonitorTiming struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dns           int32                  `protobuf:"varint,1,opt,name=dns,proto3" json:"dns,omitempty"`
+	Tcp           int32                  `protobuf:"varint,2,opt,name=tcp,proto3" json:"tcp,omitempty"`
+	Tls           int32                  `protobuf:"varint,3,opt,name=tls,proto3" json:"tls,omitempty"`
+	Ttfb          int32                  `protobuf:"varint,4,opt,name=ttfb,proto3" json:"ttfb,omitempty"`
+	Download      int32                  `protobuf:"varint,5,opt,name=download,proto3" json:"download,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonitorTiming) Reset() {
+	*x = MonitorTiming{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonitorTiming) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonitorTiming) ProtoMessage() {}
+
+func (x *MonitorTiming) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonitorTiming.ProtoReflect.Descriptor instead.
+func (*MonitorTiming) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *MonitorTiming) GetDns() int32 {
+	if x != nil {
+		return x.Dns
+	}
+	return 0
+}
+
+func (x *MonitorTiming) GetTcp() int32 {
+	if x != nil {
+		return x.Tcp
+	}
+	return 0
+}
+
+func (x *MonitorTiming) GetTls() int32 {
+	if x != nil {
+		return x.Tls
+	}
+	return 0
+}
+
+func (x *MonitorTiming) GetTtfb() int32 {
+	if x != nil {
+		return x.Ttfb
+	}
+	return 0
+}
+
+func (x *MonitorTiming) GetDownload() int32 {
+	if x != nil {
+		return x.Download
+	}
+	return 0
+}
+
+type GetSystemStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"` // empty = the worker's own host
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSystemStatsRequest) Reset() {
+	*x = GetSystemStatsRequest{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSystemStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemStatsRequest) ProtoMessage() {}
+
+func (x *GetSystemStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetSystemStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetSystemStatsRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+type GetSystemStatsHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`      // empty = the worker's own host
+	From          int64                  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`     // unix seconds, 0 = a day before to
+	To            int64                  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`         // unix seconds, 0 = now
+	Points        int32                  `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"` // wanted number of buckets, 0 = 300
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSystemStatsHistoryRequest) Reset() {
+	*x = GetSystemStatsHistoryRequest{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSystemStatsHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemStatsHistoryRequest) ProtoMessage() {}
+
+func (x *GetSystemStatsHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemStatsHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetSystemStatsHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetSystemStatsHistoryRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *GetSystemStatsHistoryRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *GetSystemStatsHistoryRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *GetSystemStatsHistoryRequest) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+// Downsampled history: one entry per bucket that has samples, so series can
+// have gaps and are shorter than points.
+type GetSystemStatsHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BucketSeconds int64                  `protobuf:"varint,1,opt,name=bucket_seconds,json=bucketSeconds,proto3" json:"bucket_seconds,omitempty"`
+	Times         []string               `protobuf:"bytes,2,rep,name=times,proto3" json:"times,omitempty"`     // RFC3339, bucket starts
+	Cpu           *StatSeries            `protobuf:"bytes,3,opt,name=cpu,proto3" json:"cpu,omitempty"`         // percent
+	Memory        *StatSeries            `protobuf:"bytes,4,opt,name=memory,proto3" json:"memory,omitempty"`   // percent
+	Disk          *StatSeries            `protobuf:"bytes,5,opt,name=disk,proto3" json:"disk,omitempty"`       // percent
+	Network       *StatSeries            `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"` // KB/s
+	Threads       *StatSeries            `protobuf:"bytes,7,opt,name=threads,proto3" json:"threads,omitempty"` // total processes
+	Load1         *StatSeries            `protobuf:"bytes,8,opt,name=load1,proto3" json:"load1,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSystemStatsHistoryResponse) Reset() {
+	*x = GetSystemStatsHistoryResponse{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSystemStatsHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemStatsHistoryResponse) ProtoMessage() {}
+
+func (x *GetSystemStatsHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemStatsHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetSystemStatsHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetSystemStatsHistoryResponse) GetBucketSeconds() int64 {
+	if x != nil {
+		return x.BucketSeconds
+	}
+	return 0
+}
+
+func (x *GetSystemStatsHistoryResponse) GetTimes() []string {
+	if x != nil {
+		return x.Times
+	}
+	return nil
+}
+
+func (x *GetSystemStatsHistoryResponse) GetCpu() *StatSeries {
+	if x != nil {
+		return x.Cpu
+	}
+	return nil
+}
+
+func (x *GetSystemStatsHistoryResponse) GetMemory() *StatSeries {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+func (x *GetSystemStatsHistoryResponse) GetDisk() *StatSeries {
+	if x != nil {
+		return x.Disk
+	}
+	return nil
+}
+
+func (x *GetSystemStatsHistoryResponse) GetNetwork() *StatSeries {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+func (x *GetSystemStatsHistoryResponse) GetThreads() *StatSeries {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+func (x *GetSystemStatsHistoryResponse) GetLoad1() *StatSeries {
+	if x != nil {
+		return x.Load1
+	}
+	return nil
+}
+
+type StatSeries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           []float64              `protobuf:"fixed64,1,rep,packed,name=min,proto3" json:"min,omitempty"`
+	Avg           []float64              `protobuf:"fixed64,2,rep,packed,name=avg,proto3" json:"avg,omitempty"`
+	Max           []float64              `protobuf:"fixed64,3,rep,packed,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatSeries) Reset() {
+	*x = StatSeries{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatSeries) ProtoMessage() {}
+
+func (x *StatSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatSeries.ProtoReflect.Descriptor instead.
+func (*StatSeries) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *StatSeries) GetMin() []float64 {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
+
+func (x *StatSeries) GetAvg() []float64 {
+	if x != nil {
+		return x.Avg
+	}
+	return nil
+}
+
+func (x *StatSeries) GetMax() []float64 {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+// --- SİSTEM İSTATİSTİKLERİ ---
+type SystemStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cpu           *ResourceUsage         `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory        *ResourceUsage         `protobuf:"bytes,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	Disk          *ResourceUsage         `protobuf:"bytes,3,opt,name=disk,proto3" json:"disk,omitempty"`
+	Network       *ResourceUsage         `protobuf:"bytes,4,opt,name=network,proto3" json:"network,omitempty"`
+	Threads       *ThreadUsage           `protobuf:"bytes,5,opt,name=threads,proto3" json:"threads,omitempty"`
+	Info          *SystemInfo            `protobuf:"bytes,6,opt,name=info,proto3" json:"info,omitempty"`
+	Disks         []*DiskUsage           `protobuf:"bytes,7,rep,name=disks,proto3" json:"disks,omitempty"` // per mountpoint
+	Interfaces    []*InterfaceUsage      `protobuf:"bytes,8,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	CpuCores      []float64              `protobuf:"fixed64,9,rep,packed,name=cpu_cores,json=cpuCores,proto3" json:"cpu_cores,omitempty"` // percent, per core
+	Load          *LoadAverage           `protobuf:"bytes,10,opt,name=load,proto3" json:"load,omitempty"`
+	TopCpu        []*ProcessInfo         `protobuf:"bytes,11,rep,name=top_cpu,json=topCpu,proto3" json:"top_cpu,omitempty"`
+	TopMemory     []*ProcessInfo         `protobuf:"bytes,12,rep,name=top_memory,json=topMemory,proto3" json:"top_memory,omitempty"` // by RSS
+	Host          string                 `protobuf:"bytes,13,opt,name=host,proto3" json:"host,omitempty"`                            // "local" = the worker's own host
+	Cgroups       []*CgroupUsage         `protobuf:"bytes,14,rep,name=cgroups,proto3" json:"cgroups,omitempty"`                      // by memory
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemStatsResponse) Reset() {
+	*x = SystemStatsResponse{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemStatsResponse) ProtoMessage() {}
+
+func (x *SystemStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemStatsResponse.ProtoReflect.Descriptor instead.
+func (*SystemStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SystemStatsResponse) GetCpu() *ResourceUsage {
+	if x != nil {
+		return x.Cpu
+	}
+	return nil
+}
+
+func (x *SystemStatsResponse) GetMemory() *ResourceUsage {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+func (x *SystemStatsResponse) GetDisk() *ResourceUsage {
+	if x != nil {
+		return x.Disk
+	}
+	return nil
+}
+
+func (x *SystemStatsResponse) GetNetwork() *ResourceUsage {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+func (x *SystemStatsResponse) GetThreads() *ThreadUsage {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+func (x *SystemStatsResponse) GetInfo() *SystemInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *SystemStatsResponse) GetDisks() []*DiskUsage {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
+
+func (x *SystemStatsResponse) GetInterfaces() []*InterfaceUsage {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+func (x *SystemStatsResponse) GetCpuCores() []float64 {
+	if x != nil {
+		return x.CpuCores
+	}
+	return nil
+}
+
+func (x *SystemStatsResponse) GetLoad() *LoadAverage {
+	if x != nil {
+		return x.Load
+	}
+	return nil
+}
+
+func (x *SystemStatsResponse) GetTopCpu() []*ProcessInfo {
+	if x != nil {
+		return x.TopCpu
+	}
+	return nil
+}
+
+func (x *SystemStatsResponse) GetTopMemory() []*ProcessInfo {
+	if x != nil {
+		return x.TopMemory
+	}
+	return nil
+}
+
+func (x *SystemStatsResponse) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *SystemStatsResponse) GetCgroups() []*CgroupUsage {
+	if x != nil {
+		return x.Cgroups
+	}
+	return nil
+}
+
+type ProcessInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pid           int32                  `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	User          string                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	State         string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Threads       int32                  `protobuf:"varint,5,opt,name=threads,proto3" json:"threads,omitempty"`
+	CpuPercent    float64                `protobuf:"fixed64,6,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
+	RssBytes      uint64                 `protobuf:"varint,7,opt,name=rss_bytes,json=rssBytes,proto3" json:"rss_bytes,omitempty"`
+	MemoryPercent float64                `protobuf:"fixed64,8,opt,name=memory_percent,json=memoryPercent,proto3" json:"memory_percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessInfo) Reset() {
+	*x = ProcessInfo{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessInfo) ProtoMessage() {}
+
+func (x *ProcessInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessInfo.ProtoReflect.Descriptor instead.
+func (*ProcessInfo) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ProcessInfo) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProcessInfo) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ProcessInfo) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ProcessInfo) GetThreads() int32 {
+	if x != nil {
+		return x.Threads
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetRssBytes() uint64 {
+	if x != nil {
+		return x.RssBytes
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetMemoryPercent() float64 {
+	if x != nil {
+		return x.MemoryPercent
+	}
+	return 0
+}
+
+type GetProcessSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	At            int64                  `protobuf:"varint,1,opt,name=at,proto3" json:"at,omitempty"`    // unix seconds, 0 = latest
+	Host          string                 `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"` // empty = the worker's own host
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProcessSnapshotRequest) Reset() {
+	*x = GetProcessSnapshotRequest{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProcessSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProcessSnapshotRequest) ProtoMessage() {}
+
+func (x *GetProcessSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProcessSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*GetProcessSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetProcessSnapshotRequest) GetAt() int64 {
+	if x != nil {
+		return x.At
+	}
+	return 0
+}
+
+func (x *GetProcessSnapshotRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+type GetProcessSnapshotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`           // RFC3339, when the snapshot was taken
+	Processes     []*ProcessInfo         `protobuf:"bytes,2,rep,name=processes,proto3" json:"processes,omitempty"` // by RSS
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProcessSnapshotResponse) Reset() {
+	*x = GetProcessSnapshotResponse{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProcessSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProcessSnapshotResponse) ProtoMessage() {}
+
+func (x *GetProcessSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProcessSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*GetProcessSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetProcessSnapshotResponse) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *GetProcessSnapshotResponse) GetProcesses() []*ProcessInfo {
+	if x != nil {
+		return x.Processes
+	}
+	return nil
+}
+
+// One cgroup v2 group: a container, a systemd service...
+type CgroupUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`                                  // relative to the cgroup root
+	ContainerId   string                 `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"` // short id, empty if it's not a container
+	CpuPercent    float64                `protobuf:"fixed64,3,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`  // 100 = one full core
+	CpuLimit      float64                `protobuf:"fixed64,4,opt,name=cpu_limit,json=cpuLimit,proto3" json:"cpu_limit,omitempty"`        // cores, 0 = unlimited
+	MemoryBytes   uint64                 `protobuf:"varint,5,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	MemoryLimit   uint64                 `protobuf:"varint,6,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"` // 0 = unlimited
+	IoReadKbs     float64                `protobuf:"fixed64,7,opt,name=io_read_kbs,json=ioReadKbs,proto3" json:"io_read_kbs,omitempty"`
+	IoWriteKbs    float64                `protobuf:"fixed64,8,opt,name=io_write_kbs,json=ioWriteKbs,proto3" json:"io_write_kbs,omitempty"`
+	Pids          int32                  `protobuf:"varint,9,opt,name=pids,proto3" json:"pids,omitempty"`
+	PidsLimit     int32                  `protobuf:"varint,10,opt,name=pids_limit,json=pidsLimit,proto3" json:"pids_limit,omitempty"` // 0 = unlimited
+	History       []*CgroupPoint         `protobuf:"bytes,11,rep,name=history,proto3" json:"history,omitempty"`                       // only in GetCgroupStats
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CgroupUsage) Reset() {
+	*x = CgroupUsage{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CgroupUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupUsage) ProtoMessage() {}
+
+func (x *CgroupUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupUsage.ProtoReflect.Descriptor instead.
+func (*CgroupUsage) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CgroupUsage) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CgroupUsage) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *CgroupUsage) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *CgroupUsage) GetCpuLimit() float64 {
+	if x != nil {
+		return x.CpuLimit
+	}
+	return 0
+}
+
+func (x *CgroupUsage) GetMemoryBytes() uint64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *CgroupUsage) GetMemoryLimit() uint64 {
+	if x != nil {
+		return x.MemoryLimit
+	}
+	return 0
+}
+
+func (x *CgroupUsage) GetIoReadKbs() float64 {
+	if x != nil {
+		return x.IoReadKbs
+	}
+	return 0
+}
+
+func (x *CgroupUsage) GetIoWriteKbs() float64 {
+	if x != nil {
+		return x.IoWriteKbs
+	}
+	return 0
+}
+
+func (x *CgroupUsage) GetPids() int32 {
+	if x != nil {
+		return x.Pids
+	}
+	return 0
+}
+
+func (x *CgroupUsage) GetPidsLimit() int32 {
+	if x != nil {
+		return x.PidsLimit
+	}
+	return 0
+}
+
+func (x *CgroupUsage) GetHistory() []*CgroupPoint {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type CgroupPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"` // RFC3339
+	CpuPercent    float64                `protobuf:"fixed64,2,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
+	MemoryBytes   uint64                 `protobuf:"varint,3,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	IoReadKbs     float64                `protobuf:"fixed64,4,opt,name=io_read_kbs,json=ioReadKbs,proto3" json:"io_read_kbs,omitempty"`
+	IoWriteKbs    float64                `protobuf:"fixed64,5,opt,name=io_write_kbs,json=ioWriteKbs,proto3" json:"io_write_kbs,omitempty"`
+	Pids          int32                  `protobuf:"varint,6,opt,name=pids,proto3" json:"pids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CgroupPoint) Reset() {
+	*x = CgroupPoint{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CgroupPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupPoint) ProtoMessage() {}
+
+func (x *CgroupPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupPoint.ProtoReflect.Descriptor instead.
+func (*CgroupPoint) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CgroupPoint) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *CgroupPoint) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *CgroupPoint) GetMemoryBytes() uint64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *CgroupPoint) GetIoReadKbs() float64 {
+	if x != nil {
+		return x.IoReadKbs
+	}
+	return 0
+}
+
+func (x *CgroupPoint) GetIoWriteKbs() float64 {
+	if x != nil {
+		return x.IoWriteKbs
+	}
+	return 0
+}
+
+func (x *CgroupPoint) GetPids() int32 {
+	if x != nil {
+		return x.Pids
+	}
+	return 0
+}
+
+type GetCgroupStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`    // empty = the worker's own host
+	Since         int64                  `protobuf:"varint,2,opt,name=since,proto3" json:"since,omitempty"` // unix seconds, 0 = the last hour
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCgroupStatsRequest) Reset() {
+	*x = GetCgroupStatsRequest{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCgroupStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCgroupStatsRequest) ProtoMessage() {}
+
+func (x *GetCgroupStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCgroupStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCgroupStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetCgroupStatsRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *GetCgroupStatsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+type GetCgroupStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cgroups       []*CgroupUsage         `protobuf:"bytes,1,rep,name=cgroups,proto3" json:"cgroups,omitempty"` // latest values, by memory
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCgroupStatsResponse) Reset() {
+	*x = GetCgroupStatsResponse{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCgroupStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCgroupStatsResponse) ProtoMessage() {}
+
+func (x *GetCgroupStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCgroupStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCgroupStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetCgroupStatsResponse) GetCgroups() []*CgroupUsage {
+	if x != nil {
+		return x.Cgroups
+	}
+	return nil
+}
+
+type DiskUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mountpoint    string                 `protobuf:"bytes,1,opt,name=mountpoint,proto3" json:"mountpoint,omitempty"`
+	Device        string                 `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	Fstype        string                 `protobuf:"bytes,3,opt,name=fstype,proto3" json:"fstype,omitempty"`
+	Used          float64                `protobuf:"fixed64,4,opt,name=used,proto3" json:"used,omitempty"`   // GB
+	Total         float64                `protobuf:"fixed64,5,opt,name=total,proto3" json:"total,omitempty"` // GB
+	Percent       float64                `protobuf:"fixed64,6,opt,name=percent,proto3" json:"percent,omitempty"`
+	ReadKbs       float64                `protobuf:"fixed64,7,opt,name=read_kbs,json=readKbs,proto3" json:"read_kbs,omitempty"`
+	WriteKbs      float64                `protobuf:"fixed64,8,opt,name=write_kbs,json=writeKbs,proto3" json:"write_kbs,omitempty"`
+	IsWarning     bool                   `protobuf:"varint,9,opt,name=is_warning,json=isWarning,proto3" json:"is_warning,omitempty"`
+	History       []float64              `protobuf:"fixed64,10,rep,packed,name=history,proto3" json:"history,omitempty"` // percent
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiskUsage) Reset() {
+	*x = DiskUsage{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiskUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskUsage) ProtoMessage() {}
+
+func (x *DiskUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskUsage.ProtoReflect.Descriptor instead.
+func (*DiskUsage) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DiskUsage) GetMountpoint() string {
+	if x != nil {
+		return x.Mountpoint
+	}
+	return ""
+}
+
+func (x *DiskUsage) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *DiskUsage) GetFstype() string {
+	if x != nil {
+		return x.Fstype
+	}
+	return ""
+}
+
+func (x *DiskUsage) GetUsed() float64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetReadKbs() float64 {
+	if x != nil {
+		return x.ReadKbs
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetWriteKbs() float64 {
+	if x != nil {
+		return x.WriteKbs
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetIsWarning() bool {
+	if x != nil {
+		return x.IsWarning
+	}
+	return false
+}
+
+func (x *DiskUsage) GetHistory() []float64 {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type InterfaceUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RxKbs         float64                `protobuf:"fixed64,2,opt,name=rx_kbs,json=rxKbs,proto3" json:"rx_kbs,omitempty"`
+	TxKbs         float64                `protobuf:"fixed64,3,opt,name=tx_kbs,json=txKbs,proto3" json:"tx_kbs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InterfaceUsage) Reset() {
+	*x = InterfaceUsage{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterfaceUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterfaceUsage) ProtoMessage() {}
+
+func (x *InterfaceUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterfaceUsage.ProtoReflect.Descriptor instead.
+func (*InterfaceUsage) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *InterfaceUsage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InterfaceUsage) GetRxKbs() float64 {
+	if x != nil {
+		return x.RxKbs
+	}
+	return 0
+}
+
+func (x *InterfaceUsage) GetTxKbs() float64 {
+	if x != nil {
+		return x.TxKbs
+	}
+	return 0
+}
+
+type LoadAverage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Load1         float64                `protobuf:"fixed64,1,opt,name=load1,proto3" json:"load1,omitempty"`
+	Load5         float64                `protobuf:"fixed64,2,opt,name=load5,proto3" json:"load5,omitempty"`
+	Load15        float64                `protobuf:"fixed64,3,opt,name=load15,proto3" json:"load15,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadAverage) Reset() {
+	*x = LoadAverage{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadAverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadAverage) ProtoMessage() {}
+
+func (x *LoadAverage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadAverage.ProtoReflect.Descriptor instead.
+func (*LoadAverage) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *LoadAverage) GetLoad1() float64 {
+	if x != nil {
+		return x.Load1
+	}
+	return 0
+}
+
+func (x *LoadAverage) GetLoad5() float64 {
+	if x != nil {
+		return x.Load5
+	}
+	return 0
+}
+
+func (x *LoadAverage) GetLoad15() float64 {
+	if x != nil {
+		return x.Load15
+	}
+	return 0
+}
+
+type ThreadUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Running       int32                  `protobuf:"varint,2,opt,name=running,proto3" json:"running,omitempty"`
+	Sleeping      int32                  `protobuf:"varint,3,opt,name=sleeping,proto3" json:"sleeping,omitempty"`
+	Zombie        int32                  `protobuf:"varint,4,opt,name=zombie,proto3" json:"zombie,omitempty"`
+	IsWarning     bool                   `protobuf:"varint,5,opt,name=is_warning,json=isWarning,proto3" json:"is_warning,omitempty"`
+	History       []*ThreadHistory       `protobuf:"bytes,6,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ThreadUsage) Reset() {
+	*x = ThreadUsage{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThreadUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadUsage) ProtoMessage() {}
+
+func (x *ThreadUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadUsage.ProtoReflect.Descriptor instead.
+func (*ThreadUsage) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ThreadUsage) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ThreadUsage) GetRunning() int32 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
+}
+
+func (x *ThreadUsage) GetSleeping() int32 {
+	if x != nil {
+		return x.Sleeping
+	}
+	return 0
+}
+
+func (x *ThreadUsage) GetZombie() int32 {
+	if x != nil {
+		return x.Zombie
+	}
+	return 0
+}
+
+func (x *ThreadUsage) GetIsWarning() bool {
+	if x != nil {
+		return x.IsWarning
+	}
+	return false
+}
+
+func (x *ThreadUsage) GetHistory() []*ThreadHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type ThreadHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Running       int32                  `protobuf:"varint,1,opt,name=running,proto3" json:"running,omitempty"`
+	Sleeping      int32                  `protobuf:"varint,2,opt,name=sleeping,proto3" json:"sleeping,omitempty"`
+	Zombie        int32                  `protobuf:"varint,3,opt,name=zombie,proto3" json:"zombie,omitempty"`
+	Time          string                 `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ThreadHistory) Reset() {
+	*x = ThreadHistory{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThreadHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadHistory) ProtoMessage() {}
+
+func (x *ThreadHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadHistory.ProtoReflect.Descriptor instead.
+func (*ThreadHistory) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ThreadHistory) GetRunning() int32 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
+}
+
+func (x *ThreadHistory) GetSleeping() int32 {
+	if x != nil {
+		return x.Sleeping
+	}
+	return 0
+}
+
+func (x *ThreadHistory) GetZombie() int32 {
+	if x != nil {
+		return x.Zombie
+	}
+	return 0
+}
+
+func (x *ThreadHistory) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type ResourceUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Used          float64                `protobuf:"fixed64,1,opt,name=used,proto3" json:"used,omitempty"`
+	Total         float64                `protobuf:"fixed64,2,opt,name=total,proto3" json:"total,omitempty"`
+	Percent       float64                `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent,omitempty"`
+	Unit          string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	IsWarning     bool                   `protobuf:"varint,5,opt,name=is_warning,json=isWarning,proto3" json:"is_warning,omitempty"`
+	History       []float64              `protobuf:"fixed64,6,rep,packed,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceUsage) Reset() {
+	*x = ResourceUsage{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceUsage) ProtoMessage() {}
+
+func (x *ResourceUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceUsage.ProtoReflect.Descriptor instead.
+func (*ResourceUsage) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ResourceUsage) GetUsed() float64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *ResourceUsage) GetIsWarning() bool {
+	if x != nil {
+		return x.IsWarning
+	}
+	return false
+}
+
+func (x *ResourceUsage) GetHistory() []float64 {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type SystemInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Hostname        string                 `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Os              string                 `protobuf:"bytes,2,opt,name=os,proto3" json:"os,omitempty"`
+	UptimeSeconds   uint64                 `protobuf:"varint,3,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	Platform        string                 `protobuf:"bytes,4,opt,name=platform,proto3" json:"platform,omitempty"`
+	PlatformVersion string                 `protobuf:"bytes,5,opt,name=platform_version,json=platformVersion,proto3" json:"platform_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SystemInfo) Reset() {
+	*x = SystemInfo{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemInfo) ProtoMessage() {}
+
+func (x *SystemInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemInfo.ProtoReflect.Descriptor instead.
+func (*SystemInfo) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SystemInfo) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetUptimeSeconds() uint64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+func (x *SystemInfo) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetPlatformVersion() string {
+	if x != nil {
+		return x.PlatformVersion
+	}
+	return ""
+}
+
+// AlertRule, a threshold on a system metric. An incident opens once the
+// comparison has held for duration_seconds and resolves when it stops.
+type AlertRule struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Metric          string                 `protobuf:"bytes,3,opt,name=metric,proto3" json:"metric,omitempty"`         // cpu_percent, memory_percent, disk_percent, network_kb_s, threads_total, threads_zombie, load_1, load_5, load_15
+	Comparison      string                 `protobuf:"bytes,4,opt,name=comparison,proto3" json:"comparison,omitempty"` // >, >=, <, <=
+	Threshold       float64                `protobuf:"fixed64,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	DurationSeconds int32                  `protobuf:"varint,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	IsActive        bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AlertRule) Reset() {
+	*x = AlertRule{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRule) ProtoMessage() {}
+
+func (x *AlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRule.ProtoReflect.Descriptor instead.
+func (*AlertRule) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AlertRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AlertRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AlertRule) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *AlertRule) GetComparison() string {
+	if x != nil {
+		return x.Comparison
+	}
+	return ""
+}
+
+func (x *AlertRule) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *AlertRule) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *AlertRule) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type Incident struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	RuleName      string                 `protobuf:"bytes,3,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	Metric        string                 `protobuf:"bytes,4,opt,name=metric,proto3" json:"metric,omitempty"`
+	Comparison    string                 `protobuf:"bytes,5,opt,name=comparison,proto3" json:"comparison,omitempty"`
+	Threshold     float64                `protobuf:"fixed64,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Value         float64                `protobuf:"fixed64,7,opt,name=value,proto3" json:"value,omitempty"`                           // when it was opened
+	StartedAt     string                 `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`    // RFC3339, when the condition began
+	ResolvedAt    string                 `protobuf:"bytes,9,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"` // RFC3339, empty while open
+	Host          string                 `protobuf:"bytes,10,opt,name=host,proto3" json:"host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Incident) Reset() {
+	*x = Incident{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Incident) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Incident) ProtoMessage() {}
+
+func (x *Incident) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Incident.ProtoReflect.Descriptor instead.
+func (*Incident) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Incident) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Incident) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *Incident) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *Incident) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *Incident) GetComparison() string {
+	if x != nil {
+		return x.Comparison
+	}
+	return ""
+}
+
+func (x *Incident) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *Incident) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Incident) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *Incident) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+func (x *Incident) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+type ListAlertRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertRulesRequest) Reset() {
+	*x = ListAlertRulesRequest{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRulesRequest) ProtoMessage() {}
+
+func (x *ListAlertRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{51}
+}
+
+type ListAlertRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*AlertRule           `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertRulesResponse) Reset() {
+	*x = ListAlertRulesResponse{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRulesResponse) ProtoMessage() {}
+
+func (x *ListAlertRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListAlertRulesResponse) GetRules() []*AlertRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type CreateAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *AlertRule             `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"` // id is ignored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAlertRuleRequest) Reset() {
+	*x = CreateAlertRuleRequest{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertRuleRequest) ProtoMessage() {}
+
+func (x *CreateAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreateAlertRuleRequest) GetRule() *AlertRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type CreateAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *AlertRule             `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAlertRuleResponse) Reset() {
+	*x = CreateAlertRuleResponse{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertRuleResponse) ProtoMessage() {}
+
+func (x *CreateAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateAlertRuleResponse) GetRule() *AlertRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *AlertRule             `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAlertRuleRequest) Reset() {
+	*x = UpdateAlertRuleRequest{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAlertRuleRequest) ProtoMessage() {}
+
+func (x *UpdateAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UpdateAlertRuleRequest) GetRule() *AlertRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *AlertRule             `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAlertRuleResponse) Reset() {
+	*x = UpdateAlertRuleResponse{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAlertRuleResponse) ProtoMessage() {}
+
+func (x *UpdateAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UpdateAlertRuleResponse) GetRule() *AlertRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertRuleRequest) Reset() {
+	*x = DeleteAlertRuleRequest{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertRuleRequest) ProtoMessage() {}
+
+func (x *DeleteAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DeleteAlertRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+type DeleteAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertRuleResponse) Reset() {
+	*x = DeleteAlertRuleResponse{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertRuleResponse) ProtoMessage() {}
+
+func (x *DeleteAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DeleteAlertRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListIncidentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIncidentsRequest) Reset() {
+	*x = ListIncidentsRequest{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIncidentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncidentsRequest) ProtoMessage() {}
+
+func (x *ListIncidentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncidentsRequest.ProtoReflect.Descriptor instead.
+func (*ListIncidentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{59}
+}
+
+type ListIncidentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Incidents     []*Incident            `protobuf:"bytes,1,rep,name=incidents,proto3" json:"incidents,omitempty"` // newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIncidentsResponse) Reset() {
+	*x = ListIncidentsResponse{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIncidentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncidentsResponse) ProtoMessage() {}
+
+func (x *ListIncidentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncidentsResponse.ProtoReflect.Descriptor instead.
+func (*ListIncidentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListIncidentsResponse) GetIncidents() []*Incident {
+	if x != nil {
+		return x.Incidents
+	}
+	return nil
+}
+
+type Agent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Host          string                 `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	LastSeen      string                 `protobuf:"bytes,3,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"` // RFC3339, empty if it never reported
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Agent) Reset() {
+	*x = Agent{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Agent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Agent) ProtoMessage() {}
+
+func (x *Agent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Agent.ProtoReflect.Descriptor instead.
+func (*Agent) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Agent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Agent) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Agent) GetLastSeen() string {
+	if x != nil {
+		return x.LastSeen
+	}
+	return ""
+}
+
+type RegisterAgentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterAgentRequest) Reset() {
+	*x = RegisterAgentRequest{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterAgentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterAgentRequest) ProtoMessage() {}
+
+func (x *RegisterAgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterAgentRequest.ProtoReflect.Descriptor instead.
+func (*RegisterAgentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RegisterAgentRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+type RegisterAgentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Agent         *Agent                 `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"` // only shown once
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterAgentResponse) Reset() {
+	*x = RegisterAgentResponse{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterAgentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterAgentResponse) ProtoMessage() {}
+
+func (x *RegisterAgentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterAgentResponse.ProtoReflect.Descriptor instead.
+func (*RegisterAgentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RegisterAgentResponse) GetAgent() *Agent {
+	if x != nil {
+		return x.Agent
+	}
+	return nil
+}
+
+func (x *RegisterAgentResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ListAgentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAgentsRequest) Reset() {
+	*x = ListAgentsRequest{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAgentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAgentsRequest) ProtoMessage() {}
+
+func (x *ListAgentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAgentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAgentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{64}
+}
+
+type ListAgentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Agents        []*Agent               `protobuf:"bytes,1,rep,name=agents,proto3" json:"agents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAgentsResponse) Reset() {
+	*x = ListAgentsResponse{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAgentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAgentsResponse) ProtoMessage() {}
+
+func (x *ListAgentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAgentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAgentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListAgentsResponse) GetAgents() []*Agent {
+	if x != nil {
+		return x.Agents
+	}
+	return nil
+}
+
+type DeleteAgentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AgentId       string                 `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAgentRequest) Reset() {
+	*x = DeleteAgentRequest{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAgentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAgentRequest) ProtoMessage() {}
+
+func (x *DeleteAgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAgentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAgentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteAgentRequest) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+type DeleteAgentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAgentResponse) Reset() {
+	*x = DeleteAgentResponse{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAgentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAgentResponse) ProtoMessage() {}
+
+func (x *DeleteAgentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAgentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAgentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteAgentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type Secret struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Ref           string                 `protobuf:"bytes,3,opt,name=ref,proto3" json:"ref,omitempty"`                              // "{{secret:<id>}}", what configs use
+	MonitorId     string                 `protobuf:"bytes,4,opt,name=monitor_id,json=monitorId,proto3" json:"monitor_id,omitempty"` // set for secrets sealed from a monitor's credentials
+	KeyId         string                 `protobuf:"bytes,5,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`             // fingerprint of the master key it is sealed with
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Secret) Reset() {
+	*x = Secret{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Secret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secret) ProtoMessage() {}
+
+func (x *Secret) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secret.ProtoReflect.Descriptor instead.
+func (*Secret) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Secret) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Secret) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Secret) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *Secret) GetMonitorId() string {
+	if x != nil {
+		return x.MonitorId
+	}
+	return ""
+}
+
+func (x *Secret) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *Secret) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Secret) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type ListSecretsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretsRequest) Reset() {
+	*x = ListSecretsRequest{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretsRequest) ProtoMessage() {}
+
+func (x *ListSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretsRequest.ProtoReflect.Descriptor instead.
+func (*ListSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{69}
+}
+
+type ListSecretsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secrets       []*Secret              `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretsResponse) Reset() {
+	*x = ListSecretsResponse{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretsResponse) ProtoMessage() {}
+
+func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretsResponse.ProtoReflect.Descriptor instead.
+func (*ListSecretsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListSecretsResponse) GetSecrets() []*Secret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+type CreateSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSecretRequest) Reset() {
+	*x = CreateSecretRequest{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSecretRequest) ProtoMessage() {}
+
+func (x *CreateSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSecretRequest.ProtoReflect.Descriptor instead.
+func (*CreateSecretRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSecretRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type CreateSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSecretResponse) Reset() {
+	*x = CreateSecretResponse{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSecretResponse) ProtoMessage() {}
+
+func (x *CreateSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSecretResponse.ProtoReflect.Descriptor instead.
+func (*CreateSecretResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreateSecretResponse) GetSecret() *Secret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type UpdateSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SecretId      string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSecretRequest) Reset() {
+	*x = UpdateSecretRequest{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSecretRequest) ProtoMessage() {}
+
+func (x *UpdateSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSecretRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSecretRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UpdateSecretRequest) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+func (x *UpdateSecretRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type UpdateSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        *Secret                `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSecretResponse) Reset() {
+	*x = UpdateSecretResponse{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSecretResponse) ProtoMessage() {}
+
+func (x *UpdateSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSecretResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSecretResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UpdateSecretResponse) GetSecret() *Secret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type DeleteSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SecretId      string                 `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSecretRequest) Reset() {
+	*x = DeleteSecretRequest{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSecretRequest) ProtoMessage() {}
+
+func (x *DeleteSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSecretRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DeleteSecretRequest) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+type DeleteSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSecretResponse) Reset() {
+	*x = DeleteSecretResponse{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSecretResponse) ProtoMessage() {}
+
+func (x *DeleteSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSecretResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSecretResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *SystemStatsResponse) GetInfo() *SystemInfo {
+func (x *DeleteSecretResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Info
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type ThreadUsage struct {
+// SystemSample, one unrounded reading of a host, as pushed by agents
+type SystemSample struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Running       int32                  `protobuf:"varint,2,opt,name=running,proto3" json:"running,omitempty"`
-	Sleeping      int32                  `protobuf:"varint,3,opt,name=sleeping,proto3" json:"sleeping,omitempty"`
-	Zombie        int32                  `protobuf:"varint,4,opt,name=zombie,proto3" json:"zombie,omitempty"`
-	IsWarning     bool                   `protobuf:"varint,5,opt,name=is_warning,json=isWarning,proto3" json:"is_warning,omitempty"`
-	History       []*ThreadHistory       `protobuf:"bytes,6,rep,name=history,proto3" json:"history,omitempty"`
+	Time          int64                  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"` // unix ms
+	CpuPercent    float64                `protobuf:"fixed64,2,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
+	CpuCores      []float64              `protobuf:"fixed64,3,rep,packed,name=cpu_cores,json=cpuCores,proto3" json:"cpu_cores,omitempty"`
+	Load          *LoadAverage           `protobuf:"bytes,4,opt,name=load,proto3" json:"load,omitempty"`
+	MemoryPercent float64                `protobuf:"fixed64,5,opt,name=memory_percent,json=memoryPercent,proto3" json:"memory_percent,omitempty"`
+	MemoryUsed    uint64                 `protobuf:"varint,6,opt,name=memory_used,json=memoryUsed,proto3" json:"memory_used,omitempty"` // bytes
+	MemoryTotal   uint64                 `protobuf:"varint,7,opt,name=memory_total,json=memoryTotal,proto3" json:"memory_total,omitempty"`
+	DiskPercent   float64                `protobuf:"fixed64,8,opt,name=disk_percent,json=diskPercent,proto3" json:"disk_percent,omitempty"`
+	DiskUsed      uint64                 `protobuf:"varint,9,opt,name=disk_used,json=diskUsed,proto3" json:"disk_used,omitempty"` // bytes
+	DiskTotal     uint64                 `protobuf:"varint,10,opt,name=disk_total,json=diskTotal,proto3" json:"disk_total,omitempty"`
+	NetworkKbs    float64                `protobuf:"fixed64,11,opt,name=network_kbs,json=networkKbs,proto3" json:"network_kbs,omitempty"`
+	Disks         []*DiskSample          `protobuf:"bytes,12,rep,name=disks,proto3" json:"disks,omitempty"`
+	Interfaces    []*InterfaceUsage      `protobuf:"bytes,13,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	Processes     *ThreadUsage           `protobuf:"bytes,14,opt,name=processes,proto3" json:"processes,omitempty"` // counts only
+	TopCpu        []*ProcessInfo         `protobuf:"bytes,15,rep,name=top_cpu,json=topCpu,proto3" json:"top_cpu,omitempty"`
+	TopMemory     []*ProcessInfo         `protobuf:"bytes,16,rep,name=top_memory,json=topMemory,proto3" json:"top_memory,omitempty"`
+	Info          *SystemInfo            `protobuf:"bytes,17,opt,name=info,proto3" json:"info,omitempty"`
+	Cgroups       []*CgroupUsage         `protobuf:"bytes,18,rep,name=cgroups,proto3" json:"cgroups,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ThreadUsage) Reset() {
-	*x = ThreadUsage{}
-	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[12]
+func (x *SystemSample) Reset() {
+	*x = SystemSample{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ThreadUsage) String() string {
+func (x *SystemSample) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ThreadUsage) ProtoMessage() {}
+func (*SystemSample) ProtoMessage() {}
 
-func (x *ThreadUsage) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[12]
+func (x *SystemSample) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -727,148 +5052,166 @@ func (x *ThreadUsage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ThreadUsage.ProtoReflect.Descriptor instead.
-func (*ThreadUsage) Descriptor() ([]byte, []int) {
-	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SystemSample.ProtoReflect.Descriptor instead.
+func (*SystemSample) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *ThreadUsage) GetTotal() int32 {
+func (x *SystemSample) GetTime() int64 {
 	if x != nil {
-		return x.Total
+		return x.Time
 	}
 	return 0
 }
 
-func (x *ThreadUsage) GetRunning() int32 {
+func (x *SystemSample) GetCpuPercent() float64 {
 	if x != nil {
-		return x.Running
+		return x.CpuPercent
 	}
 	return 0
 }
 
-func (x *ThreadUsage) GetSleeping() int32 {
+func (x *SystemSample) GetCpuCores() []float64 {
 	if x != nil {
-		return x.Sleeping
+		return x.CpuCores
+	}
+	return nil
+}
+
+func (x *SystemSample) GetLoad() *LoadAverage {
+	if x != nil {
+		return x.Load
+	}
+	return nil
+}
+
+func (x *SystemSample) GetMemoryPercent() float64 {
+	if x != nil {
+		return x.MemoryPercent
 	}
 	return 0
 }
 
-func (x *ThreadUsage) GetZombie() int32 {
+func (x *SystemSample) GetMemoryUsed() uint64 {
 	if x != nil {
-		return x.Zombie
+		return x.MemoryUsed
 	}
 	return 0
 }
 
-func (x *ThreadUsage) GetIsWarning() bool {
+func (x *SystemSample) GetMemoryTotal() uint64 {
 	if x != nil {
-		return x.IsWarning
+		return x.MemoryTotal
 	}
-	return false
+	return 0
 }
 
-func (x *ThreadUsage) GetHistory() []*ThreadHistory {
+func (x *SystemSample) GetDiskPercent() float64 {
 	if x != nil {
-		return x.History
+		return x.DiskPercent
 	}
-	return nil
+	return 0
 }
 
-type ThreadHistory struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Running       int32                  `protobuf:"varint,1,opt,name=running,proto3" json:"running,omitempty"`
-	Sleeping      int32                  `protobuf:"varint,2,opt,name=sleeping,proto3" json:"sleeping,omitempty"`
-	Zombie        int32                  `protobuf:"varint,3,opt,name=zombie,proto3" json:"zombie,omitempty"`
-	Time          string                 `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SystemSample) GetDiskUsed() uint64 {
+	if x != nil {
+		return x.DiskUsed
+	}
+	return 0
 }
 
-func (x *ThreadHistory) Reset() {
-	*x = ThreadHistory{}
-	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SystemSample) GetDiskTotal() uint64 {
+	if x != nil {
+		return x.DiskTotal
+	}
+	return 0
 }
 
-func (x *ThreadHistory) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SystemSample) GetNetworkKbs() float64 {
+	if x != nil {
+		return x.NetworkKbs
+	}
+	return 0
 }
 
-func (*ThreadHistory) ProtoMessage() {}
+func (x *SystemSample) GetDisks() []*DiskSample {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
 
-func (x *ThreadHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[13]
+func (x *SystemSample) GetInterfaces() []*InterfaceUsage {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Interfaces
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ThreadHistory.ProtoReflect.Descriptor instead.
-func (*ThreadHistory) Descriptor() ([]byte, []int) {
-	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{13}
+func (x *SystemSample) GetProcesses() *ThreadUsage {
+	if x != nil {
+		return x.Processes
+	}
+	return nil
 }
 
-func (x *ThreadHistory) GetRunning() int32 {
+func (x *SystemSample) GetTopCpu() []*ProcessInfo {
 	if x != nil {
-		return x.Running
+		return x.TopCpu
 	}
-	return 0
+	return nil
 }
 
-func (x *ThreadHistory) GetSleeping() int32 {
+func (x *SystemSample) GetTopMemory() []*ProcessInfo {
 	if x != nil {
-		return x.Sleeping
+		return x.TopMemory
 	}
-	return 0
+	return nil
 }
 
-func (x *ThreadHistory) GetZombie() int32 {
+func (x *SystemSample) GetInfo() *SystemInfo {
 	if x != nil {
-		return x.Zombie
+		return x.Info
 	}
-	return 0
+	return nil
 }
 
-func (x *ThreadHistory) GetTime() string {
+func (x *SystemSample) GetCgroups() []*CgroupUsage {
 	if x != nil {
-		return x.Time
+		return x.Cgroups
 	}
-	return ""
+	return nil
 }
 
-type ResourceUsage struct {
+type DiskSample struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Used          float64                `protobuf:"fixed64,1,opt,name=used,proto3" json:"used,omitempty"`
-	Total         float64                `protobuf:"fixed64,2,opt,name=total,proto3" json:"total,omitempty"`
-	Percent       float64                `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent,omitempty"`
-	Unit          string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
-	IsWarning     bool                   `protobuf:"varint,5,opt,name=is_warning,json=isWarning,proto3" json:"is_warning,omitempty"`
-	History       []float64              `protobuf:"fixed64,6,rep,packed,name=history,proto3" json:"history,omitempty"`
+	Mountpoint    string                 `protobuf:"bytes,1,opt,name=mountpoint,proto3" json:"mountpoint,omitempty"`
+	Device        string                 `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	Fstype        string                 `protobuf:"bytes,3,opt,name=fstype,proto3" json:"fstype,omitempty"`
+	Used          uint64                 `protobuf:"varint,4,opt,name=used,proto3" json:"used,omitempty"` // bytes
+	Total         uint64                 `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	Percent       float64                `protobuf:"fixed64,6,opt,name=percent,proto3" json:"percent,omitempty"`
+	ReadKbs       float64                `protobuf:"fixed64,7,opt,name=read_kbs,json=readKbs,proto3" json:"read_kbs,omitempty"`
+	WriteKbs      float64                `protobuf:"fixed64,8,opt,name=write_kbs,json=writeKbs,proto3" json:"write_kbs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResourceUsage) Reset() {
-	*x = ResourceUsage{}
-	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[14]
+func (x *DiskSample) Reset() {
+	*x = DiskSample{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResourceUsage) String() string {
+func (x *DiskSample) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResourceUsage) ProtoMessage() {}
+func (*DiskSample) ProtoMessage() {}
 
-func (x *ResourceUsage) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[14]
+func (x *DiskSample) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -879,79 +5222,89 @@ func (x *ResourceUsage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResourceUsage.ProtoReflect.Descriptor instead.
-func (*ResourceUsage) Descriptor() ([]byte, []int) {
-	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use DiskSample.ProtoReflect.Descriptor instead.
+func (*DiskSample) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *ResourceUsage) GetUsed() float64 {
+func (x *DiskSample) GetMountpoint() string {
 	if x != nil {
-		return x.Used
+		return x.Mountpoint
 	}
-	return 0
+	return ""
 }
 
-func (x *ResourceUsage) GetTotal() float64 {
+func (x *DiskSample) GetDevice() string {
 	if x != nil {
-		return x.Total
+		return x.Device
+	}
+	return ""
+}
+
+func (x *DiskSample) GetFstype() string {
+	if x != nil {
+		return x.Fstype
+	}
+	return ""
+}
+
+func (x *DiskSample) GetUsed() uint64 {
+	if x != nil {
+		return x.Used
 	}
 	return 0
 }
 
-func (x *ResourceUsage) GetPercent() float64 {
+func (x *DiskSample) GetTotal() uint64 {
 	if x != nil {
-		return x.Percent
+		return x.Total
 	}
 	return 0
 }
 
-func (x *ResourceUsage) GetUnit() string {
+func (x *DiskSample) GetPercent() float64 {
 	if x != nil {
-		return x.Unit
+		return x.Percent
 	}
-	return ""
+	return 0
 }
 
-func (x *ResourceUsage) GetIsWarning() bool {
+func (x *DiskSample) GetReadKbs() float64 {
 	if x != nil {
-		return x.IsWarning
+		return x.ReadKbs
 	}
-	return false
+	return 0
 }
 
-func (x *ResourceUsage) GetHistory() []float64 {
+func (x *DiskSample) GetWriteKbs() float64 {
 	if x != nil {
-		return x.History
+		return x.WriteKbs
 	}
-	return nil
+	return 0
 }
 
-type SystemInfo struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Hostname        string                 `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Os              string                 `protobuf:"bytes,2,opt,name=os,proto3" json:"os,omitempty"`
-	UptimeSeconds   uint64                 `protobuf:"varint,3,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
-	Platform        string                 `protobuf:"bytes,4,opt,name=platform,proto3" json:"platform,omitempty"`
-	PlatformVersion string                 `protobuf:"bytes,5,opt,name=platform_version,json=platformVersion,proto3" json:"platform_version,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type IngestSystemStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sample        *SystemSample          `protobuf:"bytes,1,opt,name=sample,proto3" json:"sample,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SystemInfo) Reset() {
-	*x = SystemInfo{}
-	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[15]
+func (x *IngestSystemStatsRequest) Reset() {
+	*x = IngestSystemStatsRequest{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SystemInfo) String() string {
+func (x *IngestSystemStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemInfo) ProtoMessage() {}
+func (*IngestSystemStatsRequest) ProtoMessage() {}
 
-func (x *SystemInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[15]
+func (x *IngestSystemStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -962,61 +5315,154 @@ func (x *SystemInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemInfo.ProtoReflect.Descriptor instead.
-func (*SystemInfo) Descriptor() ([]byte, []int) {
-	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use IngestSystemStatsRequest.ProtoReflect.Descriptor instead.
+func (*IngestSystemStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *SystemInfo) GetHostname() string {
+func (x *IngestSystemStatsRequest) GetSample() *SystemSample {
 	if x != nil {
-		return x.Hostname
+		return x.Sample
 	}
-	return ""
+	return nil
 }
 
-func (x *SystemInfo) GetOs() string {
-	if x != nil {
-		return x.Os
-	}
-	return ""
+type IngestSystemStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SystemInfo) GetUptimeSeconds() uint64 {
-	if x != nil {
-		return x.UptimeSeconds
-	}
-	return 0
+func (x *IngestSystemStatsResponse) Reset() {
+	*x = IngestSystemStatsResponse{}
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SystemInfo) GetPlatform() string {
-	if x != nil {
-		return x.Platform
-	}
-	return ""
+func (x *IngestSystemStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SystemInfo) GetPlatformVersion() string {
+func (*IngestSystemStatsResponse) ProtoMessage() {}
+
+func (x *IngestSystemStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pulsar_v1_monitor_proto_msgTypes[80]
 	if x != nil {
-		return x.PlatformVersion
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestSystemStatsResponse.ProtoReflect.Descriptor instead.
+func (*IngestSystemStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_pulsar_v1_monitor_proto_rawDescGZIP(), []int{80}
 }
 
 var File_proto_pulsar_v1_monitor_proto protoreflect.FileDescriptor
 
 const file_proto_pulsar_v1_monitor_proto_rawDesc = "" +
 	"\n" +
-	"\x1dproto/pulsar/v1/monitor.proto\x12\tpulsar.v1\x1a\x1bgoogle/protobuf/empty.proto\"\x92\x01\n" +
+	"\x1dproto/pulsar/v1/monitor.proto\x12\tpulsar.v1\"\x9d\x04\n" +
 	"\aMonitor\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12)\n" +
 	"\x10interval_seconds\x18\x03 \x01(\x05R\x0fintervalSeconds\x12\x1b\n" +
 	"\tis_active\x18\x04 \x01(\bR\bisActive\x12\x1d\n" +
 	"\n" +
-	"last_check\x18\x05 \x01(\x03R\tlastCheck\"S\n" +
+	"last_check\x18\x05 \x01(\x03R\tlastCheck\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x12)\n" +
+	"\x04icmp\x18\a \x01(\v2\x15.pulsar.v1.IcmpConfigR\x04icmp\x12\x19\n" +
+	"\bpush_url\x18\b \x01(\tR\apushUrl\x12)\n" +
+	"\x04push\x18\t \x01(\v2\x15.pulsar.v1.PushConfigR\x04push\x12)\n" +
+	"\x04grpc\x18\n" +
+	" \x01(\v2\x15.pulsar.v1.GrpcConfigR\x04grpc\x12/\n" +
+	"\x06script\x18\v \x01(\v2\x17.pulsar.v1.ScriptConfigR\x06script\x121\n" +
+	"\acontent\x18\f \x01(\v2\x17.pulsar.v1.ContentCheckR\acontent\x127\n" +
+	"\tredirects\x18\r \x01(\v2\x19.pulsar.v1.RedirectPolicyR\tredirects\x12<\n" +
+	"\vcredentials\x18\x0e \x01(\v2\x1a.pulsar.v1.CredentialsInfoR\vcredentials\"\x83\x01\n" +
+	"\n" +
+	"IcmpConfig\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x02 \x01(\x05R\ttimeoutMs\x12#\n" +
+	"\rdegraded_loss\x18\x03 \x01(\x01R\fdegradedLoss\x12\x1b\n" +
+	"\tdown_loss\x18\x04 \x01(\x01R\bdownLoss\"1\n" +
+	"\n" +
+	"PushConfig\x12#\n" +
+	"\rgrace_seconds\x18\x01 \x01(\x05R\fgraceSeconds\"\x81\x01\n" +
+	"\x0eRedirectPolicy\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\x12\x19\n" +
+	"\bmax_hops\x18\x02 \x01(\x05R\amaxHops\x12#\n" +
+	"\rrequire_https\x18\x03 \x01(\bR\frequireHttps\x12\x1b\n" +
+	"\tsame_host\x18\x04 \x01(\bR\bsameHost\"\x99\x01\n" +
+	"\fContentCheck\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1b\n" +
+	"\tmax_bytes\x18\x02 \x01(\x05R\bmaxBytes\x12)\n" +
+	"\x10ignore_selectors\x18\x03 \x03(\tR\x0fignoreSelectors\x12'\n" +
+	"\x0fignore_patterns\x18\x04 \x03(\tR\x0eignorePatterns\"\x89\x01\n" +
+	"\n" +
+	"GrpcConfig\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12\x10\n" +
+	"\x03tls\x18\x02 \x01(\bR\x03tls\x120\n" +
+	"\x14insecure_skip_verify\x18\x03 \x01(\bR\x12insecureSkipVerify\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x04 \x01(\x05R\ttimeoutMs\"Z\n" +
+	"\fScriptConfig\x12+\n" +
+	"\x05steps\x18\x01 \x03(\v2\x15.pulsar.v1.ScriptStepR\x05steps\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x02 \x01(\x05R\ttimeoutMs\"\xbd\x02\n" +
+	"\n" +
+	"ScriptStep\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12<\n" +
+	"\aheaders\x18\x04 \x03(\v2\".pulsar.v1.ScriptStep.HeadersEntryR\aheaders\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x122\n" +
+	"\aextract\x18\x06 \x03(\v2\x18.pulsar.v1.ScriptExtractR\aextract\x12/\n" +
+	"\x06assert\x18\a \x03(\v2\x17.pulsar.v1.ScriptAssertR\x06assert\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"I\n" +
+	"\rScriptExtract\x12\x10\n" +
+	"\x03var\x18\x01 \x01(\tR\x03var\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\"`\n" +
+	"\fScriptAssert\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x0e\n" +
+	"\x02op\x18\x03 \x01(\tR\x02op\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\"\xc4\x03\n" +
 	"\x14CreateMonitorRequest\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\x12)\n" +
-	"\x10interval_seconds\x18\x02 \x01(\x05R\x0fintervalSeconds\"E\n" +
+	"\x10interval_seconds\x18\x02 \x01(\x05R\x0fintervalSeconds\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12)\n" +
+	"\x04icmp\x18\x04 \x01(\v2\x15.pulsar.v1.IcmpConfigR\x04icmp\x12)\n" +
+	"\x04push\x18\x05 \x01(\v2\x15.pulsar.v1.PushConfigR\x04push\x12)\n" +
+	"\x04grpc\x18\x06 \x01(\v2\x15.pulsar.v1.GrpcConfigR\x04grpc\x12/\n" +
+	"\x06script\x18\a \x01(\v2\x17.pulsar.v1.ScriptConfigR\x06script\x121\n" +
+	"\acontent\x18\b \x01(\v2\x17.pulsar.v1.ContentCheckR\acontent\x127\n" +
+	"\tredirects\x18\t \x01(\v2\x19.pulsar.v1.RedirectPolicyR\tredirects\x12=\n" +
+	"\vcredentials\x18\n" +
+	" \x01(\v2\x1b.pulsar.v1.ProbeCredentialsR\vcredentials\"\x8c\x01\n" +
+	"\x10ProbeCredentials\x12\x1b\n" +
+	"\tproxy_url\x18\x01 \x01(\tR\bproxyUrl\x12\x1f\n" +
+	"\vclient_cert\x18\x02 \x01(\tR\n" +
+	"clientCert\x12\x1d\n" +
+	"\n" +
+	"client_key\x18\x03 \x01(\tR\tclientKey\x12\x1b\n" +
+	"\tca_bundle\x18\x04 \x01(\tR\bcaBundle\"\xcc\x01\n" +
+	"\x0fCredentialsInfo\x12\x1b\n" +
+	"\tproxy_url\x18\x01 \x01(\tR\bproxyUrl\x12\x1f\n" +
+	"\vclient_cert\x18\x02 \x01(\bR\n" +
+	"clientCert\x12.\n" +
+	"\x13client_cert_subject\x18\x03 \x01(\tR\x11clientCertSubject\x12.\n" +
+	"\x13client_cert_expires\x18\x04 \x01(\x03R\x11clientCertExpires\x12\x1b\n" +
+	"\tca_bundle\x18\x05 \x01(\bR\bcaBundle\"E\n" +
 	"\x15CreateMonitorResponse\x12,\n" +
 	"\amonitor\x18\x01 \x01(\v2\x12.pulsar.v1.MonitorR\amonitor\"\x15\n" +
 	"\x13ListMonitorsRequest\"F\n" +
@@ -1026,31 +5472,189 @@ const file_proto_pulsar_v1_monitor_proto_rawDesc = "" +
 	"\n" +
 	"monitor_id\x18\x01 \x01(\tR\tmonitorId\"1\n" +
 	"\x15DeleteMonitorResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"7\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"|\n" +
+	"\x1cSetMonitorCredentialsRequest\x12\x1d\n" +
+	"\n" +
+	"monitor_id\x18\x01 \x01(\tR\tmonitorId\x12=\n" +
+	"\vcredentials\x18\x02 \x01(\v2\x1b.pulsar.v1.ProbeCredentialsR\vcredentials\"M\n" +
+	"\x1dSetMonitorCredentialsResponse\x12,\n" +
+	"\amonitor\x18\x01 \x01(\v2\x12.pulsar.v1.MonitorR\amonitor\"7\n" +
 	"\x16GetMonitorStatsRequest\x12\x1d\n" +
 	"\n" +
 	"monitor_id\x18\x01 \x01(\tR\tmonitorId\"G\n" +
 	"\x17GetMonitorStatsResponse\x12,\n" +
-	"\x05stats\x18\x01 \x03(\v2\x16.pulsar.v1.MonitorStatR\x05stats\"\x99\x01\n" +
+	"\x05stats\x18\x01 \x03(\v2\x16.pulsar.v1.MonitorStatR\x05stats\"\xa6\x02\n" +
 	"\vMonitorStat\x12\x18\n" +
 	"\alatency\x18\x01 \x01(\x05R\alatency\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x16\n" +
 	"\x06status\x18\x03 \x01(\tR\x06status\x12\x12\n" +
 	"\x04time\x18\x04 \x01(\tR\x04time\x120\n" +
-	"\x06timing\x18\x05 \x01(\v2\x18.pulsar.v1.MonitorTimingR\x06timing\"u\n" +
+	"\x06timing\x18\x05 \x01(\v2\x18.pulsar.v1.MonitorTimingR\x06timing\x12(\n" +
+	"\x04icmp\x18\x06 \x01(\v2\x14.pulsar.v1.IcmpStatsR\x04icmp\x12+\n" +
+	"\x05steps\x18\a \x03(\v2\x15.pulsar.v1.StepResultR\x05steps\x124\n" +
+	"\tredirects\x18\b \x03(\v2\x16.pulsar.v1.RedirectHopR\tredirects\"7\n" +
+	"\x14WatchMonitorsRequest\x12\x1f\n" +
+	"\vmonitor_ids\x18\x01 \x03(\tR\n" +
+	"monitorIds\"\xd9\x02\n" +
+	"\rMonitorUpdate\x12\x1d\n" +
+	"\n" +
+	"monitor_id\x18\x01 \x01(\tR\tmonitorId\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\x05R\x04code\x12\x18\n" +
+	"\alatency\x18\x05 \x01(\x05R\alatency\x120\n" +
+	"\x06timing\x18\x06 \x01(\v2\x18.pulsar.v1.MonitorTimingR\x06timing\x12\x12\n" +
+	"\x04time\x18\a \x01(\tR\x04time\x12(\n" +
+	"\x04icmp\x18\b \x01(\v2\x14.pulsar.v1.IcmpStatsR\x04icmp\x12+\n" +
+	"\x05steps\x18\t \x03(\v2\x15.pulsar.v1.StepResultR\x05steps\x124\n" +
+	"\tredirects\x18\n" +
+	" \x03(\v2\x16.pulsar.v1.RedirectHopR\tredirects\"\x8f\x01\n" +
+	"\tIcmpStats\x12\x17\n" +
+	"\artt_min\x18\x01 \x01(\x01R\x06rttMin\x12\x17\n" +
+	"\artt_avg\x18\x02 \x01(\x01R\x06rttAvg\x12\x17\n" +
+	"\artt_max\x18\x03 \x01(\x01R\x06rttMax\x12\x16\n" +
+	"\x06jitter\x18\x04 \x01(\x01R\x06jitter\x12\x1f\n" +
+	"\vpacket_loss\x18\x05 \x01(\x01R\n" +
+	"packetLoss\"\x7f\n" +
+	"\vRedirectHop\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x18\n" +
+	"\alatency\x18\x03 \x01(\x05R\alatency\x120\n" +
+	"\x06timing\x18\x04 \x01(\v2\x18.pulsar.v1.MonitorTimingR\x06timing\"\xce\x01\n" +
+	"\rContentChange\x12\x1d\n" +
+	"\n" +
+	"monitor_id\x18\x01 \x01(\tR\tmonitorId\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x19\n" +
+	"\bold_hash\x18\x03 \x01(\tR\aoldHash\x12\x19\n" +
+	"\bnew_hash\x18\x04 \x01(\tR\anewHash\x12\x14\n" +
+	"\x05added\x18\x05 \x01(\x05R\x05added\x12\x18\n" +
+	"\aremoved\x18\x06 \x01(\x05R\aremoved\x12\x12\n" +
+	"\x04diff\x18\a \x01(\tR\x04diff\x12\x12\n" +
+	"\x04time\x18\b \x01(\tR\x04time\"\xc0\x01\n" +
+	"\n" +
+	"StepResult\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\x05R\x04code\x12\x18\n" +
+	"\alatency\x18\x05 \x01(\x05R\alatency\x120\n" +
+	"\x06timing\x18\x06 \x01(\v2\x18.pulsar.v1.MonitorTimingR\x06timing\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\"u\n" +
 	"\rMonitorTiming\x12\x10\n" +
 	"\x03dns\x18\x01 \x01(\x05R\x03dns\x12\x10\n" +
 	"\x03tcp\x18\x02 \x01(\x05R\x03tcp\x12\x10\n" +
 	"\x03tls\x18\x03 \x01(\x05R\x03tls\x12\x12\n" +
 	"\x04ttfb\x18\x04 \x01(\x05R\x04ttfb\x12\x1a\n" +
-	"\bdownload\x18\x05 \x01(\x05R\bdownload\"\xb2\x02\n" +
+	"\bdownload\x18\x05 \x01(\x05R\bdownload\"+\n" +
+	"\x15GetSystemStatsRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\"n\n" +
+	"\x1cGetSystemStatsHistoryRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\x03R\x02to\x12\x16\n" +
+	"\x06points\x18\x04 \x01(\x05R\x06points\"\xee\x02\n" +
+	"\x1dGetSystemStatsHistoryResponse\x12%\n" +
+	"\x0ebucket_seconds\x18\x01 \x01(\x03R\rbucketSeconds\x12\x14\n" +
+	"\x05times\x18\x02 \x03(\tR\x05times\x12'\n" +
+	"\x03cpu\x18\x03 \x01(\v2\x15.pulsar.v1.StatSeriesR\x03cpu\x12-\n" +
+	"\x06memory\x18\x04 \x01(\v2\x15.pulsar.v1.StatSeriesR\x06memory\x12)\n" +
+	"\x04disk\x18\x05 \x01(\v2\x15.pulsar.v1.StatSeriesR\x04disk\x12/\n" +
+	"\anetwork\x18\x06 \x01(\v2\x15.pulsar.v1.StatSeriesR\anetwork\x12/\n" +
+	"\athreads\x18\a \x01(\v2\x15.pulsar.v1.StatSeriesR\athreads\x12+\n" +
+	"\x05load1\x18\b \x01(\v2\x15.pulsar.v1.StatSeriesR\x05load1\"B\n" +
+	"\n" +
+	"StatSeries\x12\x10\n" +
+	"\x03min\x18\x01 \x03(\x01R\x03min\x12\x10\n" +
+	"\x03avg\x18\x02 \x03(\x01R\x03avg\x12\x10\n" +
+	"\x03max\x18\x03 \x03(\x01R\x03max\"\x90\x05\n" +
 	"\x13SystemStatsResponse\x12*\n" +
 	"\x03cpu\x18\x01 \x01(\v2\x18.pulsar.v1.ResourceUsageR\x03cpu\x120\n" +
 	"\x06memory\x18\x02 \x01(\v2\x18.pulsar.v1.ResourceUsageR\x06memory\x12,\n" +
 	"\x04disk\x18\x03 \x01(\v2\x18.pulsar.v1.ResourceUsageR\x04disk\x122\n" +
 	"\anetwork\x18\x04 \x01(\v2\x18.pulsar.v1.ResourceUsageR\anetwork\x120\n" +
 	"\athreads\x18\x05 \x01(\v2\x16.pulsar.v1.ThreadUsageR\athreads\x12)\n" +
-	"\x04info\x18\x06 \x01(\v2\x15.pulsar.v1.SystemInfoR\x04info\"\xc4\x01\n" +
+	"\x04info\x18\x06 \x01(\v2\x15.pulsar.v1.SystemInfoR\x04info\x12*\n" +
+	"\x05disks\x18\a \x03(\v2\x14.pulsar.v1.DiskUsageR\x05disks\x129\n" +
+	"\n" +
+	"interfaces\x18\b \x03(\v2\x19.pulsar.v1.InterfaceUsageR\n" +
+	"interfaces\x12\x1b\n" +
+	"\tcpu_cores\x18\t \x03(\x01R\bcpuCores\x12*\n" +
+	"\x04load\x18\n" +
+	" \x01(\v2\x16.pulsar.v1.LoadAverageR\x04load\x12/\n" +
+	"\atop_cpu\x18\v \x03(\v2\x16.pulsar.v1.ProcessInfoR\x06topCpu\x125\n" +
+	"\n" +
+	"top_memory\x18\f \x03(\v2\x16.pulsar.v1.ProcessInfoR\ttopMemory\x12\x12\n" +
+	"\x04host\x18\r \x01(\tR\x04host\x120\n" +
+	"\acgroups\x18\x0e \x03(\v2\x16.pulsar.v1.CgroupUsageR\acgroups\"\xdc\x01\n" +
+	"\vProcessInfo\x12\x10\n" +
+	"\x03pid\x18\x01 \x01(\x05R\x03pid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04user\x18\x03 \x01(\tR\x04user\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12\x18\n" +
+	"\athreads\x18\x05 \x01(\x05R\athreads\x12\x1f\n" +
+	"\vcpu_percent\x18\x06 \x01(\x01R\n" +
+	"cpuPercent\x12\x1b\n" +
+	"\trss_bytes\x18\a \x01(\x04R\brssBytes\x12%\n" +
+	"\x0ememory_percent\x18\b \x01(\x01R\rmemoryPercent\"?\n" +
+	"\x19GetProcessSnapshotRequest\x12\x0e\n" +
+	"\x02at\x18\x01 \x01(\x03R\x02at\x12\x12\n" +
+	"\x04host\x18\x02 \x01(\tR\x04host\"f\n" +
+	"\x1aGetProcessSnapshotResponse\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\tR\x04time\x124\n" +
+	"\tprocesses\x18\x02 \x03(\v2\x16.pulsar.v1.ProcessInfoR\tprocesses\"\xef\x02\n" +
+	"\vCgroupUsage\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12!\n" +
+	"\fcontainer_id\x18\x02 \x01(\tR\vcontainerId\x12\x1f\n" +
+	"\vcpu_percent\x18\x03 \x01(\x01R\n" +
+	"cpuPercent\x12\x1b\n" +
+	"\tcpu_limit\x18\x04 \x01(\x01R\bcpuLimit\x12!\n" +
+	"\fmemory_bytes\x18\x05 \x01(\x04R\vmemoryBytes\x12!\n" +
+	"\fmemory_limit\x18\x06 \x01(\x04R\vmemoryLimit\x12\x1e\n" +
+	"\vio_read_kbs\x18\a \x01(\x01R\tioReadKbs\x12 \n" +
+	"\fio_write_kbs\x18\b \x01(\x01R\n" +
+	"ioWriteKbs\x12\x12\n" +
+	"\x04pids\x18\t \x01(\x05R\x04pids\x12\x1d\n" +
+	"\n" +
+	"pids_limit\x18\n" +
+	" \x01(\x05R\tpidsLimit\x120\n" +
+	"\ahistory\x18\v \x03(\v2\x16.pulsar.v1.CgroupPointR\ahistory\"\xbb\x01\n" +
+	"\vCgroupPoint\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\tR\x04time\x12\x1f\n" +
+	"\vcpu_percent\x18\x02 \x01(\x01R\n" +
+	"cpuPercent\x12!\n" +
+	"\fmemory_bytes\x18\x03 \x01(\x04R\vmemoryBytes\x12\x1e\n" +
+	"\vio_read_kbs\x18\x04 \x01(\x01R\tioReadKbs\x12 \n" +
+	"\fio_write_kbs\x18\x05 \x01(\x01R\n" +
+	"ioWriteKbs\x12\x12\n" +
+	"\x04pids\x18\x06 \x01(\x05R\x04pids\"A\n" +
+	"\x15GetCgroupStatsRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x14\n" +
+	"\x05since\x18\x02 \x01(\x03R\x05since\"J\n" +
+	"\x16GetCgroupStatsResponse\x120\n" +
+	"\acgroups\x18\x01 \x03(\v2\x16.pulsar.v1.CgroupUsageR\acgroups\"\x90\x02\n" +
+	"\tDiskUsage\x12\x1e\n" +
+	"\n" +
+	"mountpoint\x18\x01 \x01(\tR\n" +
+	"mountpoint\x12\x16\n" +
+	"\x06device\x18\x02 \x01(\tR\x06device\x12\x16\n" +
+	"\x06fstype\x18\x03 \x01(\tR\x06fstype\x12\x12\n" +
+	"\x04used\x18\x04 \x01(\x01R\x04used\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x01R\x05total\x12\x18\n" +
+	"\apercent\x18\x06 \x01(\x01R\apercent\x12\x19\n" +
+	"\bread_kbs\x18\a \x01(\x01R\areadKbs\x12\x1b\n" +
+	"\twrite_kbs\x18\b \x01(\x01R\bwriteKbs\x12\x1d\n" +
+	"\n" +
+	"is_warning\x18\t \x01(\bR\tisWarning\x12\x18\n" +
+	"\ahistory\x18\n" +
+	" \x03(\x01R\ahistory\"R\n" +
+	"\x0eInterfaceUsage\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x15\n" +
+	"\x06rx_kbs\x18\x02 \x01(\x01R\x05rxKbs\x12\x15\n" +
+	"\x06tx_kbs\x18\x03 \x01(\x01R\x05txKbs\"Q\n" +
+	"\vLoadAverage\x12\x14\n" +
+	"\x05load1\x18\x01 \x01(\x01R\x05load1\x12\x14\n" +
+	"\x05load5\x18\x02 \x01(\x01R\x05load5\x12\x16\n" +
+	"\x06load15\x18\x03 \x01(\x01R\x06load15\"\xc4\x01\n" +
 	"\vThreadUsage\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x18\n" +
 	"\arunning\x18\x02 \x01(\x05R\arunning\x12\x1a\n" +
@@ -1078,13 +5682,162 @@ const file_proto_pulsar_v1_monitor_proto_rawDesc = "" +
 	"\x02os\x18\x02 \x01(\tR\x02os\x12%\n" +
 	"\x0euptime_seconds\x18\x03 \x01(\x04R\ruptimeSeconds\x12\x1a\n" +
 	"\bplatform\x18\x04 \x01(\tR\bplatform\x12)\n" +
-	"\x10platform_version\x18\x05 \x01(\tR\x0fplatformVersion2\xaf\x03\n" +
+	"\x10platform_version\x18\x05 \x01(\tR\x0fplatformVersion\"\xcd\x01\n" +
+	"\tAlertRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06metric\x18\x03 \x01(\tR\x06metric\x12\x1e\n" +
+	"\n" +
+	"comparison\x18\x04 \x01(\tR\n" +
+	"comparison\x12\x1c\n" +
+	"\tthreshold\x18\x05 \x01(\x01R\tthreshold\x12)\n" +
+	"\x10duration_seconds\x18\x06 \x01(\x05R\x0fdurationSeconds\x12\x1b\n" +
+	"\tis_active\x18\a \x01(\bR\bisActive\"\x90\x02\n" +
+	"\bIncident\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\tR\x06ruleId\x12\x1b\n" +
+	"\trule_name\x18\x03 \x01(\tR\bruleName\x12\x16\n" +
+	"\x06metric\x18\x04 \x01(\tR\x06metric\x12\x1e\n" +
+	"\n" +
+	"comparison\x18\x05 \x01(\tR\n" +
+	"comparison\x12\x1c\n" +
+	"\tthreshold\x18\x06 \x01(\x01R\tthreshold\x12\x14\n" +
+	"\x05value\x18\a \x01(\x01R\x05value\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\b \x01(\tR\tstartedAt\x12\x1f\n" +
+	"\vresolved_at\x18\t \x01(\tR\n" +
+	"resolvedAt\x12\x12\n" +
+	"\x04host\x18\n" +
+	" \x01(\tR\x04host\"\x17\n" +
+	"\x15ListAlertRulesRequest\"D\n" +
+	"\x16ListAlertRulesResponse\x12*\n" +
+	"\x05rules\x18\x01 \x03(\v2\x14.pulsar.v1.AlertRuleR\x05rules\"B\n" +
+	"\x16CreateAlertRuleRequest\x12(\n" +
+	"\x04rule\x18\x01 \x01(\v2\x14.pulsar.v1.AlertRuleR\x04rule\"C\n" +
+	"\x17CreateAlertRuleResponse\x12(\n" +
+	"\x04rule\x18\x01 \x01(\v2\x14.pulsar.v1.AlertRuleR\x04rule\"B\n" +
+	"\x16UpdateAlertRuleRequest\x12(\n" +
+	"\x04rule\x18\x01 \x01(\v2\x14.pulsar.v1.AlertRuleR\x04rule\"C\n" +
+	"\x17UpdateAlertRuleResponse\x12(\n" +
+	"\x04rule\x18\x01 \x01(\v2\x14.pulsar.v1.AlertRuleR\x04rule\"1\n" +
+	"\x16DeleteAlertRuleRequest\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\"3\n" +
+	"\x17DeleteAlertRuleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x16\n" +
+	"\x14ListIncidentsRequest\"J\n" +
+	"\x15ListIncidentsResponse\x121\n" +
+	"\tincidents\x18\x01 \x03(\v2\x13.pulsar.v1.IncidentR\tincidents\"H\n" +
+	"\x05Agent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04host\x18\x02 \x01(\tR\x04host\x12\x1b\n" +
+	"\tlast_seen\x18\x03 \x01(\tR\blastSeen\"*\n" +
+	"\x14RegisterAgentRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\"U\n" +
+	"\x15RegisterAgentResponse\x12&\n" +
+	"\x05agent\x18\x01 \x01(\v2\x10.pulsar.v1.AgentR\x05agent\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x13\n" +
+	"\x11ListAgentsRequest\">\n" +
+	"\x12ListAgentsResponse\x12(\n" +
+	"\x06agents\x18\x01 \x03(\v2\x10.pulsar.v1.AgentR\x06agents\"/\n" +
+	"\x12DeleteAgentRequest\x12\x19\n" +
+	"\bagent_id\x18\x01 \x01(\tR\aagentId\"/\n" +
+	"\x13DeleteAgentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb2\x01\n" +
+	"\x06Secret\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03ref\x18\x03 \x01(\tR\x03ref\x12\x1d\n" +
+	"\n" +
+	"monitor_id\x18\x04 \x01(\tR\tmonitorId\x12\x15\n" +
+	"\x06key_id\x18\x05 \x01(\tR\x05keyId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\"\x14\n" +
+	"\x12ListSecretsRequest\"B\n" +
+	"\x13ListSecretsResponse\x12+\n" +
+	"\asecrets\x18\x01 \x03(\v2\x11.pulsar.v1.SecretR\asecrets\"?\n" +
+	"\x13CreateSecretRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"A\n" +
+	"\x14CreateSecretResponse\x12)\n" +
+	"\x06secret\x18\x01 \x01(\v2\x11.pulsar.v1.SecretR\x06secret\"H\n" +
+	"\x13UpdateSecretRequest\x12\x1b\n" +
+	"\tsecret_id\x18\x01 \x01(\tR\bsecretId\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"A\n" +
+	"\x14UpdateSecretResponse\x12)\n" +
+	"\x06secret\x18\x01 \x01(\v2\x11.pulsar.v1.SecretR\x06secret\"2\n" +
+	"\x13DeleteSecretRequest\x12\x1b\n" +
+	"\tsecret_id\x18\x01 \x01(\tR\bsecretId\"0\n" +
+	"\x14DeleteSecretResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xda\x05\n" +
+	"\fSystemSample\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\x03R\x04time\x12\x1f\n" +
+	"\vcpu_percent\x18\x02 \x01(\x01R\n" +
+	"cpuPercent\x12\x1b\n" +
+	"\tcpu_cores\x18\x03 \x03(\x01R\bcpuCores\x12*\n" +
+	"\x04load\x18\x04 \x01(\v2\x16.pulsar.v1.LoadAverageR\x04load\x12%\n" +
+	"\x0ememory_percent\x18\x05 \x01(\x01R\rmemoryPercent\x12\x1f\n" +
+	"\vmemory_used\x18\x06 \x01(\x04R\n" +
+	"memoryUsed\x12!\n" +
+	"\fmemory_total\x18\a \x01(\x04R\vmemoryTotal\x12!\n" +
+	"\fdisk_percent\x18\b \x01(\x01R\vdiskPercent\x12\x1b\n" +
+	"\tdisk_used\x18\t \x01(\x04R\bdiskUsed\x12\x1d\n" +
+	"\n" +
+	"disk_total\x18\n" +
+	" \x01(\x04R\tdiskTotal\x12\x1f\n" +
+	"\vnetwork_kbs\x18\v \x01(\x01R\n" +
+	"networkKbs\x12+\n" +
+	"\x05disks\x18\f \x03(\v2\x15.pulsar.v1.DiskSampleR\x05disks\x129\n" +
+	"\n" +
+	"interfaces\x18\r \x03(\v2\x19.pulsar.v1.InterfaceUsageR\n" +
+	"interfaces\x124\n" +
+	"\tprocesses\x18\x0e \x01(\v2\x16.pulsar.v1.ThreadUsageR\tprocesses\x12/\n" +
+	"\atop_cpu\x18\x0f \x03(\v2\x16.pulsar.v1.ProcessInfoR\x06topCpu\x125\n" +
+	"\n" +
+	"top_memory\x18\x10 \x03(\v2\x16.pulsar.v1.ProcessInfoR\ttopMemory\x12)\n" +
+	"\x04info\x18\x11 \x01(\v2\x15.pulsar.v1.SystemInfoR\x04info\x120\n" +
+	"\acgroups\x18\x12 \x03(\v2\x16.pulsar.v1.CgroupUsageR\acgroups\"\xd8\x01\n" +
+	"\n" +
+	"DiskSample\x12\x1e\n" +
+	"\n" +
+	"mountpoint\x18\x01 \x01(\tR\n" +
+	"mountpoint\x12\x16\n" +
+	"\x06device\x18\x02 \x01(\tR\x06device\x12\x16\n" +
+	"\x06fstype\x18\x03 \x01(\tR\x06fstype\x12\x12\n" +
+	"\x04used\x18\x04 \x01(\x04R\x04used\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x04R\x05total\x12\x18\n" +
+	"\apercent\x18\x06 \x01(\x01R\apercent\x12\x19\n" +
+	"\bread_kbs\x18\a \x01(\x01R\areadKbs\x12\x1b\n" +
+	"\twrite_kbs\x18\b \x01(\x01R\bwriteKbs\"K\n" +
+	"\x18IngestSystemStatsRequest\x12/\n" +
+	"\x06sample\x18\x01 \x01(\v2\x17.pulsar.v1.SystemSampleR\x06sample\"\x1b\n" +
+	"\x19IngestSystemStatsResponse2\xe0\x0f\n" +
 	"\x0eMonitorService\x12R\n" +
 	"\rCreateMonitor\x12\x1f.pulsar.v1.CreateMonitorRequest\x1a .pulsar.v1.CreateMonitorResponse\x12O\n" +
 	"\fListMonitors\x12\x1e.pulsar.v1.ListMonitorsRequest\x1a\x1f.pulsar.v1.ListMonitorsResponse\x12R\n" +
-	"\rDeleteMonitor\x12\x1f.pulsar.v1.DeleteMonitorRequest\x1a .pulsar.v1.DeleteMonitorResponse\x12X\n" +
-	"\x0fGetMonitorStats\x12!.pulsar.v1.GetMonitorStatsRequest\x1a\".pulsar.v1.GetMonitorStatsResponse\x12J\n" +
-	"\x0eGetSystemStats\x12\x16.google.protobuf.Empty\x1a\x1e.pulsar.v1.SystemStatsResponse0\x01B3Z1github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1b\x06proto3"
+	"\rDeleteMonitor\x12\x1f.pulsar.v1.DeleteMonitorRequest\x1a .pulsar.v1.DeleteMonitorResponse\x12j\n" +
+	"\x15SetMonitorCredentials\x12'.pulsar.v1.SetMonitorCredentialsRequest\x1a(.pulsar.v1.SetMonitorCredentialsResponse\x12X\n" +
+	"\x0fGetMonitorStats\x12!.pulsar.v1.GetMonitorStatsRequest\x1a\".pulsar.v1.GetMonitorStatsResponse\x12T\n" +
+	"\x0eGetSystemStats\x12 .pulsar.v1.GetSystemStatsRequest\x1a\x1e.pulsar.v1.SystemStatsResponse0\x01\x12j\n" +
+	"\x15GetSystemStatsHistory\x12'.pulsar.v1.GetSystemStatsHistoryRequest\x1a(.pulsar.v1.GetSystemStatsHistoryResponse\x12L\n" +
+	"\rWatchMonitors\x12\x1f.pulsar.v1.WatchMonitorsRequest\x1a\x18.pulsar.v1.MonitorUpdate0\x01\x12a\n" +
+	"\x12GetProcessSnapshot\x12$.pulsar.v1.GetProcessSnapshotRequest\x1a%.pulsar.v1.GetProcessSnapshotResponse\x12U\n" +
+	"\x0eGetCgroupStats\x12 .pulsar.v1.GetCgroupStatsRequest\x1a!.pulsar.v1.GetCgroupStatsResponse\x12U\n" +
+	"\x0eListAlertRules\x12 .pulsar.v1.ListAlertRulesRequest\x1a!.pulsar.v1.ListAlertRulesResponse\x12X\n" +
+	"\x0fCreateAlertRule\x12!.pulsar.v1.CreateAlertRuleRequest\x1a\".pulsar.v1.CreateAlertRuleResponse\x12X\n" +
+	"\x0fUpdateAlertRule\x12!.pulsar.v1.UpdateAlertRuleRequest\x1a\".pulsar.v1.UpdateAlertRuleResponse\x12X\n" +
+	"\x0fDeleteAlertRule\x12!.pulsar.v1.DeleteAlertRuleRequest\x1a\".pulsar.v1.DeleteAlertRuleResponse\x12R\n" +
+	"\rListIncidents\x12\x1f.pulsar.v1.ListIncidentsRequest\x1a .pulsar.v1.ListIncidentsResponse\x12R\n" +
+	"\rRegisterAgent\x12\x1f.pulsar.v1.RegisterAgentRequest\x1a .pulsar.v1.RegisterAgentResponse\x12I\n" +
+	"\n" +
+	"ListAgents\x12\x1c.pulsar.v1.ListAgentsRequest\x1a\x1d.pulsar.v1.ListAgentsResponse\x12L\n" +
+	"\vDeleteAgent\x12\x1d.pulsar.v1.DeleteAgentRequest\x1a\x1e.pulsar.v1.DeleteAgentResponse\x12^\n" +
+	"\x11IngestSystemStats\x12#.pulsar.v1.IngestSystemStatsRequest\x1a$.pulsar.v1.IngestSystemStatsResponse\x12L\n" +
+	"\vListSecrets\x12\x1d.pulsar.v1.ListSecretsRequest\x1a\x1e.pulsar.v1.ListSecretsResponse\x12O\n" +
+	"\fCreateSecret\x12\x1e.pulsar.v1.CreateSecretRequest\x1a\x1f.pulsar.v1.CreateSecretResponse\x12O\n" +
+	"\fUpdateSecret\x12\x1e.pulsar.v1.UpdateSecretRequest\x1a\x1f.pulsar.v1.UpdateSecretResponse\x12O\n" +
+	"\fDeleteSecret\x12\x1e.pulsar.v1.DeleteSecretRequest\x1a\x1f.pulsar.v1.DeleteSecretResponseB3Z1github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1b\x06proto3"
 
 var (
 	file_proto_pulsar_v1_monitor_proto_rawDescOnce sync.Once
@@ -1098,53 +5851,218 @@ func file_proto_pulsar_v1_monitor_proto_rawDescGZIP() []byte {
 	return file_proto_pulsar_v1_monitor_proto_rawDescData
 }
 
-var file_proto_pulsar_v1_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_proto_pulsar_v1_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 82)
 var file_proto_pulsar_v1_monitor_proto_goTypes = []any{
-	(*Monitor)(nil),                 // 0: pulsar.v1.Monitor
-	(*CreateMonitorRequest)(nil),    // 1: pulsar.v1.CreateMonitorRequest
-	(*CreateMonitorResponse)(nil),   // 2: pulsar.v1.CreateMonitorResponse
-	(*ListMonitorsRequest)(nil),     // 3: pulsar.v1.ListMonitorsRequest
-	(*ListMonitorsResponse)(nil),    // 4: pulsar.v1.ListMonitorsResponse
-	(*DeleteMonitorRequest)(nil),    // 5: pulsar.v1.DeleteMonitorRequest
-	(*DeleteMonitorResponse)(nil),   // 6: pulsar.v1.DeleteMonitorResponse
-	(*GetMonitorStatsRequest)(nil),  // 7: pulsar.v1.GetMonitorStatsRequest
-	(*GetMonitorStatsResponse)(nil), // 8: pulsar.v1.GetMonitorStatsResponse
-	(*MonitorStat)(nil),             // 9: pulsar.v1.MonitorStat
-	(*MonitorTiming)(nil),           // 10: pulsar.v1.MonitorTiming
-	(*SystemStatsResponse)(nil),     // 11: pulsar.v1.SystemStatsResponse
-	(*ThreadUsage)(nil),             // 12: pulsar.v1.ThreadUsage
-	(*ThreadHistory)(nil),           // 13: pulsar.v1.ThreadHistory
-	(*ResourceUsage)(nil),           // 14: pulsar.v1.ResourceUsage
-	(*SystemInfo)(nil),              // 15: pulsar.v1.SystemInfo
-	(*emptypb.Empty)(nil),           // 16: google.protobuf.Empty
+	(*Monitor)(nil),                       // 0: pulsar.v1.Monitor
+	(*IcmpConfig)(nil),                    // 1: pulsar.v1.IcmpConfig
+	(*PushConfig)(nil),                    // 2: pulsar.v1.PushConfig
+	(*RedirectPolicy)(nil),                // 3: pulsar.v1.RedirectPolicy
+	(*ContentCheck)(nil),                  // 4: pulsar.v1.ContentCheck
+	(*GrpcConfig)(nil),                    // 5: pulsar.v1.GrpcConfig
+	(*ScriptConfig)(nil),                  // 6: pulsar.v1.ScriptConfig
+	(*ScriptStep)(nil),                    // 7: pulsar.v1.ScriptStep
+	(*ScriptExtract)(nil),                 // 8: pulsar.v1.ScriptExtract
+	(*ScriptAssert)(nil),                  // 9: pulsar.v1.ScriptAssert
+	(*CreateMonitorRequest)(nil),          // 10: pulsar.v1.CreateMonitorRequest
+	(*ProbeCredentials)(nil),              // 11: pulsar.v1.ProbeCredentials
+	(*CredentialsInfo)(nil),               // 12: pulsar.v1.CredentialsInfo
+	(*CreateMonitorResponse)(nil),         // 13: pulsar.v1.CreateMonitorResponse
+	(*ListMonitorsRequest)(nil),           // 14: pulsar.v1.ListMonitorsRequest
+	(*ListMonitorsResponse)(nil),          // 15: pulsar.v1.ListMonitorsResponse
+	(*DeleteMonitorRequest)(nil),          // 16: pulsar.v1.DeleteMonitorRequest
+	(*DeleteMonitorResponse)(nil),         // 17: pulsar.v1.DeleteMonitorResponse
+	(*SetMonitorCredentialsRequest)(nil),  // 18: pulsar.v1.SetMonitorCredentialsRequest
+	(*SetMonitorCredentialsResponse)(nil), // 19: pulsar.v1.SetMonitorCredentialsResponse
+	(*GetMonitorStatsRequest)(nil),        // 20: pulsar.v1.GetMonitorStatsRequest
+	(*GetMonitorStatsResponse)(nil),       // 21: pulsar.v1.GetMonitorStatsResponse
+	(*MonitorStat)(nil),                   // 22: pulsar.v1.MonitorStat
+	(*WatchMonitorsRequest)(nil),          // 23: pulsar.v1.WatchMonitorsRequest
+	(*MonitorUpdate)(nil),                 // 24: pulsar.v1.MonitorUpdate
+	(*IcmpStats)(nil),                     // 25: pulsar.v1.IcmpStats
+	(*RedirectHop)(nil),                   // 26: pulsar.v1.RedirectHop
+	(*ContentChange)(nil),                 // 27: pulsar.v1.ContentChange
+	(*StepResult)(nil),                    // 28: pulsar.v1.StepResult
+	(*MonitorTiming)(nil),                 // 29: pulsar.v1.MonitorTiming
+	(*GetSystemStatsRequest)(nil),         // 30: pulsar.v1.GetSystemStatsRequest
+	(*GetSystemStatsHistoryRequest)(nil),  // 31: pulsar.v1.GetSystemStatsHistoryRequest
+	(*GetSystemStatsHistoryResponse)(nil), // 32: pulsar.v1.GetSystemStatsHistoryResponse
+	(*StatSeries)(nil),                    // 33: pulsar.v1.StatSeries
+	(*SystemStatsResponse)(nil),           // 34: pulsar.v1.SystemStatsResponse
+	(*ProcessInfo)(nil),                   // 35: pulsar.v1.ProcessInfo
+	(*GetProcessSnapshotRequest)(nil),     // 36: pulsar.v1.GetProcessSnapshotRequest
+	(*GetProcessSnapshotResponse)(nil),    // 37: pulsar.v1.GetProcessSnapshotResponse
+	(*CgroupUsage)(nil),                   // 38: pulsar.v1.CgroupUsage
+	(*CgroupPoint)(nil),                   // 39: pulsar.v1.CgroupPoint
+	(*GetCgroupStatsRequest)(nil),         // 40: pulsar.v1.GetCgroupStatsRequest
+	(*GetCgroupStatsResponse)(nil),        // 41: pulsar.v1.GetCgroupStatsResponse
+	(*DiskUsage)(nil),                     // 42: pulsar.v1.DiskUsage
+	(*InterfaceUsage)(nil),                // 43: pulsar.v1.InterfaceUsage
+	(*LoadAverage)(nil),                   // 44: pulsar.v1.LoadAverage
+	(*ThreadUsage)(nil),                   // 45: pulsar.v1.ThreadUsage
+	(*ThreadHistory)(nil),                 // 46: pulsar.v1.ThreadHistory
+	(*ResourceUsage)(nil),                 // 47: pulsar.v1.ResourceUsage
+	(*SystemInfo)(nil),                    // 48: pulsar.v1.SystemInfo
+	(*AlertRule)(nil),                     // 49: pulsar.v1.AlertRule
+	(*Incident)(nil),                      // 50: pulsar.v1.Incident
+	(*ListAlertRulesRequest)(nil),         // 51: pulsar.v1.ListAlertRulesRequest
+	(*ListAlertRulesResponse)(nil),        // 52: pulsar.v1.ListAlertRulesResponse
+	(*CreateAlertRuleRequest)(nil),        // 53: pulsar.v1.CreateAlertRuleRequest
+	(*CreateAlertRuleResponse)(nil),       // 54: pulsar.v1.CreateAlertRuleResponse
+	(*UpdateAlertRuleRequest)(nil),        // 55: pulsar.v1.UpdateAlertRuleRequest
+	(*UpdateAlertRuleResponse)(nil),       // 56: pulsar.v1.UpdateAlertRuleResponse
+	(*DeleteAlertRuleRequest)(nil),        // 57: pulsar.v1.DeleteAlertRuleRequest
+	(*DeleteAlertRuleResponse)(nil),       // 58: pulsar.v1.DeleteAlertRuleResponse
+	(*ListIncidentsRequest)(nil),          // 59: pulsar.v1.ListIncidentsRequest
+	(*ListIncidentsResponse)(nil),         // 60: pulsar.v1.ListIncidentsResponse
+	(*Agent)(nil),                         // 61: pulsar.v1.Agent
+	(*RegisterAgentRequest)(nil),          // 62: pulsar.v1.RegisterAgentRequest
+	(*RegisterAgentResponse)(nil),         // 63: pulsar.v1.RegisterAgentResponse
+	(*ListAgentsRequest)(nil),             // 64: pulsar.v1.ListAgentsRequest
+	(*ListAgentsResponse)(nil),            // 65: pulsar.v1.ListAgentsResponse
+	(*DeleteAgentRequest)(nil),            // 66: pulsar.v1.DeleteAgentRequest
+	(*DeleteAgentResponse)(nil),           // 67: pulsar.v1.DeleteAgentResponse
+	(*Secret)(nil),                        // 68: pulsar.v1.Secret
+	(*ListSecretsRequest)(nil),            // 69: pulsar.v1.ListSecretsRequest
+	(*ListSecretsResponse)(nil),           // 70: pulsar.v1.ListSecretsResponse
+	(*CreateSecretRequest)(nil),           // 71: pulsar.v1.CreateSecretRequest
+	(*CreateSecretResponse)(nil),          // 72: pulsar.v1.CreateSecretResponse
+	(*UpdateSecretRequest)(nil),           // 73: pulsar.v1.UpdateSecretRequest
+	(*UpdateSecretResponse)(nil),          // 74: pulsar.v1.UpdateSecretResponse
+	(*DeleteSecretRequest)(nil),           // 75: pulsar.v1.DeleteSecretRequest
+	(*DeleteSecretResponse)(nil),          // 76: pulsar.v1.DeleteSecretResponse
+	(*SystemSample)(nil),                  // 77: pulsar.v1.SystemSample
+	(*DiskSample)(nil),                    // 78: pulsar.v1.DiskSample
+	(*IngestSystemStatsRequest)(nil),      // 79: pulsar.v1.IngestSystemStatsRequest
+	(*IngestSystemStatsResponse)(nil),     // 80: pulsar.v1.IngestSystemStatsResponse
+	nil,                                   // 81: pulsar.v1.ScriptStep.HeadersEntry
 }
 var file_proto_pulsar_v1_monitor_proto_depIdxs = []int32{
-	0,  // 0: pulsar.v1.CreateMonitorResponse.monitor:type_name -> pulsar.v1.Monitor
-	0,  // 1: pulsar.v1.ListMonitorsResponse.monitors:type_name -> pulsar.v1.Monitor
-	9,  // 2: pulsar.v1.GetMonitorStatsResponse.stats:type_name -> pulsar.v1.MonitorStat
-	10, // 3: pulsar.v1.MonitorStat.timing:type_name -> pulsar.v1.MonitorTiming
-	14, // 4: pulsar.v1.SystemStatsResponse.cpu:type_name -> pulsar.v1.ResourceUsage
-	14, // 5: pulsar.v1.SystemStatsResponse.memory:type_name -> pulsar.v1.ResourceUsage
-	14, // 6: pulsar.v1.SystemStatsResponse.disk:type_name -> pulsar.v1.ResourceUsage
-	14, // 7: pulsar.v1.SystemStatsResponse.network:type_name -> pulsar.v1.ResourceUsage
-	12, // 8: pulsar.v1.SystemStatsResponse.threads:type_name -> pulsar.v1.ThreadUsage
-	15, // 9: pulsar.v1.SystemStatsResponse.info:type_name -> pulsar.v1.SystemInfo
-	13, // 10: pulsar.v1.ThreadUsage.history:type_name -> pulsar.v1.ThreadHistory
-	1,  // 11: pulsar.v1.MonitorService.CreateMonitor:input_type -> pulsar.v1.CreateMonitorRequest
-	3,  // 12: pulsar.v1.MonitorService.ListMonitors:input_type -> pulsar.v1.ListMonitorsRequest
-	5,  // 13: pulsar.v1.MonitorService.DeleteMonitor:input_type -> pulsar.v1.DeleteMonitorRequest
-	7,  // 14: pulsar.v1.MonitorService.GetMonitorStats:input_type -> pulsar.v1.GetMonitorStatsRequest
-	16, // 15: pulsar.v1.MonitorService.GetSystemStats:input_type -> google.protobuf.Empty
-	2,  // 16: pulsar.v1.MonitorService.CreateMonitor:output_type -> pulsar.v1.CreateMonitorResponse
-	4,  // 17: pulsar.v1.MonitorService.ListMonitors:output_type -> pulsar.v1.ListMonitorsResponse
-	6,  // 18: pulsar.v1.MonitorService.DeleteMonitor:output_type -> pulsar.v1.DeleteMonitorResponse
-	8,  // 19: pulsar.v1.MonitorService.GetMonitorStats:output_type -> pulsar.v1.GetMonitorStatsResponse
-	11, // 20: pulsar.v1.MonitorService.GetSystemStats:output_type -> pulsar.v1.SystemStatsResponse
-	16, // [16:21] is the sub-list for method output_type
-	11, // [11:16] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	1,  // 0: pulsar.v1.Monitor.icmp:type_name -> pulsar.v1.IcmpConfig
+	2,  // 1: pulsar.v1.Monitor.push:type_name -> pulsar.v1.PushConfig
+	5,  // 2: pulsar.v1.Monitor.grpc:type_name -> pulsar.v1.GrpcConfig
+	6,  // 3: pulsar.v1.Monitor.script:type_name -> pulsar.v1.ScriptConfig
+	4,  // 4: pulsar.v1.Monitor.content:type_name -> pulsar.v1.ContentCheck
+	3,  // 5: pulsar.v1.Monitor.redirects:type_name -> pulsar.v1.RedirectPolicy
+	12, // 6: pulsar.v1.Monitor.credentials:type_name -> pulsar.v1.CredentialsInfo
+	7,  // 7: pulsar.v1.ScriptConfig.steps:type_name -> pulsar.v1.ScriptStep
+	81, // 8: pulsar.v1.ScriptStep.headers:type_name -> pulsar.v1.ScriptStep.HeadersEntry
+	8,  // 9: pulsar.v1.ScriptStep.extract:type_name -> pulsar.v1.ScriptExtract
+	9,  // 10: pulsar.v1.ScriptStep.assert:type_name -> pulsar.v1.ScriptAssert
+	1,  // 11: pulsar.v1.CreateMonitorRequest.icmp:type_name -> pulsar.v1.IcmpConfig
+	2,  // 12: pulsar.v1.CreateMonitorRequest.push:type_name -> pulsar.v1.PushConfig
+	5,  // 13: pulsar.v1.CreateMonitorRequest.grpc:type_name -> pulsar.v1.GrpcConfig
+	6,  // 14: pulsar.v1.CreateMonitorRequest.script:type_name -> pulsar.v1.ScriptConfig
+	4,  // 15: pulsar.v1.CreateMonitorRequest.content:type_name -> pulsar.v1.ContentCheck
+	3,  // 16: pulsar.v1.CreateMonitorRequest.redirects:type_name -> pulsar.v1.RedirectPolicy
+	11, // 17: pulsar.v1.CreateMonitorRequest.credentials:type_name -> pulsar.v1.ProbeCredentials
+	0,  // 18: pulsar.v1.CreateMonitorResponse.monitor:type_name -> pulsar.v1.Monitor
+	0,  // 19: pulsar.v1.ListMonitorsResponse.monitors:type_name -> pulsar.v1.Monitor
+	11, // 20: pulsar.v1.SetMonitorCredentialsRequest.credentials:type_name -> pulsar.v1.ProbeCredentials
+	0,  // 21: pulsar.v1.SetMonitorCredentialsResponse.monitor:type_name -> pulsar.v1.Monitor
+	22, // 22: pulsar.v1.GetMonitorStatsResponse.stats:type_name -> pulsar.v1.MonitorStat
+	29, // 23: pulsar.v1.MonitorStat.timing:type_name -> pulsar.v1.MonitorTiming
+	25, // 24: pulsar.v1.MonitorStat.icmp:type_name -> pulsar.v1.IcmpStats
+	28, // 25: pulsar.v1.MonitorStat.steps:type_name -> pulsar.v1.StepResult
+	26, // 26: pulsar.v1.MonitorStat.redirects:type_name -> pulsar.v1.RedirectHop
+	29, // 27: pulsar.v1.MonitorUpdate.timing:type_name -> pulsar.v1.MonitorTiming
+	25, // 28: pulsar.v1.MonitorUpdate.icmp:type_name -> pulsar.v1.IcmpStats
+	28, // 29: pulsar.v1.MonitorUpdate.steps:type_name -> pulsar.v1.StepResult
+	26, // 30: pulsar.v1.MonitorUpdate.redirects:type_name -> pulsar.v1.RedirectHop
+	29, // 31: pulsar.v1.RedirectHop.timing:type_name -> pulsar.v1.MonitorTiming
+	29, // 32: pulsar.v1.StepResult.timing:type_name -> pulsar.v1.MonitorTiming
+	33, // 33: pulsar.v1.GetSystemStatsHistoryResponse.cpu:type_name -> pulsar.v1.StatSeries
+	33, // 34: pulsar.v1.GetSystemStatsHistoryResponse.memory:type_name -> pulsar.v1.StatSeries
+	33, // 35: pulsar.v1.GetSystemStatsHistoryResponse.disk:type_name -> pulsar.v1.StatSeries
+	33, // 36: pulsar.v1.GetSystemStatsHistoryResponse.network:type_name -> pulsar.v1.StatSeries
+	33, // 37: pulsar.v1.GetSystemStatsHistoryResponse.threads:type_name -> pulsar.v1.StatSeries
+	33, // 38: pulsar.v1.GetSystemStatsHistoryResponse.load1:type_name -> pulsar.v1.StatSeries
+	47, // 39: pulsar.v1.SystemStatsResponse.cpu:type_name -> pulsar.v1.ResourceUsage
+	47, // 40: pulsar.v1.SystemStatsResponse.memory:type_name -> pulsar.v1.ResourceUsage
+	47, // 41: pulsar.v1.SystemStatsResponse.disk:type_name -> pulsar.v1.ResourceUsage
+	47, // 42: pulsar.v1.SystemStatsResponse.network:type_name -> pulsar.v1.ResourceUsage
+	45, // 43: pulsar.v1.SystemStatsResponse.threads:type_name -> pulsar.v1.ThreadUsage
+	48, // 44: pulsar.v1.SystemStatsResponse.info:type_name -> pulsar.v1.SystemInfo
+	42, // 45: pulsar.v1.SystemStatsResponse.disks:type_name -> pulsar.v1.DiskUsage
+	43, // 46: pulsar.v1.SystemStatsResponse.interfaces:type_name -> pulsar.v1.InterfaceUsage
+	44, // 47: pulsar.v1.SystemStatsResponse.load:type_name -> pulsar.v1.LoadAverage
+	35, // 48: pulsar.v1.SystemStatsResponse.top_cpu:type_name -> pulsar.v1.ProcessInfo
+	35, // 49: pulsar.v1.SystemStatsResponse.top_memory:type_name -> pulsar.v1.ProcessInfo
+	38, // 50: pulsar.v1.SystemStatsResponse.cgroups:type_name -> pulsar.v1.CgroupUsage
+	35, // 51: pulsar.v1.GetProcessSnapshotResponse.processes:type_name -> pulsar.v1.ProcessInfo
+	39, // 52: pulsar.v1.CgroupUsage.history:type_name -> pulsar.v1.CgroupPoint
+	38, // 53: pulsar.v1.GetCgroupStatsResponse.cgroups:type_name -> pulsar.v1.CgroupUsage
+	46, // 54: pulsar.v1.ThreadUsage.history:type_name -> pulsar.v1.ThreadHistory
+	49, // 55: pulsar.v1.ListAlertRulesResponse.rules:type_name -> pulsar.v1.AlertRule
+	49, // 56: pulsar.v1.CreateAlertRuleRequest.rule:type_name -> pulsar.v1.AlertRule
+	49, // 57: pulsar.v1.CreateAlertRuleResponse.rule:type_name -> pulsar.v1.AlertRule
+	49, // 58: pulsar.v1.UpdateAlertRuleRequest.rule:type_name -> pulsar.v1.AlertRule
+	49, // 59: pulsar.v1.UpdateAlertRuleResponse.rule:type_name -> pulsar.v1.AlertRule
+	50, // 60: pulsar.v1.ListIncidentsResponse.incidents:type_name -> pulsar.v1.Incident
+	61, // 61: pulsar.v1.RegisterAgentResponse.agent:type_name -> pulsar.v1.Agent
+	61, // 62: pulsar.v1.ListAgentsResponse.agents:type_name -> pulsar.v1.Agent
+	68, // 63: pulsar.v1.ListSecretsResponse.secrets:type_name -> pulsar.v1.Secret
+	68, // 64: pulsar.v1.CreateSecretResponse.secret:type_name -> pulsar.v1.Secret
+	68, // 65: pulsar.v1.UpdateSecretResponse.secret:type_name -> pulsar.v1.Secret
+	44, // 66: pulsar.v1.SystemSample.load:type_name -> pulsar.v1.LoadAverage
+	78, // 67: pulsar.v1.SystemSample.disks:type_name -> pulsar.v1.DiskSample
+	43, // 68: pulsar.v1.SystemSample.interfaces:type_name -> pulsar.v1.InterfaceUsage
+	45, // 69: pulsar.v1.SystemSample.processes:type_name -> pulsar.v1.ThreadUsage
+	35, // 70: pulsar.v1.SystemSample.top_cpu:type_name -> pulsar.v1.ProcessInfo
+	35, // 71: pulsar.v1.SystemSample.top_memory:type_name -> pulsar.v1.ProcessInfo
+	48, // 72: pulsar.v1.SystemSample.info:type_name -> pulsar.v1.SystemInfo
+	38, // 73: pulsar.v1.SystemSample.cgroups:type_name -> pulsar.v1.CgroupUsage
+	77, // 74: pulsar.v1.IngestSystemStatsRequest.sample:type_name -> pulsar.v1.SystemSample
+	10, // 75: pulsar.v1.MonitorService.CreateMonitor:input_type -> pulsar.v1.CreateMonitorRequest
+	14, // 76: pulsar.v1.MonitorService.ListMonitors:input_type -> pulsar.v1.ListMonitorsRequest
+	16, // 77: pulsar.v1.MonitorService.DeleteMonitor:input_type -> pulsar.v1.DeleteMonitorRequest
+	18, // 78: pulsar.v1.MonitorService.SetMonitorCredentials:input_type -> pulsar.v1.SetMonitorCredentialsRequest
+	20, // 79: pulsar.v1.MonitorService.GetMonitorStats:input_type -> pulsar.v1.GetMonitorStatsRequest
+	30, // 80: pulsar.v1.MonitorService.GetSystemStats:input_type -> pulsar.v1.GetSystemStatsRequest
+	31, // 81: pulsar.v1.MonitorService.GetSystemStatsHistory:input_type -> pulsar.v1.GetSystemStatsHistoryRequest
+	23, // 82: pulsar.v1.MonitorService.WatchMonitors:input_type -> pulsar.v1.WatchMonitorsRequest
+	36, // 83: pulsar.v1.MonitorService.GetProcessSnapshot:input_type -> pulsar.v1.GetProcessSnapshotRequest
+	40, // 84: pulsar.v1.MonitorService.GetCgroupStats:input_type -> pulsar.v1.GetCgroupStatsRequest
+	51, // 85: pulsar.v1.MonitorService.ListAlertRules:input_type -> pulsar.v1.ListAlertRulesRequest
+	53, // 86: pulsar.v1.MonitorService.CreateAlertRule:input_type -> pulsar.v1.CreateAlertRuleRequest
+	55, // 87: pulsar.v1.MonitorService.UpdateAlertRule:input_type -> pulsar.v1.UpdateAlertRuleRequest
+	57, // 88: pulsar.v1.MonitorService.DeleteAlertRule:input_type -> pulsar.v1.DeleteAlertRuleRequest
+	59, // 89: pulsar.v1.MonitorService.ListIncidents:input_type -> pulsar.v1.ListIncidentsRequest
+	62, // 90: pulsar.v1.MonitorService.RegisterAgent:input_type -> pulsar.v1.RegisterAgentRequest
+	64, // 91: pulsar.v1.MonitorService.ListAgents:input_type -> pulsar.v1.ListAgentsRequest
+	66, // 92: pulsar.v1.MonitorService.DeleteAgent:input_type -> pulsar.v1.DeleteAgentRequest
+	79, // 93: pulsar.v1.MonitorService.IngestSystemStats:input_type -> pulsar.v1.IngestSystemStatsRequest
+	69, // 94: pulsar.v1.MonitorService.ListSecrets:input_type -> pulsar.v1.ListSecretsRequest
+	71, // 95: pulsar.v1.MonitorService.CreateSecret:input_type -> pulsar.v1.CreateSecretRequest
+	73, // 96: pulsar.v1.MonitorService.UpdateSecret:input_type -> pulsar.v1.UpdateSecretRequest
+	75, // 97: pulsar.v1.MonitorService.DeleteSecret:input_type -> pulsar.v1.DeleteSecretRequest
+	13, // 98: pulsar.v1.MonitorService.CreateMonitor:output_type -> pulsar.v1.CreateMonitorResponse
+	15, // 99: pulsar.v1.MonitorService.ListMonitors:output_type -> pulsar.v1.ListMonitorsResponse
+	17, // 100: pulsar.v1.MonitorService.DeleteMonitor:output_type -> pulsar.v1.DeleteMonitorResponse
+	19, // 101: pulsar.v1.MonitorService.SetMonitorCredentials:output_type -> pulsar.v1.SetMonitorCredentialsResponse
+	21, // 102: pulsar.v1.MonitorService.GetMonitorStats:output_type -> pulsar.v1.GetMonitorStatsResponse
+	34, // 103: pulsar.v1.MonitorService.GetSystemStats:output_type -> pulsar.v1.SystemStatsResponse
+	32, // 104: pulsar.v1.MonitorService.GetSystemStatsHistory:output_type -> pulsar.v1.GetSystemStatsHistoryResponse
+	24, // 105: pulsar.v1.MonitorService.WatchMonitors:output_type -> pulsar.v1.MonitorUpdate
+	37, // 106: pulsar.v1.MonitorService.GetProcessSnapshot:output_type -> pulsar.v1.GetProcessSnapshotResponse
+	41, // 107: pulsar.v1.MonitorService.GetCgroupStats:output_type -> pulsar.v1.GetCgroupStatsResponse
+	52, // 108: pulsar.v1.MonitorService.ListAlertRules:output_type -> pulsar.v1.ListAlertRulesResponse
+	54, // 109: pulsar.v1.MonitorService.CreateAlertRule:output_type -> pulsar.v1.CreateAlertRuleResponse
+	56, // 110: pulsar.v1.MonitorService.UpdateAlertRule:output_type -> pulsar.v1.UpdateAlertRuleResponse
+	58, // 111: pulsar.v1.MonitorService.DeleteAlertRule:output_type -> pulsar.v1.DeleteAlertRuleResponse
+	60, // 112: pulsar.v1.MonitorService.ListIncidents:output_type -> pulsar.v1.ListIncidentsResponse
+	63, // 113: pulsar.v1.MonitorService.RegisterAgent:output_type -> pulsar.v1.RegisterAgentResponse
+	65, // 114: pulsar.v1.MonitorService.ListAgents:output_type -> pulsar.v1.ListAgentsResponse
+	67, // 115: pulsar.v1.MonitorService.DeleteAgent:output_type -> pulsar.v1.DeleteAgentResponse
+	80, // 116: pulsar.v1.MonitorService.IngestSystemStats:output_type -> pulsar.v1.IngestSystemStatsResponse
+	70, // 117: pulsar.v1.MonitorService.ListSecrets:output_type -> pulsar.v1.ListSecretsResponse
+	72, // 118: pulsar.v1.MonitorService.CreateSecret:output_type -> pulsar.v1.CreateSecretResponse
+	74, // 119: pulsar.v1.MonitorService.UpdateSecret:output_type -> pulsar.v1.UpdateSecretResponse
+	76, // 120: pulsar.v1.MonitorService.DeleteSecret:output_type -> pulsar.v1.DeleteSecretResponse
+	98, // [98:121] is the sub-list for method output_type
+	75, // [75:98] is the sub-list for method input_type
+	75, // [75:75] is the sub-list for extension type_name
+	75, // [75:75] is the sub-list for extension extendee
+	0,  // [0:75] is the sub-list for field type_name
 }
 
 func init() { file_proto_pulsar_v1_monitor_proto_init() }
@@ -1158,7 +6076,7 @@ func file_proto_pulsar_v1_monitor_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_pulsar_v1_monitor_proto_rawDesc), len(file_proto_pulsar_v1_monitor_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   82,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
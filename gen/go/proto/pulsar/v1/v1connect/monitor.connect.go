@@ -9,7 +9,6 @@ import (
 	context "context"
 	errors "errors"
 	v1 "github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	http "net/http"
 	strings "strings"
 )
@@ -43,12 +42,66 @@ const (
 	// MonitorServiceDeleteMonitorProcedure is the fully-qualified name of the MonitorService's
 	// DeleteMonitor RPC.
 	MonitorServiceDeleteMonitorProcedure = "/pulsar.v1.MonitorService/DeleteMonitor"
+	// MonitorServiceSetMonitorCredentialsProcedure is the fully-qualified name of the MonitorService's
+	// SetMonitorCredentials RPC.
+	MonitorServiceSetMonitorCredentialsProcedure = "/pulsar.v1.MonitorService/SetMonitorCredentials"
 	// MonitorServiceGetMonitorStatsProcedure is the fully-qualified name of the MonitorService's
 	// GetMonitorStats RPC.
 	MonitorServiceGetMonitorStatsProcedure = "/pulsar.v1.MonitorService/GetMonitorStats"
 	// MonitorServiceGetSystemStatsProcedure is the fully-qualified name of the MonitorService's
 	// GetSystemStats RPC.
 	MonitorServiceGetSystemStatsProcedure = "/pulsar.v1.MonitorService/GetSystemStats"
+	// MonitorServiceGetSystemStatsHistoryProcedure is the fully-qualified name of the MonitorService's
+	// GetSystemStatsHistory RPC.
+	MonitorServiceGetSystemStatsHistoryProcedure = "/pulsar.v1.MonitorService/GetSystemStatsHistory"
+	// MonitorServiceWatchMonitorsProcedure is the fully-qualified name of the MonitorService's
+	// WatchMonitors RPC.
+	MonitorServiceWatchMonitorsProcedure = "/pulsar.v1.MonitorService/WatchMonitors"
+	// MonitorServiceGetProcessSnapshotProcedure is the fully-qualified name of the MonitorService's
+	// GetProcessSnapshot RPC.
+	MonitorServiceGetProcessSnapshotProcedure = "/pulsar.v1.MonitorService/GetProcessSnapshot"
+	// MonitorServiceGetCgroupStatsProcedure is the fully-qualified name of the MonitorService's
+	// GetCgroupStats RPC.
+	MonitorServiceGetCgroupStatsProcedure = "/pulsar.v1.MonitorService/GetCgroupStats"
+	// MonitorServiceListAlertRulesProcedure is the fully-qualified name of the MonitorService's
+	// ListAlertRules RPC.
+	MonitorServiceListAlertRulesProcedure = "/pulsar.v1.MonitorService/ListAlertRules"
+	// MonitorServiceCreateAlertRuleProcedure is the fully-qualified name of the MonitorService's
+	// CreateAlertRule RPC.
+	MonitorServiceCreateAlertRuleProcedure = "/pulsar.v1.MonitorService/CreateAlertRule"
+	// MonitorServiceUpdateAlertRuleProcedure is the fully-qualified name of the MonitorService's
+	// UpdateAlertRule RPC.
+	MonitorServiceUpdateAlertRuleProcedure = "/pulsar.v1.MonitorService/UpdateAlertRule"
+	// MonitorServiceDeleteAlertRuleProcedure is the fully-qualified name of the MonitorService's
+	// DeleteAlertRule RPC.
+	MonitorServiceDeleteAlertRuleProcedure = "/pulsar.v1.MonitorService/DeleteAlertRule"
+	// MonitorServiceListIncidentsProcedure is the fully-qualified name of the MonitorService's
+	// ListIncidents RPC.
+	MonitorServiceListIncidentsProcedure = "/pulsar.v1.MonitorService/ListIncidents"
+	// MonitorServiceRegisterAgentProcedure is the fully-qualified name of the MonitorService's
+	// RegisterAgent RPC.
+	MonitorServiceRegisterAgentProcedure = "/pulsar.v1.MonitorService/RegisterAgent"
+	// MonitorServiceListAgentsProcedure is the fully-qualified name of the MonitorService's ListAgents
+	// RPC.
+	MonitorServiceListAgentsProcedure = "/pulsar.v1.MonitorService/ListAgents"
+	// MonitorServiceDeleteAgentProcedure is the fully-qualified name of the MonitorService's
+	// DeleteAgent RPC.
+	MonitorServiceDeleteAgentProcedure = "/pulsar.v1.MonitorService/DeleteAgent"
+	// MonitorServiceIngestSystemStatsProcedure is the fully-qualified name of the MonitorService's
+	// IngestSystemStats RPC.
+	MonitorServiceIngestSystemStatsProcedure = "/pulsar.v1.MonitorService/IngestSystemStats"
+	// MonitorServiceListSecretsProcedure is the fully-qualified name of the MonitorService's
+	// ListSecrets RPC.
+	MonitorServiceListSecretsProcedure = "/pulsar.v1.MonitorService/ListSecrets"
+	// MonitorServiceCreateSecretProcedure is the fully-qualified name of the MonitorService's
+	// CreateSecret RPC.
+	MonitorServiceCreateSecretProcedure = "/pulsar.v1.MonitorService/CreateSecret"
+	// MonitorServiceUpdateSecretProcedure is the fully-qualified name of the MonitorService's
+	// UpdateSecret RPC.
+	MonitorServiceUpdateSecretProcedure = "/pulsar.v1.MonitorService/UpdateSecret"
+	// MonitorServiceDeleteSecretProcedure is the fully-qualified name of the MonitorService's
+	// DeleteSecret RPC.
+	MonitorServiceDeleteSecretProcedure = "/pulsar.v1.MonitorService/DeleteSecret"
 )
 
 // MonitorServiceClient is a client for the pulsar.v1.MonitorService service.
@@ -56,10 +109,33 @@ type MonitorServiceClient interface {
 	CreateMonitor(context.Context, *connect.Request[v1.CreateMonitorRequest]) (*connect.Response[v1.CreateMonitorResponse], error)
 	ListMonitors(context.Context, *connect.Request[v1.ListMonitorsRequest]) (*connect.Response[v1.ListMonitorsResponse], error)
 	DeleteMonitor(context.Context, *connect.Request[v1.DeleteMonitorRequest]) (*connect.Response[v1.DeleteMonitorResponse], error)
+	// Replaces the proxy & client certificate settings; empty clears them.
+	SetMonitorCredentials(context.Context, *connect.Request[v1.SetMonitorCredentialsRequest]) (*connect.Response[v1.SetMonitorCredentialsResponse], error)
 	// Geçmiş verileri (History) çekmek için
 	GetMonitorStats(context.Context, *connect.Request[v1.GetMonitorStatsRequest]) (*connect.Response[v1.GetMonitorStatsResponse], error)
 	// Sistem istatistikleri (Opsiyonel, genelde WebSocket kullanıyoruz ama burada kalabilir)
-	GetSystemStats(context.Context, *connect.Request[emptypb.Empty]) (*connect.ServerStreamForClient[v1.SystemStatsResponse], error)
+	GetSystemStats(context.Context, *connect.Request[v1.GetSystemStatsRequest]) (*connect.ServerStreamForClient[v1.SystemStatsResponse], error)
+	GetSystemStatsHistory(context.Context, *connect.Request[v1.GetSystemStatsHistoryRequest]) (*connect.Response[v1.GetSystemStatsHistoryResponse], error)
+	WatchMonitors(context.Context, *connect.Request[v1.WatchMonitorsRequest]) (*connect.ServerStreamForClient[v1.MonitorUpdate], error)
+	GetProcessSnapshot(context.Context, *connect.Request[v1.GetProcessSnapshotRequest]) (*connect.Response[v1.GetProcessSnapshotResponse], error)
+	GetCgroupStats(context.Context, *connect.Request[v1.GetCgroupStatsRequest]) (*connect.Response[v1.GetCgroupStatsResponse], error)
+	ListAlertRules(context.Context, *connect.Request[v1.ListAlertRulesRequest]) (*connect.Response[v1.ListAlertRulesResponse], error)
+	CreateAlertRule(context.Context, *connect.Request[v1.CreateAlertRuleRequest]) (*connect.Response[v1.CreateAlertRuleResponse], error)
+	UpdateAlertRule(context.Context, *connect.Request[v1.UpdateAlertRuleRequest]) (*connect.Response[v1.UpdateAlertRuleResponse], error)
+	DeleteAlertRule(context.Context, *connect.Request[v1.DeleteAlertRuleRequest]) (*connect.Response[v1.DeleteAlertRuleResponse], error)
+	ListIncidents(context.Context, *connect.Request[v1.ListIncidentsRequest]) (*connect.Response[v1.ListIncidentsResponse], error)
+	// Remote agents: RegisterAgent returns the token IngestSystemStats expects
+	// as "Authorization: Bearer <token>".
+	RegisterAgent(context.Context, *connect.Request[v1.RegisterAgentRequest]) (*connect.Response[v1.RegisterAgentResponse], error)
+	ListAgents(context.Context, *connect.Request[v1.ListAgentsRequest]) (*connect.Response[v1.ListAgentsResponse], error)
+	DeleteAgent(context.Context, *connect.Request[v1.DeleteAgentRequest]) (*connect.Response[v1.DeleteAgentResponse], error)
+	IngestSystemStats(context.Context, *connect.Request[v1.IngestSystemStatsRequest]) (*connect.Response[v1.IngestSystemStatsResponse], error)
+	// Secrets are referenced from monitor configs as {{secret:<id>}}; their
+	// values are never returned.
+	ListSecrets(context.Context, *connect.Request[v1.ListSecretsRequest]) (*connect.Response[v1.ListSecretsResponse], error)
+	CreateSecret(context.Context, *connect.Request[v1.CreateSecretRequest]) (*connect.Response[v1.CreateSecretResponse], error)
+	UpdateSecret(context.Context, *connect.Request[v1.UpdateSecretRequest]) (*connect.Response[v1.UpdateSecretResponse], error)
+	DeleteSecret(context.Context, *connect.Request[v1.DeleteSecretRequest]) (*connect.Response[v1.DeleteSecretResponse], error)
 }
 
 // NewMonitorServiceClient constructs a client for the pulsar.v1.MonitorService service. By default,
@@ -91,28 +167,154 @@ func NewMonitorServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(monitorServiceMethods.ByName("DeleteMonitor")),
 			connect.WithClientOptions(opts...),
 		),
+		setMonitorCredentials: connect.NewClient[v1.SetMonitorCredentialsRequest, v1.SetMonitorCredentialsResponse](
+			httpClient,
+			baseURL+MonitorServiceSetMonitorCredentialsProcedure,
+			connect.WithSchema(monitorServiceMethods.ByName("SetMonitorCredentials")),
+			connect.WithClientOptions(opts...),
+		),
 		getMonitorStats: connect.NewClient[v1.GetMonitorStatsRequest, v1.GetMonitorStatsResponse](
 			httpClient,
 			baseURL+MonitorServiceGetMonitorStatsProcedure,
 			connect.WithSchema(monitorServiceMethods.ByName("GetMonitorStats")),
 			connect.WithClientOptions(opts...),
 		),
-		getSystemStats: connect.NewClient[emptypb.Empty, v1.SystemStatsResponse](
+		getSystemStats: connect.NewClient[v1.GetSystemStatsRequest, v1.SystemStatsResponse](
 			httpClient,
 			baseURL+MonitorServiceGetSystemStatsProcedure,
 			connect.WithSchema(monitorServiceMethods.ByName("GetSystemStats")),
 			connect.WithClientOptions(opts...),
 		),
+		getSystemStatsHistory: connect.NewClient[v1.GetSystemStatsHistoryRequest, v1.GetSystemStatsHistoryResponse](
+			httpClient,
+			baseURL+MonitorServiceGetSystemStatsHistoryProcedure,
+			connect.WithSchema(monitorServiceMethods.ByName("GetSystemStatsHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		watchMonitors: connect.NewClient[v1.WatchMonitorsRequest, v1.MonitorUpdate](
+			httpClient,
+			baseURL+MonitorServiceWatchMonitorsProcedure,
+			connect.WithSchema(monitorServiceMethods.ByName("WatchMonitors")),
+			connect.WithClientOptions(opts...),
+		),
+		getProcessSnapshot: connect.NewClient[v1.GetProcessSnapshotRequest, v1.GetProcessSnapshotResponse](
+			httpClient,
+			baseURL+MonitorServiceGetProcessSnapshotProcedure,
+			connect.WithSchema(monitorServiceMethods.ByName("GetProcessSnapshot")),
+			connect.WithClientOptions(opts...),
+		),
+		getCgroupStats: connect.NewClient[v1.GetCgroupStatsRequest, v1.GetCgroupStatsResponse](
+			httpClient,
+			baseURL+MonitorServiceGetCgroupStatsProcedure,
+			connect.WithSchema(monitorServiceMethods.ByName("GetCgroupStats")),
+			connect.WithClientOptions(opts...),
+		),
+		listAlertRules: connect.NewClient[v1.ListAlertRulesRequest, v1.ListAlertRulesResponse](
+			httpClient,
+			baseURL+MonitorServiceListAlertRulesProcedure,
+			connect.WithSchema(monitorServiceMethods.ByName("ListAlertRules")),
+			connect.WithClientOptions(opts...),
+		),
+		createAlertRule: connect.NewClient[v1.CreateAlertRuleRequest, v1.CreateAlertRuleResponse](
+			httpClient,
+			baseURL+MonitorServiceCreateAlertRuleProcedure,
+			connect.WithSchema(monitorServiceMethods.ByName("CreateAlertRule")),
+			connect.WithClientOptions(opts...),
+		),
+		updateAlertRule: connect.NewClient[v1.UpdateAlertRuleRequest, v1.UpdateAlertRuleResponse](
+			httpClient,
+			baseURL+MonitorServiceUpdateAlertRuleProcedure,
+			connect.WithSchema(monitorServiceMethods.ByName("UpdateAlertRule")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAlertRule: connect.NewClient[v1.DeleteAlertRuleRequest, v1.DeleteAlertRuleResponse](
+			httpClient,
+			baseURL+MonitorServiceDeleteAlertRuleProcedure,
+			connect.WithSchema(monitorServiceMethods.ByName("DeleteAlertRule")),
+			connect.WithClientOptions(opts...),
+		),
+		listIncidents: connect.NewClient[v1.ListIncidentsRequest, v1.ListIncidentsResponse](
+			httpClient,
+			baseURL+MonitorServiceListIncidentsProcedure,
+			connect.WithSchema(monitorServiceMethods.ByName("ListIncidents")),
+			connect.WithClientOptions(opts...),
+		),
+		registerAgent: connect.NewClient[v1.RegisterAgentRequest, v1.RegisterAgentResponse](
+			httpClient,
+			baseURL+MonitorServiceRegisterAgentProcedure,
+			connect.WithSchema(monitorServiceMethods.ByName("RegisterAgent")),
+			connect.WithClientOptions(opts...),
+		),
+		listAgents: connect.NewClient[v1.ListAgentsRequest, v1.ListAgentsResponse](
+			httpClient,
+			baseURL+MonitorServiceListAgentsProcedure,
+			connect.WithSchema(monitorServiceMethods.ByName("ListAgents")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAgent: connect.NewClient[v1.DeleteAgentRequest, v1.DeleteAgentResponse](
+			httpClient,
+			baseURL+MonitorServiceDeleteAgentProcedure,
+			connect.WithSchema(monitorServiceMethods.ByName("DeleteAgent")),
+			connect.WithClientOptions(opts...),
+		),
+		ingestSystemStats: connect.NewClient[v1.IngestSystemStatsRequest, v1.IngestSystemStatsResponse](
+			httpClient,
+			baseURL+MonitorServiceIngestSystemStatsProcedure,
+			connect.WithSchema(monitorServiceMethods.ByName("IngestSystemStats")),
+			connect.WithClientOptions(opts...),
+		),
+		listSecrets: connect.NewClient[v1.ListSecretsRequest, v1.ListSecretsResponse](
+			httpClient,
+			baseURL+MonitorServiceListSecretsProcedure,
+			connect.WithSchema(monitorServiceMethods.ByName("ListSecrets")),
+			connect.WithClientOptions(opts...),
+		),
+		createSecret: connect.NewClient[v1.CreateSecretRequest, v1.CreateSecretResponse](
+			httpClient,
+			baseURL+MonitorServiceCreateSecretProcedure,
+			connect.WithSchema(monitorServiceMethods.ByName("CreateSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		updateSecret: connect.NewClient[v1.UpdateSecretRequest, v1.UpdateSecretResponse](
+			httpClient,
+			baseURL+MonitorServiceUpdateSecretProcedure,
+			connect.WithSchema(monitorServiceMethods.ByName("UpdateSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSecret: connect.NewClient[v1.DeleteSecretRequest, v1.DeleteSecretResponse](
+			httpClient,
+			baseURL+MonitorServiceDeleteSecretProcedure,
+			connect.WithSchema(monitorServiceMethods.ByName("DeleteSecret")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // monitorServiceClient implements MonitorServiceClient.
 type monitorServiceClient struct {
-	createMonitor   *connect.Client[v1.CreateMonitorRequest, v1.CreateMonitorResponse]
-	listMonitors    *connect.Client[v1.ListMonitorsRequest, v1.ListMonitorsResponse]
-	deleteMonitor   *connect.Client[v1.DeleteMonitorRequest, v1.DeleteMonitorResponse]
-	getMonitorStats *connect.Client[v1.GetMonitorStatsRequest, v1.GetMonitorStatsResponse]
-	getSystemStats  *connect.Client[emptypb.Empty, v1.SystemStatsResponse]
+	createMonitor         *connect.Client[v1.CreateMonitorRequest, v1.CreateMonitorResponse]
+	listMonitors          *connect.Client[v1.ListMonitorsRequest, v1.ListMonitorsResponse]
+	deleteMonitor         *connect.Client[v1.DeleteMonitorRequest, v1.DeleteMonitorResponse]
+	setMonitorCredentials *connect.Client[v1.SetMonitorCredentialsRequest, v1.SetMonitorCredentialsResponse]
+	getMonitorStats       *connect.Client[v1.GetMonitorStatsRequest, v1.GetMonitorStatsResponse]
+	getSystemStats        *connect.Client[v1.GetSystemStatsRequest, v1.SystemStatsResponse]
+	getSystemStatsHistory *connect.Client[v1.GetSystemStatsHistoryRequest, v1.GetSystemStatsHistoryResponse]
+	watchMonitors         *connect.Client[v1.WatchMonitorsRequest, v1.MonitorUpdate]
+	getProcessSnapshot    *connect.Client[v1.GetProcessSnapshotRequest, v1.GetProcessSnapshotResponse]
+	getCgroupStats        *connect.Client[v1.GetCgroupStatsRequest, v1.GetCgroupStatsResponse]
+	listAlertRules        *connect.Client[v1.ListAlertRulesRequest, v1.ListAlertRulesResponse]
+	createAlertRule       *connect.Client[v1.CreateAlertRuleRequest, v1.CreateAlertRuleResponse]
+	updateAlertRule       *connect.Client[v1.UpdateAlertRuleRequest, v1.UpdateAlertRuleResponse]
+	deleteAlertRule       *connect.Client[v1.DeleteAlertRuleRequest, v1.DeleteAlertRuleResponse]
+	listIncidents         *connect.Client[v1.ListIncidentsRequest, v1.ListIncidentsResponse]
+	registerAgent         *connect.Client[v1.RegisterAgentRequest, v1.RegisterAgentResponse]
+	listAgents            *connect.Client[v1.ListAgentsRequest, v1.ListAgentsResponse]
+	deleteAgent           *connect.Client[v1.DeleteAgentRequest, v1.DeleteAgentResponse]
+	ingestSystemStats     *connect.Client[v1.IngestSystemStatsRequest, v1.IngestSystemStatsResponse]
+	listSecrets           *connect.Client[v1.ListSecretsRequest, v1.ListSecretsResponse]
+	createSecret          *connect.Client[v1.CreateSecretRequest, v1.CreateSecretResponse]
+	updateSecret          *connect.Client[v1.UpdateSecretRequest, v1.UpdateSecretResponse]
+	deleteSecret          *connect.Client[v1.DeleteSecretRequest, v1.DeleteSecretResponse]
 }
 
 // CreateMonitor calls pulsar.v1.MonitorService.CreateMonitor.
@@ -130,25 +332,138 @@ func (c *monitorServiceClient) DeleteMonitor(ctx context.Context, req *connect.R
 	return c.deleteMonitor.CallUnary(ctx, req)
 }
 
+// SetMonitorCredentials calls pulsar.v1.MonitorService.SetMonitorCredentials.
+func (c *monitorServiceClient) SetMonitorCredentials(ctx context.Context, req *connect.Request[v1.SetMonitorCredentialsRequest]) (*connect.Response[v1.SetMonitorCredentialsResponse], error) {
+	return c.setMonitorCredentials.CallUnary(ctx, req)
+}
+
 // GetMonitorStats calls pulsar.v1.MonitorService.GetMonitorStats.
 func (c *monitorServiceClient) GetMonitorStats(ctx context.Context, req *connect.Request[v1.GetMonitorStatsRequest]) (*connect.Response[v1.GetMonitorStatsResponse], error) {
 	return c.getMonitorStats.CallUnary(ctx, req)
 }
 
 // GetSystemStats calls pulsar.v1.MonitorService.GetSystemStats.
-func (c *monitorServiceClient) GetSystemStats(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.ServerStreamForClient[v1.SystemStatsResponse], error) {
+func (c *monitorServiceClient) GetSystemStats(ctx context.Context, req *connect.Request[v1.GetSystemStatsRequest]) (*connect.ServerStreamForClient[v1.SystemStatsResponse], error) {
 	return c.getSystemStats.CallServerStream(ctx, req)
 }
 
+// GetSystemStatsHistory calls pulsar.v1.MonitorService.GetSystemStatsHistory.
+func (c *monitorServiceClient) GetSystemStatsHistory(ctx context.Context, req *connect.Request[v1.GetSystemStatsHistoryRequest]) (*connect.Response[v1.GetSystemStatsHistoryResponse], error) {
+	return c.getSystemStatsHistory.CallUnary(ctx, req)
+}
+
+// WatchMonitors calls pulsar.v1.MonitorService.WatchMonitors.
+func (c *monitorServiceClient) WatchMonitors(ctx context.Context, req *connect.Request[v1.WatchMonitorsRequest]) (*connect.ServerStreamForClient[v1.MonitorUpdate], error) {
+	return c.watchMonitors.CallServerStream(ctx, req)
+}
+
+// GetProcessSnapshot calls pulsar.v1.MonitorService.GetProcessSnapshot.
+func (c *monitorServiceClient) GetProcessSnapshot(ctx context.Context, req *connect.Request[v1.GetProcessSnapshotRequest]) (*connect.Response[v1.GetProcessSnapshotResponse], error) {
+	return c.getProcessSnapshot.CallUnary(ctx, req)
+}
+
+// GetCgroupStats calls pulsar.v1.MonitorService.GetCgroupStats.
+func (c *monitorServiceClient) GetCgroupStats(ctx context.Context, req *connect.Request[v1.GetCgroupStatsRequest]) (*connect.Response[v1.GetCgroupStatsResponse], error) {
+	return c.getCgroupStats.CallUnary(ctx, req)
+}
+
+// ListAlertRules calls pulsar.v1.MonitorService.ListAlertRules.
+func (c *monitorServiceClient) ListAlertRules(ctx context.Context, req *connect.Request[v1.ListAlertRulesRequest]) (*connect.Response[v1.ListAlertRulesResponse], error) {
+	return c.listAlertRules.CallUnary(ctx, req)
+}
+
+// CreateAlertRule calls pulsar.v1.MonitorService.CreateAlertRule.
+func (c *monitorServiceClient) CreateAlertRule(ctx context.Context, req *connect.Request[v1.CreateAlertRuleRequest]) (*connect.Response[v1.CreateAlertRuleResponse], error) {
+	return c.createAlertRule.CallUnary(ctx, req)
+}
+
+// UpdateAlertRule calls pulsar.v1.MonitorService.UpdateAlertRule.
+func (c *monitorServiceClient) UpdateAlertRule(ctx context.Context, req *connect.Request[v1.UpdateAlertRuleRequest]) (*connect.Response[v1.UpdateAlertRuleResponse], error) {
+	return c.updateAlertRule.CallUnary(ctx, req)
+}
+
+// DeleteAlertRule calls pulsar.v1.MonitorService.DeleteAlertRule.
+func (c *monitorServiceClient) DeleteAlertRule(ctx context.Context, req *connect.Request[v1.DeleteAlertRuleRequest]) (*connect.Response[v1.DeleteAlertRuleResponse], error) {
+	return c.deleteAlertRule.CallUnary(ctx, req)
+}
+
+// ListIncidents calls pulsar.v1.MonitorService.ListIncidents.
+func (c *monitorServiceClient) ListIncidents(ctx context.Context, req *connect.Request[v1.ListIncidentsRequest]) (*connect.Response[v1.ListIncidentsResponse], error) {
+	return c.listIncidents.CallUnary(ctx, req)
+}
+
+// RegisterAgent calls pulsar.v1.MonitorService.RegisterAgent.
+func (c *monitorServiceClient) RegisterAgent(ctx context.Context, req *connect.Request[v1.RegisterAgentRequest]) (*connect.Response[v1.RegisterAgentResponse], error) {
+	return c.registerAgent.CallUnary(ctx, req)
+}
+
+// ListAgents calls pulsar.v1.MonitorService.ListAgents.
+func (c *monitorServiceClient) ListAgents(ctx context.Context, req *connect.Request[v1.ListAgentsRequest]) (*connect.Response[v1.ListAgentsResponse], error) {
+	return c.listAgents.CallUnary(ctx, req)
+}
+
+// DeleteAgent calls pulsar.v1.MonitorService.DeleteAgent.
+func (c *monitorServiceClient) DeleteAgent(ctx context.Context, req *connect.Request[v1.DeleteAgentRequest]) (*connect.Response[v1.DeleteAgentResponse], error) {
+	return c.deleteAgent.CallUnary(ctx, req)
+}
+
+// IngestSystemStats calls pulsar.v1.MonitorService.IngestSystemStats.
+func (c *monitorServiceClient) IngestSystemStats(ctx context.Context, req *connect.Request[v1.IngestSystemStatsRequest]) (*connect.Response[v1.IngestSystemStatsResponse], error) {
+	return c.ingestSystemStats.CallUnary(ctx, req)
+}
+
+// ListSecrets calls pulsar.v1.MonitorService.ListSecrets.
+func (c *monitorServiceClient) ListSecrets(ctx context.Context, req *connect.Request[v1.ListSecretsRequest]) (*connect.Response[v1.ListSecretsResponse], error) {
+	return c.listSecrets.CallUnary(ctx, req)
+}
+
+// CreateSecret calls pulsar.v1.MonitorService.CreateSecret.
+func (c *monitorServiceClient) CreateSecret(ctx context.Context, req *connect.Request[v1.CreateSecretRequest]) (*connect.Response[v1.CreateSecretResponse], error) {
+	return c.createSecret.CallUnary(ctx, req)
+}
+
+// UpdateSecret calls pulsar.v1.MonitorService.UpdateSecret.
+func (c *monitorServiceClient) UpdateSecret(ctx context.Context, req *connect.Request[v1.UpdateSecretRequest]) (*connect.Response[v1.UpdateSecretResponse], error) {
+	return c.updateSecret.CallUnary(ctx, req)
+}
+
+// DeleteSecret calls pulsar.v1.MonitorService.DeleteSecret.
+func (c *monitorServiceClient) DeleteSecret(ctx context.Context, req *connect.Request[v1.DeleteSecretRequest]) (*connect.Response[v1.DeleteSecretResponse], error) {
+	return c.deleteSecret.CallUnary(ctx, req)
+}
+
 // MonitorServiceHandler is an implementation of the pulsar.v1.MonitorService service.
 type MonitorServiceHandler interface {
 	CreateMonitor(context.Context, *connect.Request[v1.CreateMonitorRequest]) (*connect.Response[v1.CreateMonitorResponse], error)
 	ListMonitors(context.Context, *connect.Request[v1.ListMonitorsRequest]) (*connect.Response[v1.ListMonitorsResponse], error)
 	DeleteMonitor(context.Context, *connect.Request[v1.DeleteMonitorRequest]) (*connect.Response[v1.DeleteMonitorResponse], error)
+	// Replaces the proxy & client certificate settings; empty clears them.
+	SetMonitorCredentials(context.Context, *connect.Request[v1.SetMonitorCredentialsRequest]) (*connect.Response[v1.SetMonitorCredentialsResponse], error)
 	// Geçmiş verileri (History) çekmek için
 	GetMonitorStats(context.Context, *connect.Request[v1.GetMonitorStatsRequest]) (*connect.Response[v1.GetMonitorStatsResponse], error)
 	// Sistem istatistikleri (Opsiyonel, genelde WebSocket kullanıyoruz ama burada kalabilir)
-	GetSystemStats(context.Context, *connect.Request[emptypb.Empty], *connect.ServerStream[v1.SystemStatsResponse]) error
+	GetSystemStats(context.Context, *connect.Request[v1.GetSystemStatsRequest], *connect.ServerStream[v1.SystemStatsResponse]) error
+	GetSystemStatsHistory(context.Context, *connect.Request[v1.GetSystemStatsHistoryRequest]) (*connect.Response[v1.GetSystemStatsHistoryResponse], error)
+	WatchMonitors(context.Context, *connect.Request[v1.WatchMonitorsRequest], *connect.ServerStream[v1.MonitorUpdate]) error
+	GetProcessSnapshot(context.Context, *connect.Request[v1.GetProcessSnapshotRequest]) (*connect.Response[v1.GetProcessSnapshotResponse], error)
+	GetCgroupStats(context.Context, *connect.Request[v1.GetCgroupStatsRequest]) (*connect.Response[v1.GetCgroupStatsResponse], error)
+	ListAlertRules(context.Context, *connect.Request[v1.ListAlertRulesRequest]) (*connect.Response[v1.ListAlertRulesResponse], error)
+	CreateAlertRule(context.Context, *connect.Request[v1.CreateAlertRuleRequest]) (*connect.Response[v1.CreateAlertRuleResponse], error)
+	UpdateAlertRule(context.Context, *connect.Request[v1.UpdateAlertRuleRequest]) (*connect.Response[v1.UpdateAlertRuleResponse], error)
+	DeleteAlertRule(context.Context, *connect.Request[v1.DeleteAlertRuleRequest]) (*connect.Response[v1.DeleteAlertRuleResponse], error)
+	ListIncidents(context.Context, *connect.Request[v1.ListIncidentsRequest]) (*connect.Response[v1.ListIncidentsResponse], error)
+	// Remote agents: RegisterAgent returns the token IngestSystemStats expects
+	// as "Authorization: Bearer <token>".
+	RegisterAgent(context.Context, *connect.Request[v1.RegisterAgentRequest]) (*connect.Response[v1.RegisterAgentResponse], error)
+	ListAgents(context.Context, *connect.Request[v1.ListAgentsRequest]) (*connect.Response[v1.ListAgentsResponse], error)
+	DeleteAgent(context.Context, *connect.Request[v1.DeleteAgentRequest]) (*connect.Response[v1.DeleteAgentResponse], error)
+	IngestSystemStats(context.Context, *connect.Request[v1.IngestSystemStatsRequest]) (*connect.Response[v1.IngestSystemStatsResponse], error)
+	// Secrets are referenced from monitor configs as {{secret:<id>}}; their
+	// values are never returned.
+	ListSecrets(context.Context, *connect.Request[v1.ListSecretsRequest]) (*connect.Response[v1.ListSecretsResponse], error)
+	CreateSecret(context.Context, *connect.Request[v1.CreateSecretRequest]) (*connect.Response[v1.CreateSecretResponse], error)
+	UpdateSecret(context.Context, *connect.Request[v1.UpdateSecretRequest]) (*connect.Response[v1.UpdateSecretResponse], error)
+	DeleteSecret(context.Context, *connect.Request[v1.DeleteSecretRequest]) (*connect.Response[v1.DeleteSecretResponse], error)
 }
 
 // NewMonitorServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -176,6 +491,12 @@ func NewMonitorServiceHandler(svc MonitorServiceHandler, opts ...connect.Handler
 		connect.WithSchema(monitorServiceMethods.ByName("DeleteMonitor")),
 		connect.WithHandlerOptions(opts...),
 	)
+	monitorServiceSetMonitorCredentialsHandler := connect.NewUnaryHandler(
+		MonitorServiceSetMonitorCredentialsProcedure,
+		svc.SetMonitorCredentials,
+		connect.WithSchema(monitorServiceMethods.ByName("SetMonitorCredentials")),
+		connect.WithHandlerOptions(opts...),
+	)
 	monitorServiceGetMonitorStatsHandler := connect.NewUnaryHandler(
 		MonitorServiceGetMonitorStatsProcedure,
 		svc.GetMonitorStats,
@@ -188,6 +509,108 @@ func NewMonitorServiceHandler(svc MonitorServiceHandler, opts ...connect.Handler
 		connect.WithSchema(monitorServiceMethods.ByName("GetSystemStats")),
 		connect.WithHandlerOptions(opts...),
 	)
+	monitorServiceGetSystemStatsHistoryHandler := connect.NewUnaryHandler(
+		MonitorServiceGetSystemStatsHistoryProcedure,
+		svc.GetSystemStatsHistory,
+		connect.WithSchema(monitorServiceMethods.ByName("GetSystemStatsHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	monitorServiceWatchMonitorsHandler := connect.NewServerStreamHandler(
+		MonitorServiceWatchMonitorsProcedure,
+		svc.WatchMonitors,
+		connect.WithSchema(monitorServiceMethods.ByName("WatchMonitors")),
+		connect.WithHandlerOptions(opts...),
+	)
+	monitorServiceGetProcessSnapshotHandler := connect.NewUnaryHandler(
+		MonitorServiceGetProcessSnapshotProcedure,
+		svc.GetProcessSnapshot,
+		connect.WithSchema(monitorServiceMethods.ByName("GetProcessSnapshot")),
+		connect.WithHandlerOptions(opts...),
+	)
+	monitorServiceGetCgroupStatsHandler := connect.NewUnaryHandler(
+		MonitorServiceGetCgroupStatsProcedure,
+		svc.GetCgroupStats,
+		connect.WithSchema(monitorServiceMethods.ByName("GetCgroupStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	monitorServiceListAlertRulesHandler := connect.NewUnaryHandler(
+		MonitorServiceListAlertRulesProcedure,
+		svc.ListAlertRules,
+		connect.WithSchema(monitorServiceMethods.ByName("ListAlertRules")),
+		connect.WithHandlerOptions(opts...),
+	)
+	monitorServiceCreateAlertRuleHandler := connect.NewUnaryHandler(
+		MonitorServiceCreateAlertRuleProcedure,
+		svc.CreateAlertRule,
+		connect.WithSchema(monitorServiceMethods.ByName("CreateAlertRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	monitorServiceUpdateAlertRuleHandler := connect.NewUnaryHandler(
+		MonitorServiceUpdateAlertRuleProcedure,
+		svc.UpdateAlertRule,
+		connect.WithSchema(monitorServiceMethods.ByName("UpdateAlertRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	monitorServiceDeleteAlertRuleHandler := connect.NewUnaryHandler(
+		MonitorServiceDeleteAlertRuleProcedure,
+		svc.DeleteAlertRule,
+		connect.WithSchema(monitorServiceMethods.ByName("DeleteAlertRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	monitorServiceListIncidentsHandler := connect.NewUnaryHandler(
+		MonitorServiceListIncidentsProcedure,
+		svc.ListIncidents,
+		connect.WithSchema(monitorServiceMethods.ByName("ListIncidents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	monitorServiceRegisterAgentHandler := connect.NewUnaryHandler(
+		MonitorServiceRegisterAgentProcedure,
+		svc.RegisterAgent,
+		connect.WithSchema(monitorServiceMethods.ByName("RegisterAgent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	monitorServiceListAgentsHandler := connect.NewUnaryHandler(
+		MonitorServiceListAgentsProcedure,
+		svc.ListAgents,
+		connect.WithSchema(monitorServiceMethods.ByName("ListAgents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	monitorServiceDeleteAgentHandler := connect.NewUnaryHandler(
+		MonitorServiceDeleteAgentProcedure,
+		svc.DeleteAgent,
+		connect.WithSchema(monitorServiceMethods.ByName("DeleteAgent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	monitorServiceIngestSystemStatsHandler := connect.NewUnaryHandler(
+		MonitorServiceIngestSystemStatsProcedure,
+		svc.IngestSystemStats,
+		connect.WithSchema(monitorServiceMethods.ByName("IngestSystemStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	monitorServiceListSecretsHandler := connect.NewUnaryHandler(
+		MonitorServiceListSecretsProcedure,
+		svc.ListSecrets,
+		connect.WithSchema(monitorServiceMethods.ByName("ListSecrets")),
+		connect.WithHandlerOptions(opts...),
+	)
+	monitorServiceCreateSecretHandler := connect.NewUnaryHandler(
+		MonitorServiceCreateSecretProcedure,
+		svc.CreateSecret,
+		connect.WithSchema(monitorServiceMethods.ByName("CreateSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	monitorServiceUpdateSecretHandler := connect.NewUnaryHandler(
+		MonitorServiceUpdateSecretProcedure,
+		svc.UpdateSecret,
+		connect.WithSchema(monitorServiceMethods.ByName("UpdateSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	monitorServiceDeleteSecretHandler := connect.NewUnaryHandler(
+		MonitorServiceDeleteSecretProcedure,
+		svc.DeleteSecret,
+		connect.WithSchema(monitorServiceMethods.ByName("DeleteSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/pulsar.v1.MonitorService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case MonitorServiceCreateMonitorProcedure:
@@ -196,10 +619,46 @@ func NewMonitorServiceHandler(svc MonitorServiceHandler, opts ...connect.Handler
 			monitorServiceListMonitorsHandler.ServeHTTP(w, r)
 		case MonitorServiceDeleteMonitorProcedure:
 			monitorServiceDeleteMonitorHandler.ServeHTTP(w, r)
+		case MonitorServiceSetMonitorCredentialsProcedure:
+			monitorServiceSetMonitorCredentialsHandler.ServeHTTP(w, r)
 		case MonitorServiceGetMonitorStatsProcedure:
 			monitorServiceGetMonitorStatsHandler.ServeHTTP(w, r)
 		case MonitorServiceGetSystemStatsProcedure:
 			monitorServiceGetSystemStatsHandler.ServeHTTP(w, r)
+		case MonitorServiceGetSystemStatsHistoryProcedure:
+			monitorServiceGetSystemStatsHistoryHandler.ServeHTTP(w, r)
+		case MonitorServiceWatchMonitorsProcedure:
+			monitorServiceWatchMonitorsHandler.ServeHTTP(w, r)
+		case MonitorServiceGetProcessSnapshotProcedure:
+			monitorServiceGetProcessSnapshotHandler.ServeHTTP(w, r)
+		case MonitorServiceGetCgroupStatsProcedure:
+			monitorServiceGetCgroupStatsHandler.ServeHTTP(w, r)
+		case MonitorServiceListAlertRulesProcedure:
+			monitorServiceListAlertRulesHandler.ServeHTTP(w, r)
+		case MonitorServiceCreateAlertRuleProcedure:
+			monitorServiceCreateAlertRuleHandler.ServeHTTP(w, r)
+		case MonitorServiceUpdateAlertRuleProcedure:
+			monitorServiceUpdateAlertRuleHandler.ServeHTTP(w, r)
+		case MonitorServiceDeleteAlertRuleProcedure:
+			monitorServiceDeleteAlertRuleHandler.ServeHTTP(w, r)
+		case MonitorServiceListIncidentsProcedure:
+			monitorServiceListIncidentsHandler.ServeHTTP(w, r)
+		case MonitorServiceRegisterAgentProcedure:
+			monitorServiceRegisterAgentHandler.ServeHTTP(w, r)
+		case MonitorServiceListAgentsProcedure:
+			monitorServiceListAgentsHandler.ServeHTTP(w, r)
+		case MonitorServiceDeleteAgentProcedure:
+			monitorServiceDeleteAgentHandler.ServeHTTP(w, r)
+		case MonitorServiceIngestSystemStatsProcedure:
+			monitorServiceIngestSystemStatsHandler.ServeHTTP(w, r)
+		case MonitorServiceListSecretsProcedure:
+			monitorServiceListSecretsHandler.ServeHTTP(w, r)
+		case MonitorServiceCreateSecretProcedure:
+			monitorServiceCreateSecretHandler.ServeHTTP(w, r)
+		case MonitorServiceUpdateSecretProcedure:
+			monitorServiceUpdateSecretHandler.ServeHTTP(w, r)
+		case MonitorServiceDeleteSecretProcedure:
+			monitorServiceDeleteSecretHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -221,10 +680,82 @@ func (UnimplementedMonitorServiceHandler) DeleteMonitor(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pulsar.v1.MonitorService.DeleteMonitor is not implemented"))
 }
 
+func (UnimplementedMonitorServiceHandler) SetMonitorCredentials(context.Context, *connect.Request[v1.SetMonitorCredentialsRequest]) (*connect.Response[v1.SetMonitorCredentialsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pulsar.v1.MonitorService.SetMonitorCredentials is not implemented"))
+}
+
 func (UnimplementedMonitorServiceHandler) GetMonitorStats(context.Context, *connect.Request[v1.GetMonitorStatsRequest]) (*connect.Response[v1.GetMonitorStatsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pulsar.v1.MonitorService.GetMonitorStats is not implemented"))
 }
 
-func (UnimplementedMonitorServiceHandler) GetSystemStats(context.Context, *connect.Request[emptypb.Empty], *connect.ServerStream[v1.SystemStatsResponse]) error {
+func (UnimplementedMonitorServiceHandler) GetSystemStats(context.Context, *connect.Request[v1.GetSystemStatsRequest], *connect.ServerStream[v1.SystemStatsResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("pulsar.v1.MonitorService.GetSystemStats is not implemented"))
 }
+
+func (UnimplementedMonitorServiceHandler) GetSystemStatsHistory(context.Context, *connect.Request[v1.GetSystemStatsHistoryRequest]) (*connect.Response[v1.GetSystemStatsHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pulsar.v1.MonitorService.GetSystemStatsHistory is not implemented"))
+}
+
+func (UnimplementedMonitorServiceHandler) WatchMonitors(context.Context, *connect.Request[v1.WatchMonitorsRequest], *connect.ServerStream[v1.MonitorUpdate]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("pulsar.v1.MonitorService.WatchMonitors is not implemented"))
+}
+
+func (UnimplementedMonitorServiceHandler) GetProcessSnapshot(context.Context, *connect.Request[v1.GetProcessSnapshotRequest]) (*connect.Response[v1.GetProcessSnapshotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pulsar.v1.MonitorService.GetProcessSnapshot is not implemented"))
+}
+
+func (UnimplementedMonitorServiceHandler) GetCgroupStats(context.Context, *connect.Request[v1.GetCgroupStatsRequest]) (*connect.Response[v1.GetCgroupStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pulsar.v1.MonitorService.GetCgroupStats is not implemented"))
+}
+
+func (UnimplementedMonitorServiceHandler) ListAlertRules(context.Context, *connect.Request[v1.ListAlertRulesRequest]) (*connect.Response[v1.ListAlertRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pulsar.v1.MonitorService.ListAlertRules is not implemented"))
+}
+
+func (UnimplementedMonitorServiceHandler) CreateAlertRule(context.Context, *connect.Request[v1.CreateAlertRuleRequest]) (*connect.Response[v1.CreateAlertRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pulsar.v1.MonitorService.CreateAlertRule is not implemented"))
+}
+
+func (UnimplementedMonitorServiceHandler) UpdateAlertRule(context.Context, *connect.Request[v1.UpdateAlertRuleRequest]) (*connect.Response[v1.UpdateAlertRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pulsar.v1.MonitorService.UpdateAlertRule is not implemented"))
+}
+
+func (UnimplementedMonitorServiceHandler) DeleteAlertRule(context.Context, *connect.Request[v1.DeleteAlertRuleRequest]) (*connect.Response[v1.DeleteAlertRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pulsar.v1.MonitorService.DeleteAlertRule is not implemented"))
+}
+
+func (UnimplementedMonitorServiceHandler) ListIncidents(context.Context, *connect.Request[v1.ListIncidentsRequest]) (*connect.Response[v1.ListIncidentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pulsar.v1.MonitorService.ListIncidents is not implemented"))
+}
+
+func (UnimplementedMonitorServiceHandler) RegisterAgent(context.Context, *connect.Request[v1.RegisterAgentRequest]) (*connect.Response[v1.RegisterAgentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pulsar.v1.MonitorService.RegisterAgent is not implemented"))
+}
+
+func (UnimplementedMonitorServiceHandler) ListAgents(context.Context, *connect.Request[v1.ListAgentsRequest]) (*connect.Response[v1.ListAgentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pulsar.v1.MonitorService.ListAgents is not implemented"))
+}
+
+func (UnimplementedMonitorServiceHandler) DeleteAgent(context.Context, *connect.Request[v1.DeleteAgentRequest]) (*connect.Response[v1.DeleteAgentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pulsar.v1.MonitorService.DeleteAgent is not implemented"))
+}
+
+func (UnimplementedMonitorServiceHandler) IngestSystemStats(context.Context, *connect.Request[v1.IngestSystemStatsRequest]) (*connect.Response[v1.IngestSystemStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pulsar.v1.MonitorService.IngestSystemStats is not implemented"))
+}
+
+func (UnimplementedMonitorServiceHandler) ListSecrets(context.Context, *connect.Request[v1.ListSecretsRequest]) (*connect.Response[v1.ListSecretsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pulsar.v1.MonitorService.ListSecrets is not implemented"))
+}
+
+func (UnimplementedMonitorServiceHandler) CreateSecret(context.Context, *connect.Request[v1.CreateSecretRequest]) (*connect.Response[v1.CreateSecretResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pulsar.v1.MonitorService.CreateSecret is not implemented"))
+}
+
+func (UnimplementedMonitorServiceHandler) UpdateSecret(context.Context, *connect.Request[v1.UpdateSecretRequest]) (*connect.Response[v1.UpdateSecretResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pulsar.v1.MonitorService.UpdateSecret is not implemented"))
+}
+
+func (UnimplementedMonitorServiceHandler) DeleteSecret(context.Context, *connect.Request[v1.DeleteSecretRequest]) (*connect.Response[v1.DeleteSecretResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pulsar.v1.MonitorService.DeleteSecret is not implemented"))
+}
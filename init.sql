@@ -8,7 +8,11 @@ CREATE TABLE IF NOT EXISTS monitors (
     interval_seconds INTEGER NOT NULL,
     is_active BOOLEAN DEFAULT true,
     last_check TIMESTAMP WITH TIME ZONE,
-    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    type TEXT NOT NULL DEFAULT 'http', -- http, icmp, push, grpc, script
+    config JSONB NOT NULL DEFAULT '{}',
+    push_token TEXT UNIQUE, -- push monitors: /push/<token>
+    last_heartbeat TIMESTAMP WITH TIME ZONE
 );
 
 -- 3. Monitor Results (Ping & Waterfall)
@@ -26,6 +30,13 @@ CREATE TABLE IF NOT EXISTS monitor_results (
     timing_tls INTEGER NOT NULL DEFAULT 0,
     timing_ttfb INTEGER NOT NULL DEFAULT 0,
     timing_download INTEGER NOT NULL DEFAULT 0,
+
+    -- ICMP (ms / percent, NULL for other types)
+    rtt_min DOUBLE PRECISION,
+    rtt_avg DOUBLE PRECISION,
+    rtt_max DOUBLE PRECISION,
+    jitter DOUBLE PRECISION,
+    packet_loss DOUBLE PRECISION,
     
     created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
 );
@@ -36,6 +47,7 @@ CREATE INDEX IF NOT EXISTS idx_results_monitor_id_created ON monitor_results(mon
 -- 5. SYSTEM STATS 
 CREATE TABLE IF NOT EXISTS system_stats (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    host TEXT NOT NULL DEFAULT 'local', -- 'local' = the worker's own host
     
     -- Source Usages    
     cpu_percent DOUBLE PRECISION NOT NULL,
@@ -49,8 +61,235 @@ CREATE TABLE IF NOT EXISTS system_stats (
     threads_sleeping INTEGER NOT NULL DEFAULT 0,
     threads_zombie INTEGER NOT NULL DEFAULT 0,
 
+    -- Load Averages
+    load_1 DOUBLE PRECISION NOT NULL DEFAULT 0,
+    load_5 DOUBLE PRECISION NOT NULL DEFAULT 0,
+    load_15 DOUBLE PRECISION NOT NULL DEFAULT 0,
+
     created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
 );
 
 -- 6. System Stats Indexes
-CREATE INDEX IF NOT EXISTS idx_system_stats_created ON system_stats(created_at DESC);
\ No newline at end of file
+CREATE INDEX IF NOT EXISTS idx_system_stats_created ON system_stats(created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_system_stats_host_created ON system_stats(host, created_at DESC);
+
+-- 7. Per-Mountpoint Disk, Per-Interface Network, Per-Core CPU
+CREATE TABLE IF NOT EXISTS system_disk_stats (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    system_stat_id UUID NOT NULL REFERENCES system_stats(id) ON DELETE CASCADE,
+
+    mountpoint TEXT NOT NULL,
+    device TEXT NOT NULL,
+    fstype TEXT NOT NULL,
+    used_bytes BIGINT NOT NULL,
+    total_bytes BIGINT NOT NULL,
+    used_percent DOUBLE PRECISION NOT NULL,
+    read_kb_s DOUBLE PRECISION NOT NULL DEFAULT 0,
+    write_kb_s DOUBLE PRECISION NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS system_net_stats (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    system_stat_id UUID NOT NULL REFERENCES system_stats(id) ON DELETE CASCADE,
+
+    interface TEXT NOT NULL,
+    rx_kb_s DOUBLE PRECISION NOT NULL,
+    tx_kb_s DOUBLE PRECISION NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS system_cpu_stats (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    system_stat_id UUID NOT NULL REFERENCES system_stats(id) ON DELETE CASCADE,
+
+    core INTEGER NOT NULL,
+    percent DOUBLE PRECISION NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_system_disk_stats_stat ON system_disk_stats(system_stat_id);
+CREATE INDEX IF NOT EXISTS idx_system_disk_stats_mount_created ON system_disk_stats(mountpoint, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_system_net_stats_stat ON system_net_stats(system_stat_id);
+CREATE INDEX IF NOT EXISTS idx_system_net_stats_iface_created ON system_net_stats(interface, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_system_cpu_stats_stat ON system_cpu_stats(system_stat_id);
+
+-- 8. Top Process Snapshots
+CREATE TABLE IF NOT EXISTS system_process_stats (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    system_stat_id UUID NOT NULL REFERENCES system_stats(id) ON DELETE CASCADE,
+
+    pid INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    username TEXT NOT NULL,
+    state TEXT NOT NULL,
+    threads INTEGER NOT NULL,
+    cpu_percent DOUBLE PRECISION NOT NULL,
+    rss_bytes BIGINT NOT NULL,
+    memory_percent DOUBLE PRECISION NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_system_process_stats_stat ON system_process_stats(system_stat_id);
+CREATE INDEX IF NOT EXISTS idx_system_process_stats_created ON system_process_stats(created_at DESC);
+
+-- 9. System Alert Rules & Incidents
+CREATE TABLE IF NOT EXISTS alert_rules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL,
+    metric TEXT NOT NULL,
+    comparison TEXT NOT NULL DEFAULT '>' CHECK (comparison IN ('>', '>=', '<', '<=')),
+    threshold DOUBLE PRECISION NOT NULL,
+    duration_seconds INTEGER NOT NULL DEFAULT 0,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO alert_rules (name, metric, comparison, threshold, duration_seconds)
+SELECT * FROM (VALUES
+    ('High CPU', 'cpu_percent', '>', 80::DOUBLE PRECISION, 60),
+    ('High Memory', 'memory_percent', '>', 90, 60),
+    ('Disk Almost Full', 'disk_percent', '>', 95, 0),
+    ('Too Many Processes', 'threads_total', '>', 3000, 0)
+) AS defaults
+WHERE NOT EXISTS (SELECT 1 FROM alert_rules);
+
+CREATE TABLE IF NOT EXISTS incidents (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    rule_id UUID NOT NULL REFERENCES alert_rules(id) ON DELETE CASCADE,
+    host TEXT NOT NULL DEFAULT 'local',
+
+    value DOUBLE PRECISION NOT NULL,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    opened_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_incidents_open ON incidents(rule_id, host) WHERE resolved_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_incidents_started ON incidents(started_at DESC);
+
+-- 10. Remote Agents
+CREATE TABLE IF NOT EXISTS agents (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    host TEXT NOT NULL UNIQUE,
+    token_hash TEXT NOT NULL UNIQUE,
+    last_seen_at TIMESTAMP WITH TIME ZONE,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- 11. Per-Cgroup (Container / Service) Usage
+CREATE TABLE IF NOT EXISTS cgroup_stats (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    system_stat_id UUID NOT NULL REFERENCES system_stats(id) ON DELETE CASCADE,
+
+    path TEXT NOT NULL,
+    container_id TEXT NOT NULL DEFAULT '',
+    cpu_percent DOUBLE PRECISION NOT NULL,
+    cpu_limit DOUBLE PRECISION NOT NULL DEFAULT 0, -- cores, 0 = unlimited
+    memory_bytes BIGINT NOT NULL,
+    memory_limit BIGINT NOT NULL DEFAULT 0,
+    io_read_kb_s DOUBLE PRECISION NOT NULL DEFAULT 0,
+    io_write_kb_s DOUBLE PRECISION NOT NULL DEFAULT 0,
+    pids INTEGER NOT NULL,
+    pids_limit INTEGER NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_cgroup_stats_stat ON cgroup_stats(system_stat_id);
+CREATE INDEX IF NOT EXISTS idx_cgroup_stats_created ON cgroup_stats(created_at DESC);
+
+-- 12. Script Monitor Steps (children of one monitor_results row)
+CREATE TABLE IF NOT EXISTS monitor_result_steps (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    result_id UUID NOT NULL REFERENCES monitor_results(id) ON DELETE CASCADE,
+
+    position INTEGER NOT NULL, -- 0-based, in script order
+    name TEXT NOT NULL,
+    method TEXT NOT NULL,
+    url TEXT NOT NULL, -- after variable substitution
+    status_code INTEGER NOT NULL, -- 0 when the request failed
+    latency INTEGER NOT NULL,
+    error TEXT NOT NULL DEFAULT '', -- empty = passed
+
+    -- Waterfall (Trace)
+    timing_dns INTEGER NOT NULL DEFAULT 0,
+    timing_tcp INTEGER NOT NULL DEFAULT 0,
+    timing_tls INTEGER NOT NULL DEFAULT 0,
+    timing_ttfb INTEGER NOT NULL DEFAULT 0,
+    timing_download INTEGER NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_result_steps_result ON monitor_result_steps(result_id, position);
+
+-- 13. Content Snapshots (http monitors with content change detection)
+-- A row per change: the first look at the page and every differing one.
+CREATE TABLE IF NOT EXISTS content_snapshots (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    monitor_id UUID NOT NULL REFERENCES monitors(id) ON DELETE CASCADE,
+
+    hash TEXT NOT NULL, -- sha256 of content
+    content TEXT NOT NULL, -- normalized text
+    diff TEXT NOT NULL DEFAULT '', -- unified diff against the previous snapshot
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_content_snapshots_monitor ON content_snapshots(monitor_id, created_at DESC);
+
+-- 14. Redirect Chains (http results that were redirected or broke the policy)
+CREATE TABLE IF NOT EXISTS monitor_result_redirects (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    result_id UUID NOT NULL REFERENCES monitor_results(id) ON DELETE CASCADE,
+
+    position INTEGER NOT NULL, -- 0 = the monitor's own URL
+    url TEXT NOT NULL,
+    status_code INTEGER NOT NULL, -- 0 when the request failed
+    latency INTEGER NOT NULL, -- until the response headers
+
+    -- Waterfall (Trace)
+    timing_dns INTEGER NOT NULL DEFAULT 0,
+    timing_tcp INTEGER NOT NULL DEFAULT 0,
+    timing_tls INTEGER NOT NULL DEFAULT 0,
+    timing_ttfb INTEGER NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_result_redirects_result ON monitor_result_redirects(result_id, position);
+
+-- 15. Probe Credentials (proxy & client certificates, never copied into monitors.config)
+CREATE TABLE IF NOT EXISTS monitor_credentials (
+    monitor_id UUID PRIMARY KEY REFERENCES monitors(id) ON DELETE CASCADE,
+
+    proxy_url TEXT NOT NULL DEFAULT '', -- http, https or socks5, may carry user:password
+    client_cert TEXT NOT NULL DEFAULT '', -- PEM
+    client_key TEXT NOT NULL DEFAULT '', -- PEM
+    ca_bundle TEXT NOT NULL DEFAULT '', -- PEM, trusted next to the system roots
+
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- 16. Secrets (envelope encrypted, referenced as {{secret:<id>}})
+CREATE TABLE IF NOT EXISTS secrets (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL UNIQUE,
+    monitor_id UUID REFERENCES monitors(id) ON DELETE CASCADE, -- set for secrets sealed from a monitor's credentials
+
+    ciphertext BYTEA NOT NULL, -- nonce || AES-256-GCM(data key, value)
+    wrapped_key BYTEA NOT NULL, -- nonce || AES-256-GCM(master key, data key)
+    key_id TEXT NOT NULL, -- fingerprint of the master key
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_secrets_key ON secrets(key_id);
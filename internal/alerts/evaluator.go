@@ -0,0 +1,292 @@
+package alerts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	pulsarv1 "github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1"
+	"github.com/barkinrl/pulsar/internal/db"
+	"github.com/barkinrl/pulsar/internal/events"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/redis/go-redis/v9"
+)
+
+// Metrics a rule can watch, named like the system_stats columns
+const (
+	MetricCPU           = "cpu_percent"
+	MetricMemory        = "memory_percent"
+	MetricDisk          = "disk_percent"
+	MetricNetwork       = "network_kb_s"
+	MetricThreadsTotal  = "threads_total"
+	MetricThreadsZombie = "threads_zombie"
+	MetricLoad1         = "load_1"
+	MetricLoad5         = "load_5"
+	MetricLoad15        = "load_15"
+)
+
+var metrics = map[string]bool{
+	MetricCPU: true, MetricMemory: true, MetricDisk: true, MetricNetwork: true,
+	MetricThreadsTotal: true, MetricThreadsZombie: true,
+	MetricLoad1: true, MetricLoad5: true, MetricLoad15: true,
+}
+
+const (
+	// rules are re-read this often, so edits apply without a restart
+	ruleReload = 30 * time.Second
+
+	// when each rule's condition began on each host, shared by every process
+	// that records samples; forgotten if a host stops reporting
+	sinceKeyPfx = "pulsar:alerts:since:"
+	sinceTTL    = 5 * time.Minute
+)
+
+// Validate checks a rule's metric and comparison.
+func Validate(metric, comparison string) error {
+	if !metrics[metric] {
+		return fmt.Errorf("unknown metric %q", metric)
+	}
+	switch comparison {
+	case ">", ">=", "<", "<=":
+		return nil
+	}
+	return fmt.Errorf("unknown comparison %q", comparison)
+}
+
+func breached(value float64, comparison string, threshold float64) bool {
+	switch comparison {
+	case ">":
+		return value > threshold
+	case ">=":
+		return value >= threshold
+	case "<":
+		return value < threshold
+	case "<=":
+		return value <= threshold
+	}
+	return false
+}
+
+// Evaluator checks each host's system samples against the alert rules. A
+// rule whose condition holds on a host for its duration opens an incident for
+// that host; the incident resolves on the first sample where it no longer
+// holds. Both are published on the event stream for the notifier and the
+// dashboard. Several processes may evaluate samples: the condition start is
+// kept in Redis and Postgres keeps a single open incident per rule and host.
+type Evaluator struct {
+	queries *db.Queries
+	rdb     *redis.Client
+
+	mu       sync.Mutex
+	rules    []db.AlertRule
+	loadedAt time.Time
+	open     map[incidentKey]bool
+}
+
+type incidentKey struct {
+	rule pgtype.UUID
+	host string
+}
+
+func NewEvaluator(queries *db.Queries, rdb *redis.Client) *Evaluator {
+	return &Evaluator{
+		queries: queries,
+		rdb:     rdb,
+		open:    map[incidentKey]bool{},
+	}
+}
+
+// Evaluate checks values (metric -> value) sampled on host at the given time.
+func (e *Evaluator) Evaluate(ctx context.Context, host string, values map[string]float64, at time.Time) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.reload(ctx)
+
+	for _, r := range e.rules {
+		value, ok := values[r.Metric]
+		if !ok || !r.IsActive {
+			continue
+		}
+		key := incidentKey{rule: r.ID, host: host}
+		sinceKey := sinceKeyPfx + pgUUIDToString(r.ID) + "/" + host
+
+		if !breached(value, r.Comparison, r.Threshold) {
+			// the condition just stopped holding: there may be an incident to
+			// resolve, opened here or elsewhere
+			ended, err := e.rdb.Del(ctx, sinceKey).Result()
+			if e.open[key] || (err == nil && ended > 0) {
+				e.resolve(ctx, r, host, at)
+			}
+			continue
+		}
+
+		started, err := e.since(ctx, sinceKey, at)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("⚠️ Alert state error (%s): %v", r.Name, err)
+			}
+			continue
+		}
+		if !e.open[key] && at.Sub(started) >= time.Duration(r.DurationSeconds)*time.Second {
+			e.openIncident(ctx, r, host, value, started)
+		}
+	}
+}
+
+// since returns when the condition behind key began, starting it at at if
+// this is its first breaching sample.
+func (e *Evaluator) since(ctx context.Context, key string, at time.Time) (time.Time, error) {
+	pipe := e.rdb.Pipeline()
+	pipe.SetNX(ctx, key, at.UnixMilli(), sinceTTL)
+	pipe.Expire(ctx, key, sinceTTL)
+	get := pipe.Get(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return time.Time{}, err
+	}
+	ms, err := strconv.ParseInt(get.Val(), 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.UnixMilli(ms), nil
+}
+
+// Warn reports whether value breaks an active rule on metric, regardless of
+// how long it has: what the dashboard's is_warning flags show.
+func (e *Evaluator) Warn(metric string, value float64) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, r := range e.rules {
+		if r.IsActive && r.Metric == metric && breached(value, r.Comparison, r.Threshold) {
+			return true
+		}
+	}
+	return false
+}
+
+// reload re-reads the rules and the open incidents. Incidents of rules that
+// were switched off are resolved.
+func (e *Evaluator) reload(ctx context.Context) {
+	if time.Since(e.loadedAt) < ruleReload {
+		return
+	}
+
+	rules, err := e.queries.ListAlertRules(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("⚠️ Alert rules could not be loaded: %v", err)
+		}
+		return
+	}
+	incidents, err := e.queries.ListOpenIncidents(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("⚠️ Open incidents could not be loaded: %v", err)
+		}
+		return
+	}
+	e.rules, e.loadedAt = rules, time.Now()
+
+	e.open = make(map[incidentKey]bool, len(incidents))
+	for _, inc := range incidents {
+		e.open[incidentKey{rule: inc.RuleID, host: inc.Host}] = true
+	}
+	for _, r := range rules {
+		if r.IsActive {
+			continue
+		}
+		for key := range e.open {
+			if key.rule == r.ID {
+				e.resolve(ctx, r, key.host, time.Now())
+			}
+		}
+	}
+}
+
+func (e *Evaluator) openIncident(ctx context.Context, r db.AlertRule, host string, value float64, started time.Time) {
+	key := incidentKey{rule: r.ID, host: host}
+	inc, err := e.queries.OpenIncident(ctx, db.OpenIncidentParams{
+		RuleID:    r.ID,
+		Host:      host,
+		Value:     value,
+		StartedAt: pgtype.Timestamptz{Time: started, Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		// already open (elsewhere, or before a restart)
+		e.open[key] = true
+		return
+	}
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("⚠️ Incident could not be opened (%s): %v", r.Name, err)
+		}
+		return
+	}
+	e.open[key] = true
+
+	log.Printf("🚨 Incident opened: %s on %s (%s %.1f %s %g)", r.Name, host, r.Metric, value, r.Comparison, r.Threshold)
+	e.publish(ctx, IncidentProto(inc, r))
+}
+
+func (e *Evaluator) resolve(ctx context.Context, r db.AlertRule, host string, at time.Time) {
+	key := incidentKey{rule: r.ID, host: host}
+	inc, err := e.queries.ResolveIncident(ctx, db.ResolveIncidentParams{
+		RuleID:     r.ID,
+		Host:       host,
+		ResolvedAt: pgtype.Timestamptz{Time: at, Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		delete(e.open, key)
+		return
+	}
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("⚠️ Incident could not be resolved (%s): %v", r.Name, err)
+		}
+		return
+	}
+	delete(e.open, key)
+
+	log.Printf("✅ Incident resolved: %s on %s", r.Name, host)
+	e.publish(ctx, IncidentProto(inc, r))
+}
+
+func (e *Evaluator) publish(ctx context.Context, inc *pulsarv1.Incident) {
+	err := events.Publish(ctx, e.rdb, &pulsarv1.Event{
+		Payload: &pulsarv1.Event_Incident{Incident: inc},
+	})
+	if err != nil && ctx.Err() == nil {
+		log.Printf("⚠️ Incident Publish Error: %v", err)
+	}
+}
+
+// IncidentProto converts an incident and its rule to the API message.
+func IncidentProto(inc db.Incident, r db.AlertRule) *pulsarv1.Incident {
+	resp := &pulsarv1.Incident{
+		Id:         pgUUIDToString(inc.ID),
+		RuleId:     pgUUIDToString(r.ID),
+		RuleName:   r.Name,
+		Host:       inc.Host,
+		Metric:     r.Metric,
+		Comparison: r.Comparison,
+		Threshold:  r.Threshold,
+		Value:      inc.Value,
+		StartedAt:  inc.StartedAt.Time.Format(time.RFC3339),
+	}
+	if inc.ResolvedAt.Valid {
+		resp.ResolvedAt = inc.ResolvedAt.Time.Format(time.RFC3339)
+	}
+	return resp
+}
+
+func pgUUIDToString(uuid pgtype.UUID) string {
+	if !uuid.Valid {
+		return ""
+	}
+	src := uuid.Bytes
+	return fmt.Sprintf("%x-%x-%x-%x-%x", src[0:4], src[4:6], src[6:8], src[8:10], src[10:16])
+}
@@ -0,0 +1,94 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	pulsarv1 "github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1"
+	"github.com/barkinrl/pulsar/internal/events"
+	"github.com/redis/go-redis/v9"
+)
+
+// every worker's notifier shares this group, so each incident is sent once
+const notifierGroup = "notifier"
+
+// Notifier sends opened and resolved incidents and page content changes to a
+// Discord webhook (or any webhook taking {"content": ...}). Without a URL
+// they are only logged.
+type Notifier struct {
+	webhookURL string
+	client     *http.Client
+}
+
+func NewNotifier(webhookURL string) *Notifier {
+	return &Notifier{
+		webhookURL: webhookURL,
+		client:     &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Run consumes incident events until ctx is done. Failed sends stay pending
+// on the stream and are retried.
+func (n *Notifier) Run(ctx context.Context, rdb *redis.Client, name string) error {
+	return events.NewConsumer(rdb, notifierGroup, name).Run(ctx, n.handle)
+}
+
+func (n *Notifier) handle(ctx context.Context, ev *pulsarv1.Event) error {
+	var msg string
+	switch {
+	case ev.GetIncident() != nil:
+		msg = Message(ev.GetIncident())
+	case ev.GetContentChange() != nil:
+		msg = ContentMessage(ev.GetContentChange())
+	default:
+		return nil
+	}
+
+	if n.webhookURL == "" {
+		log.Printf("📢 %s", msg)
+		return nil
+	}
+
+	body, _ := json.Marshal(map[string]string{"content": msg})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// ContentMessage, the notification text for a content change. The diff is
+// cut to what fits into a Discord message.
+func ContentMessage(c *pulsarv1.ContentChange) string {
+	diff := c.Diff
+	if len(diff) > 1500 {
+		diff = strings.ToValidUTF8(diff[:1500], "") + "\n..."
+	}
+	return fmt.Sprintf("📝 **Content changed** on %s (+%d -%d lines)\n```diff\n%s\n```", c.Url, c.Added, c.Removed, diff)
+}
+
+// Message, the notification text for an incident
+func Message(inc *pulsarv1.Incident) string {
+	if inc.ResolvedAt != "" {
+		return fmt.Sprintf("✅ **%s** on %s resolved: %s %s %g no longer holds (%s → %s)",
+			inc.RuleName, inc.Host, inc.Metric, inc.Comparison, inc.Threshold, inc.StartedAt, inc.ResolvedAt)
+	}
+	return fmt.Sprintf("🚨 **%s** on %s: %s is %.1f (%s %g) since %s",
+		inc.RuleName, inc.Host, inc.Metric, inc.Value, inc.Comparison, inc.Threshold, inc.StartedAt)
+}
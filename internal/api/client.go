@@ -0,0 +1,138 @@
+package api
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// Time allowed to write a message to the peer
+	writeWait = 10 * time.Second
+
+	// Time allowed to read the next pong from the peer
+	pongWait = 60 * time.Second
+
+	// Send pings with this period. Must be less than pongWait
+	pingPeriod = (pongWait * 9) / 10
+
+	// Messages queued per client before it is considered too slow
+	sendBufferSize = 64
+
+	// Clients only send small subscribe/unsubscribe frames
+	maxMessageSize = 8192
+)
+
+// Client, a single WebSocket connection with its own send queue
+type Client struct {
+	hub  *Hub
+	conn *websocket.Conn
+	send chan []byte
+
+	// owned by the hub goroutine
+	topics   *topicSet
+	session  string // empty without a session store
+	since    int64  // last seq the client saw before reconnecting
+	lastSeq  int64  // last seq sent to the client
+	resuming bool   // replay not sent yet, live messages go to backlog
+	backlog  []message
+
+	// set by the hub right before send is closed
+	closeCode   int
+	closeReason string
+	closed      bool
+}
+
+// newClient; a resuming client gets room for the whole replay on top of the
+// usual queue, since the hub queues it in one go.
+func newClient(h *Hub, conn *websocket.Conn, topics *topicSet, resuming bool) *Client {
+	size := sendBufferSize
+	if resuming {
+		size += replaySize + 1 // + the "resume" frame
+	}
+	return &Client{
+		hub:      h,
+		conn:     conn,
+		send:     make(chan []byte, size),
+		topics:   topics,
+		resuming: resuming,
+	}
+}
+
+// close makes writePump send a close frame and exit. Only the hub calls it;
+// calling it again does nothing.
+func (c *Client) close(code int, reason string) {
+	if c.closed {
+		return
+	}
+	c.closed = true
+	c.closeCode = code
+	c.closeReason = reason
+	close(c.send)
+}
+
+// writePump is the only goroutine writing to the connection. It also pings
+// the peer so dead connections are noticed by readPump.
+func (c *Client) writePump() {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+		c.hub.pumps.Done()
+	}()
+
+	for {
+		select {
+		case message, ok := <-c.send:
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if !ok {
+				// send closed by the hub
+				c.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(c.closeCode, c.closeReason))
+				return
+			}
+			if err := c.conn.WriteMessage(websocket.TextMessage, message); err != nil {
+				return
+			}
+
+		case <-ticker.C:
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// readPump hands subscribe/unsubscribe frames to the hub until the connection
+// fails, then unregisters.
+func (c *Client) readPump() {
+	defer func() {
+		select {
+		case c.hub.unregister <- c:
+		case <-c.hub.stopping:
+		}
+		c.hub.pumps.Done()
+	}()
+
+	c.conn.SetReadLimit(maxMessageSize)
+	c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		sub := subscription{client: c}
+		sub.err = json.Unmarshal(data, &sub.frame)
+
+		select {
+		case c.hub.subscriptions <- sub:
+		case <-c.hub.stopping:
+			return
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	pulsarv1 "github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1"
+	"github.com/barkinrl/pulsar/internal/systemstats"
+)
+
+// eventJSON renders an event as the {"type": ..., "data": ...} message the
+// dashboard reads over /ws.
+func eventJSON(ev *pulsarv1.Event) ([]byte, error) {
+	switch p := ev.Payload.(type) {
+	case *pulsarv1.Event_MonitorUpdate:
+		u := p.MonitorUpdate
+		t := u.GetTiming()
+		data := map[string]interface{}{
+			"monitor_id": u.MonitorId,
+			"url":        u.Url,
+			"status":     u.Status,
+			"code":       u.Code,
+			"latency":    u.Latency,
+			"time":       u.Time,
+			"timing": map[string]int32{
+				"dns":      t.GetDns(),
+				"connect":  t.GetTcp(),
+				"tls":      t.GetTls(),
+				"ttfb":     t.GetTtfb(),
+				"download": t.GetDownload(),
+			},
+		}
+		if i := u.GetIcmp(); i != nil {
+			data["icmp"] = map[string]float64{
+				"rtt_min":     i.GetRttMin(),
+				"rtt_avg":     i.GetRttAvg(),
+				"rtt_max":     i.GetRttMax(),
+				"jitter":      i.GetJitter(),
+				"packet_loss": i.GetPacketLoss(),
+			}
+		}
+		if len(u.GetSteps()) > 0 {
+			steps := make([]map[string]interface{}, 0, len(u.GetSteps()))
+			for _, s := range u.GetSteps() {
+				st := s.GetTiming()
+				steps = append(steps, map[string]interface{}{
+					"name":    s.GetName(),
+					"method":  s.GetMethod(),
+					"url":     s.GetUrl(),
+					"code":    s.GetCode(),
+					"latency": s.GetLatency(),
+					"error":   s.GetError(),
+					"timing": map[string]int32{
+						"dns":      st.GetDns(),
+						"connect":  st.GetTcp(),
+						"tls":      st.GetTls(),
+						"ttfb":     st.GetTtfb(),
+						"download": st.GetDownload(),
+					},
+				})
+			}
+			data["steps"] = steps
+		}
+		if len(u.GetRedirects()) > 0 {
+			hops := make([]map[string]interface{}, 0, len(u.GetRedirects()))
+			for _, h := range u.GetRedirects() {
+				ht := h.GetTiming()
+				hops = append(hops, map[string]interface{}{
+					"url":     h.GetUrl(),
+					"code":    h.GetCode(),
+					"latency": h.GetLatency(),
+					"timing": map[string]int32{
+						"dns":     ht.GetDns(),
+						"connect": ht.GetTcp(),
+						"tls":     ht.GetTls(),
+						"ttfb":    ht.GetTtfb(),
+					},
+				})
+			}
+			data["redirects"] = hops
+		}
+		return json.Marshal(map[string]interface{}{
+			"type": "monitor_update",
+			"data": data,
+		})
+
+	case *pulsarv1.Event_System:
+		s := p.System
+		disks := make([]map[string]interface{}, 0, len(s.GetDisks()))
+		for _, d := range s.GetDisks() {
+			disks = append(disks, map[string]interface{}{
+				"mountpoint": d.GetMountpoint(),
+				"device":     d.GetDevice(),
+				"percent":    d.GetPercent(),
+				"used":       d.GetUsed(),
+				"total":      d.GetTotal(),
+				"read_kbs":   d.GetReadKbs(),
+				"write_kbs":  d.GetWriteKbs(),
+				"is_warning": d.GetIsWarning(),
+			})
+		}
+		ifaces := make([]map[string]interface{}, 0, len(s.GetInterfaces()))
+		for _, i := range s.GetInterfaces() {
+			ifaces = append(ifaces, map[string]interface{}{
+				"name":   i.GetName(),
+				"rx_kbs": i.GetRxKbs(),
+				"tx_kbs": i.GetTxKbs(),
+			})
+		}
+		cgroups := make([]map[string]interface{}, 0, len(s.GetCgroups()))
+		for _, c := range s.GetCgroups() {
+			cgroups = append(cgroups, map[string]interface{}{
+				"path":         c.GetPath(),
+				"container_id": c.GetContainerId(),
+				"cpu_percent":  c.GetCpuPercent(),
+				"cpu_limit":    c.GetCpuLimit(),
+				"memory_bytes": c.GetMemoryBytes(),
+				"memory_limit": c.GetMemoryLimit(),
+				"io_read_kbs":  c.GetIoReadKbs(),
+				"io_write_kbs": c.GetIoWriteKbs(),
+				"pids":         c.GetPids(),
+				"pids_limit":   c.GetPidsLimit(),
+			})
+		}
+		processes := func(list []*pulsarv1.ProcessInfo) []map[string]interface{} {
+			rows := make([]map[string]interface{}, 0, len(list))
+			for _, p := range list {
+				rows = append(rows, map[string]interface{}{
+					"pid":            p.GetPid(),
+					"name":           p.GetName(),
+					"user":           p.GetUser(),
+					"state":          p.GetState(),
+					"threads":        p.GetThreads(),
+					"cpu_percent":    p.GetCpuPercent(),
+					"rss_bytes":      p.GetRssBytes(),
+					"memory_percent": p.GetMemoryPercent(),
+				})
+			}
+			return rows
+		}
+		// agents' hosts get their own type, so the local dashboard keeps
+		// showing only the local host
+		kind, host := "system", s.GetHost()
+		if host == "" {
+			host = systemstats.LocalHost
+		}
+		if host != systemstats.LocalHost {
+			kind = "host_system"
+		}
+		return json.Marshal(map[string]interface{}{
+			"type": kind,
+			"data": map[string]interface{}{
+				"host": host,
+				"cpu": map[string]interface{}{
+					"percent": s.GetCpu().GetPercent(),
+				},
+				"memory": map[string]interface{}{
+					"percent": s.GetMemory().GetPercent(),
+					"used":    s.GetMemory().GetUsed(),
+					"total":   s.GetMemory().GetTotal(),
+				},
+				"disk": map[string]interface{}{
+					"percent": s.GetDisk().GetPercent(),
+					"used":    s.GetDisk().GetUsed(),
+					"total":   s.GetDisk().GetTotal(),
+				},
+				"network": map[string]interface{}{
+					"used": s.GetNetwork().GetUsed(),
+				},
+				"threads": map[string]interface{}{
+					"total":      s.GetThreads().GetTotal(),
+					"running":    s.GetThreads().GetRunning(),
+					"sleeping":   s.GetThreads().GetSleeping(),
+					"zombie":     s.GetThreads().GetZombie(),
+					"is_warning": s.GetThreads().GetIsWarning(),
+				},
+				"cpu_cores": s.GetCpuCores(),
+				"load": map[string]interface{}{
+					"load1":  s.GetLoad().GetLoad1(),
+					"load5":  s.GetLoad().GetLoad5(),
+					"load15": s.GetLoad().GetLoad15(),
+				},
+				"disks":      disks,
+				"interfaces": ifaces,
+				"cgroups":    cgroups,
+				"top_cpu":    processes(s.GetTopCpu()),
+				"top_memory": processes(s.GetTopMemory()),
+				"uptime":     s.GetInfo().GetUptimeSeconds(),
+				"os":         s.GetInfo().GetPlatform(),
+			},
+		})
+
+	case *pulsarv1.Event_ContentChange:
+		c := p.ContentChange
+		return json.Marshal(map[string]interface{}{
+			"type": "content_change",
+			"data": map[string]interface{}{
+				"monitor_id": c.MonitorId,
+				"url":        c.Url,
+				"old_hash":   c.OldHash,
+				"new_hash":   c.NewHash,
+				"added":      c.Added,
+				"removed":    c.Removed,
+				"diff":       c.Diff,
+				"time":       c.Time,
+			},
+		})
+
+	case *pulsarv1.Event_Incident:
+		inc := p.Incident
+		return json.Marshal(map[string]interface{}{
+			"type": "incident",
+			"data": map[string]interface{}{
+				"id":          inc.Id,
+				"rule_id":     inc.RuleId,
+				"rule_name":   inc.RuleName,
+				"metric":      inc.Metric,
+				"comparison":  inc.Comparison,
+				"threshold":   inc.Threshold,
+				"value":       inc.Value,
+				"started_at":  inc.StartedAt,
+				"resolved_at": inc.ResolvedAt,
+				"host":        inc.Host,
+			},
+		})
+	}
+	return nil, fmt.Errorf("event without payload")
+}
+
+// BroadcastEvent sends a worker event to the WebSocket clients.
+func (h *Hub) BroadcastEvent(ev *pulsarv1.Event) {
+	payload, err := eventJSON(ev)
+	if err != nil {
+		log.Printf("⚠️ Event encode error: %v", err)
+		return
+	}
+	h.Broadcast(ev.Seq, payload)
+}
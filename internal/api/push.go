@@ -0,0 +1,91 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/barkinrl/pulsar/internal/db"
+	"github.com/barkinrl/pulsar/internal/worker"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// PushHandler takes the heartbeats of push monitors on /push/{token}. Jobs
+// call it when they finish, optionally with ?status=up|down and
+// ?duration_ms=<how long the run took>:
+//
+//	curl -fsS "https://pulsar.example.com/push/<token>?status=up&duration_ms=5300"
+type PushHandler struct {
+	queries *db.Queries
+	results *worker.ResultRecorder
+}
+
+func NewPushHandler(queries *db.Queries, results *worker.ResultRecorder) *PushHandler {
+	return &PushHandler{
+		queries: queries,
+		results: results,
+	}
+}
+
+func (h *PushHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet, http.MethodPost, http.MethodHead:
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	token := pgtype.Text{String: r.PathValue("token"), Valid: true}
+	m, err := h.queries.GetMonitorByPushToken(r.Context(), token)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && m.Type != worker.MonitorTypePush) {
+		http.Error(w, "unknown token", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	var res worker.CheckResult
+	switch strings.ToLower(r.FormValue("status")) {
+	case "", "up", "ok", "success":
+		res.Status, res.Up = "UP", true
+	case "down", "fail", "error":
+		res.Status = "DOWN"
+	default:
+		http.Error(w, "status must be up or down", http.StatusBadRequest)
+		return
+	}
+	if v := r.FormValue("duration_ms"); v != "" {
+		ms, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || ms < 0 {
+			http.Error(w, "invalid duration_ms", http.StatusBadRequest)
+			return
+		}
+		res.Latency = time.Duration(ms) * time.Millisecond
+	}
+
+	// a failed run still counts as a heartbeat: the job did run
+	if err := h.queries.RecordHeartbeat(r.Context(), m.ID); err != nil {
+		log.Printf("❌ Heartbeat kaydedilemedi: %v", err)
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	h.results.Record(r.Context(), pgUUIDToString(m.ID), m.Url, res)
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ok"))
+}
+
+func pgUUIDToString(uuid pgtype.UUID) string {
+	if !uuid.Valid {
+		return ""
+	}
+	src := uuid.Bytes
+	return fmt.Sprintf("%x-%x-%x-%x-%x", src[0:4], src[4:6], src[6:8], src[8:10], src[10:16])
+}
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	replayKey  = "pulsar:ws:replay"
+	replaySize = 1000
+	replayTTL  = 15 * time.Minute
+)
+
+// Every API replica appends the same events with the same seq: only the
+// first one is stored.
+var appendScript = redis.NewScript(`
+if redis.call("ZCOUNT", KEYS[1], ARGV[1], ARGV[1]) == 0 then
+	redis.call("ZADD", KEYS[1], ARGV[1], ARGV[2])
+	redis.call("ZREMRANGEBYRANK", KEYS[1], 0, -tonumber(ARGV[3]) - 1)
+end
+redis.call("PEXPIRE", KEYS[1], ARGV[4])
+return 1
+`)
+
+// ReplayBuffer keeps the last few numbered messages in Redis so a
+// reconnecting client can catch up from the last sequence it saw, on any
+// replica.
+type ReplayBuffer struct {
+	rdb *redis.Client
+}
+
+func NewReplayBuffer(rdb *redis.Client) *ReplayBuffer {
+	return &ReplayBuffer{rdb: rdb}
+}
+
+// Append adds seq to payload (a JSON object), stores it and returns the
+// stamped payload. Messages without a seq are returned as is.
+func (r *ReplayBuffer) Append(ctx context.Context, seq int64, payload []byte) ([]byte, error) {
+	if seq == 0 {
+		return payload, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return nil, err
+	}
+	fields["seq"] = json.RawMessage(strconv.FormatInt(seq, 10))
+	stamped, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	err = appendScript.Run(ctx, r.rdb, []string{replayKey},
+		seq, stamped, replaySize, replayTTL.Milliseconds()).Err()
+	return stamped, err
+}
+
+// Since returns the stored messages newer than seq, oldest first, and the
+// newest stored seq. complete is false when some of them already fell out of
+// the buffer.
+func (r *ReplayBuffer) Since(ctx context.Context, seq int64) (msgs [][]byte, last int64, complete bool, err error) {
+	newest, err := r.rdb.ZRevRangeWithScores(ctx, replayKey, 0, 0).Result()
+	if err != nil {
+		return nil, 0, false, err
+	}
+	if len(newest) == 0 {
+		// nothing stored (yet, or any more)
+		return nil, 0, seq == 0, nil
+	}
+	last = int64(newest[0].Score)
+	if seq == last {
+		return nil, last, true, nil
+	}
+	if seq > last {
+		// counter was reset (e.g. Redis flushed): the client has to start over
+		return nil, last, false, nil
+	}
+
+	members, err := r.rdb.ZRangeByScore(ctx, replayKey, &redis.ZRangeBy{
+		Min: "(" + strconv.FormatInt(seq, 10),
+		Max: "+inf",
+	}).Result()
+	if err != nil {
+		return nil, last, false, err
+	}
+
+	msgs = make([][]byte, 0, len(members))
+	for _, m := range members {
+		msgs = append(msgs, []byte(m))
+	}
+
+	// complete if the oldest message we return directly follows seq
+	complete = len(msgs) > 0 && newMessage(msgs[0]).seq == seq+1
+	return msgs, last, complete, nil
+}
@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestReplay(t *testing.T) *ReplayBuffer {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	return NewReplayBuffer(rdb)
+}
+
+func appendSeqs(t *testing.T, r *ReplayBuffer, seqs ...int64) {
+	t.Helper()
+	for _, seq := range seqs {
+		payload := fmt.Sprintf(`{"type":"monitor_update","data":{"n":%d}}`, seq)
+		if _, err := r.Append(context.Background(), seq, []byte(payload)); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func replaySeqs(msgs [][]byte) []int64 {
+	seqs := []int64{}
+	for _, m := range msgs {
+		seqs = append(seqs, newMessage(m).seq)
+	}
+	return seqs
+}
+
+func TestReplaySince(t *testing.T) {
+	r := newTestReplay(t)
+	ctx := context.Background()
+
+	// replicas append as their consumers go: out of order, and every
+	// event once per replica
+	appendSeqs(t, r, 2, 1, 3, 2, 5, 4, 1, 5)
+
+	tests := []struct {
+		since    int64
+		want     []int64
+		complete bool
+	}{
+		{0, []int64{1, 2, 3, 4, 5}, true},
+		{2, []int64{3, 4, 5}, true},
+		{5, []int64{}, true},
+		{9, []int64{}, false}, // counter reset
+	}
+	for _, tt := range tests {
+		msgs, last, complete, err := r.Since(ctx, tt.since)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := replaySeqs(msgs); !reflect.DeepEqual(got, tt.want) || last != 5 || complete != tt.complete {
+			t.Errorf("Since(%d) = %v, last %d, complete %v; want %v, 5, %v", tt.since, got, last, complete, tt.want, tt.complete)
+		}
+	}
+}
+
+func TestReplaySinceEvicted(t *testing.T) {
+	r := newTestReplay(t)
+	ctx := context.Background()
+
+	for seq := int64(1); seq <= replaySize+10; seq++ {
+		appendSeqs(t, r, seq)
+	}
+	msgs, last, complete, err := r.Since(ctx, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != replaySize || last != replaySize+10 || complete {
+		t.Errorf("Since(5) = %d messages, last %d, complete %v", len(msgs), last, complete)
+	}
+	if seqs := replaySeqs(msgs); seqs[0] != 11 || seqs[len(seqs)-1] != replaySize+10 {
+		t.Errorf("kept %d..%d, want the newest", seqs[0], seqs[len(seqs)-1])
+	}
+
+	// one the client already has sits right before the oldest kept
+	if _, _, complete, _ := r.Since(ctx, 10); !complete {
+		t.Error("Since(10) incomplete")
+	}
+}
+
+func TestReplayAppend(t *testing.T) {
+	r := newTestReplay(t)
+	ctx := context.Background()
+
+	stamped, err := r.Append(ctx, 42, []byte(`{"type":"system","data":{}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m := newMessage(stamped); m.seq != 42 || m.kind != "system" {
+		t.Errorf("stamped %s", stamped)
+	}
+
+	// unnumbered messages aren't kept
+	plain := []byte(`{"type":"hello"}`)
+	if out, err := r.Append(ctx, 0, plain); err != nil || string(out) != string(plain) {
+		t.Errorf("Append(0) = %s, %v", out, err)
+	}
+	if msgs, _, _, _ := r.Since(ctx, 0); len(msgs) != 1 {
+		t.Errorf("%d messages stored, want 1", len(msgs))
+	}
+	if _, err := r.Append(ctx, 43, []byte(`not json`)); err == nil {
+		t.Error("appended a payload that isn't an object")
+	}
+}
+
+func TestReplayEmpty(t *testing.T) {
+	r := newTestReplay(t)
+	for since, want := range map[int64]bool{0: true, 3: false} {
+		msgs, last, complete, err := r.Since(context.Background(), since)
+		if err != nil || len(msgs) != 0 || last != 0 || complete != want {
+			t.Errorf("Since(%d) on an empty buffer = %d, %d, %v, %v", since, len(msgs), last, complete, err)
+		}
+	}
+}
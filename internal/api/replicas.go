@@ -0,0 +1,99 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	replicasKey       = "pulsar:ws:replicas"
+	replicaHeartbeat  = 10 * time.Second
+	replicaStaleAfter = 3 * replicaHeartbeat
+)
+
+// ReplicaInfo, what each API replica reports about itself
+type ReplicaInfo struct {
+	ID        string    `json:"id"`
+	Clients   int       `json:"clients"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Replicas publishes this replica's WebSocket client count to Redis and
+// lists every live replica's count.
+type Replicas struct {
+	rdb *redis.Client
+	id  string
+}
+
+func NewReplicas(rdb *redis.Client, id string) *Replicas {
+	return &Replicas{rdb: rdb, id: id}
+}
+
+// Run reports clients() every few seconds until ctx is done, then removes
+// this replica.
+func (r *Replicas) Run(ctx context.Context, clients func() int) {
+	ticker := time.NewTicker(replicaHeartbeat)
+	defer ticker.Stop()
+
+	for {
+		info, _ := json.Marshal(ReplicaInfo{ID: r.id, Clients: clients(), UpdatedAt: time.Now().UTC()})
+		if err := r.rdb.HSet(ctx, replicasKey, r.id, info).Err(); err != nil && ctx.Err() == nil {
+			log.Printf("⚠️ Replica heartbeat error: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			cctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			r.rdb.HDel(cctx, replicasKey, r.id)
+			cancel()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// List returns the replicas that reported recently, pruning the others.
+func (r *Replicas) List(ctx context.Context) ([]ReplicaInfo, error) {
+	all, err := r.rdb.HGetAll(ctx, replicasKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	replicas := make([]ReplicaInfo, 0, len(all))
+	for id, data := range all {
+		var info ReplicaInfo
+		if json.Unmarshal([]byte(data), &info) != nil || time.Since(info.UpdatedAt) > replicaStaleAfter {
+			r.rdb.HDel(ctx, replicasKey, id)
+			continue
+		}
+		replicas = append(replicas, info)
+	}
+	sort.Slice(replicas, func(i, j int) bool { return replicas[i].ID < replicas[j].ID })
+	return replicas, nil
+}
+
+// ServeHTTP lists the replicas and their client counts as JSON.
+func (r *Replicas) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	replicas, err := r.List(req.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	total := 0
+	for _, info := range replicas {
+		total += info.Clients
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"self":     r.id,
+		"replicas": replicas,
+		"clients":  total,
+	})
+}
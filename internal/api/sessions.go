@@ -0,0 +1,89 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	sessionKeyPfx = "pulsar:ws:session:"
+	sessionTTL    = 24 * time.Hour
+)
+
+var sessionIDPattern = regexp.MustCompile(`^[A-Za-z0-9-]{8,64}$`)
+
+// SessionStore keeps each client's topics in Redis under a session id, so a
+// reconnect that lands on another replica gets the same subscriptions.
+type SessionStore struct {
+	rdb    *redis.Client
+	writes chan sessionWrite
+}
+
+type sessionWrite struct {
+	id     string
+	topics []string
+}
+
+func NewSessionStore(rdb *redis.Client) *SessionStore {
+	return &SessionStore{
+		rdb:    rdb,
+		writes: make(chan sessionWrite, 256),
+	}
+}
+
+// Run writes the queued updates, in order, until ctx is done.
+func (s *SessionStore) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case w := <-s.writes:
+			data, _ := json.Marshal(w.topics)
+			wctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+			if err := s.rdb.Set(wctx, sessionKeyPfx+w.id, data, sessionTTL).Err(); err != nil {
+				log.Printf("⚠️ WebSocket session save error: %v", err)
+			}
+			cancel()
+		}
+	}
+}
+
+// Load returns the topics stored for id; ok is false for unknown sessions.
+func (s *SessionStore) Load(ctx context.Context, id string) (topics []string, ok bool, err error) {
+	data, err := s.rdb.Get(ctx, sessionKeyPfx+id).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if err := json.Unmarshal(data, &topics); err != nil {
+		return nil, false, err
+	}
+	return topics, true, nil
+}
+
+// save queues the client's topics without blocking; called from the hub.
+func (s *SessionStore) save(id string, topics []string) {
+	select {
+	case s.writes <- sessionWrite{id: id, topics: topics}:
+	default:
+		log.Println("⚠️ WebSocket session writes backed up, dropping one")
+	}
+}
+
+// sessionID returns the id the client asked for if it looks valid, a new one
+// otherwise.
+func sessionID(requested string) string {
+	if sessionIDPattern.MatchString(requested) {
+		return requested
+	}
+	return uuid.NewString()
+}
@@ -0,0 +1,180 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Topics a client can subscribe to over /ws. "*" means every message and is
+// the default for new connections; a message type ("system",
+// "monitor_update") selects that kind; "monitor:<id>" a single monitor and
+// "group:<url>" every monitor whose URL is <url> or lives under <url>/, like
+// the dashboard groups; "host:<name>" the system stats and incidents of one
+// host ("local" for the worker's own).
+const (
+	topicAll          = "*"
+	topicMonitorPfx   = "monitor:"
+	topicGroupPfx     = "group:"
+	topicHostPfx      = "host:"
+	maxTopicsPerFrame = 100
+)
+
+// message, a payload plus what the hub needs to route it
+type message struct {
+	seq       int64
+	kind      string
+	monitorID string
+	url       string
+	host      string
+	data      []byte
+}
+
+// newMessage reads the routing keys out of a {"seq": ..., "type": ..., "data": {...}} payload.
+func newMessage(payload []byte) message {
+	var envelope struct {
+		Seq  int64  `json:"seq"`
+		Type string `json:"type"`
+		Data struct {
+			MonitorID string `json:"monitor_id"`
+			URL       string `json:"url"`
+			Host      string `json:"host"`
+		} `json:"data"`
+	}
+	json.Unmarshal(payload, &envelope)
+
+	return message{
+		seq:       envelope.Seq,
+		kind:      envelope.Type,
+		monitorID: envelope.Data.MonitorID,
+		url:       envelope.Data.URL,
+		host:      envelope.Data.Host,
+		data:      payload,
+	}
+}
+
+// topicSet, the subscriptions of one client. Only touched by the hub goroutine.
+type topicSet struct {
+	all      bool
+	kinds    map[string]bool
+	monitors map[string]bool
+	groups   map[string]bool
+	hosts    map[string]bool
+}
+
+func newTopicSet(topics ...string) *topicSet {
+	t := &topicSet{
+		kinds:    make(map[string]bool),
+		monitors: make(map[string]bool),
+		groups:   make(map[string]bool),
+		hosts:    make(map[string]bool),
+	}
+	for _, topic := range topics {
+		t.add(topic)
+	}
+	return t
+}
+
+func (t *topicSet) add(topic string) error {
+	switch {
+	case topic == topicAll:
+		t.all = true
+	case strings.HasPrefix(topic, topicMonitorPfx):
+		id := strings.TrimPrefix(topic, topicMonitorPfx)
+		if id == "" {
+			return fmt.Errorf("empty monitor id in %q", topic)
+		}
+		t.monitors[id] = true
+	case strings.HasPrefix(topic, topicGroupPfx):
+		prefix := strings.TrimSuffix(strings.TrimPrefix(topic, topicGroupPfx), "/")
+		if prefix == "" {
+			return fmt.Errorf("empty group in %q", topic)
+		}
+		t.groups[prefix] = true
+	case strings.HasPrefix(topic, topicHostPfx):
+		host := strings.TrimPrefix(topic, topicHostPfx)
+		if host == "" {
+			return fmt.Errorf("empty host in %q", topic)
+		}
+		t.hosts[host] = true
+	case topic != "" && !strings.Contains(topic, ":"):
+		t.kinds[topic] = true
+	default:
+		return fmt.Errorf("unknown topic %q", topic)
+	}
+	return nil
+}
+
+func (t *topicSet) remove(topic string) {
+	switch {
+	case topic == topicAll:
+		t.all = false
+	case strings.HasPrefix(topic, topicMonitorPfx):
+		delete(t.monitors, strings.TrimPrefix(topic, topicMonitorPfx))
+	case strings.HasPrefix(topic, topicGroupPfx):
+		delete(t.groups, strings.TrimSuffix(strings.TrimPrefix(topic, topicGroupPfx), "/"))
+	case strings.HasPrefix(topic, topicHostPfx):
+		delete(t.hosts, strings.TrimPrefix(topic, topicHostPfx))
+	default:
+		delete(t.kinds, topic)
+	}
+}
+
+func (t *topicSet) matches(m message) bool {
+	if t.all || t.kinds[m.kind] {
+		return true
+	}
+	if m.monitorID != "" && t.monitors[m.monitorID] {
+		return true
+	}
+	if m.host != "" && t.hosts[m.host] {
+		return true
+	}
+	if m.url != "" {
+		for prefix := range t.groups {
+			if m.url == prefix || strings.HasPrefix(m.url, prefix+"/") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// list returns the subscriptions in topic form, sorted.
+func (t *topicSet) list() []string {
+	topics := make([]string, 0, 1+len(t.kinds)+len(t.monitors)+len(t.groups)+len(t.hosts))
+	if t.all {
+		topics = append(topics, topicAll)
+	}
+	for k := range t.kinds {
+		topics = append(topics, k)
+	}
+	for id := range t.monitors {
+		topics = append(topics, topicMonitorPfx+id)
+	}
+	for g := range t.groups {
+		topics = append(topics, topicGroupPfx+g)
+	}
+	for h := range t.hosts {
+		topics = append(topics, topicHostPfx+h)
+	}
+	sort.Strings(topics)
+	return topics
+}
+
+// clientFrame, what clients send over /ws:
+//
+//	{"action": "subscribe",   "topics": ["system", "monitor:<id>"]}
+//	{"action": "unsubscribe", "topics": ["*"]}
+type clientFrame struct {
+	Action string   `json:"action"`
+	Topics []string `json:"topics"`
+}
+
+// subscription, a parsed client frame on its way to the hub
+type subscription struct {
+	client *Client
+	frame  clientFrame
+	err    error
+}
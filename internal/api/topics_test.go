@@ -0,0 +1,82 @@
+package api
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTopicSetMatches(t *testing.T) {
+	update := message{kind: "monitor_update", monitorID: "m1", url: "https://example.com/api/health"}
+	system := message{kind: "system", host: "web-1"}
+	incident := message{kind: "incident", host: "local"}
+
+	tests := []struct {
+		topics []string
+		msg    message
+		want   bool
+	}{
+		{[]string{"*"}, update, true},
+		{nil, update, false},
+		{[]string{"monitor_update"}, update, true},
+		{[]string{"monitor_update"}, system, false},
+		{[]string{"monitor:m1"}, update, true},
+		{[]string{"monitor:m2"}, update, false},
+		{[]string{"group:https://example.com"}, update, true},
+		{[]string{"group:https://example.com/"}, update, true},
+		{[]string{"group:https://example.com/api/health"}, update, true},
+		{[]string{"group:https://example.co"}, update, false}, // a prefix, not a parent
+		{[]string{"group:https://example.com/api/health/deep"}, update, false},
+		{[]string{"group:https://example.com"}, system, false}, // no url
+		{[]string{"host:web-1"}, system, true},
+		{[]string{"host:web-1"}, incident, false},
+		{[]string{"host:local"}, incident, true},
+		{[]string{"host:web-1"}, update, false},
+		{[]string{"monitor:"}, message{kind: "x"}, false}, // rejected, empty id
+		{[]string{"monitor:m2", "system"}, system, true},
+	}
+	for _, tt := range tests {
+		if got := newTopicSet(tt.topics...).matches(tt.msg); got != tt.want {
+			t.Errorf("%v matches %+v = %v, want %v", tt.topics, tt.msg, got, tt.want)
+		}
+	}
+}
+
+func TestTopicSetAdd(t *testing.T) {
+	set := newTopicSet()
+	for _, topic := range []string{"monitor:", "group:", "group:/", "host:", "", "bogus:x"} {
+		if err := set.add(topic); err == nil {
+			t.Errorf("add(%q) accepted", topic)
+		}
+	}
+	if list := set.list(); len(list) != 0 {
+		t.Errorf("rejected topics were kept: %v", list)
+	}
+}
+
+func TestTopicSetRemove(t *testing.T) {
+	set := newTopicSet("*", "system", "monitor:m1", "group:https://a/", "host:h")
+	want := []string{"*", "group:https://a", "host:h", "monitor:m1", "system"}
+	if got := set.list(); !reflect.DeepEqual(got, want) {
+		t.Errorf("list() = %v, want %v", got, want)
+	}
+
+	for _, topic := range []string{"*", "system", "monitor:m1", "group:https://a/", "host:h"} {
+		set.remove(topic)
+	}
+	if got := set.list(); len(got) != 0 {
+		t.Errorf("after removing everything: %v", got)
+	}
+	if set.matches(message{kind: "system", monitorID: "m1", url: "https://a", host: "h"}) {
+		t.Error("empty set matches")
+	}
+}
+
+func TestNewMessage(t *testing.T) {
+	m := newMessage([]byte(`{"seq": 7, "type": "monitor_update", "data": {"monitor_id": "m1", "url": "https://a", "host": "h"}}`))
+	if m.seq != 7 || m.kind != "monitor_update" || m.monitorID != "m1" || m.url != "https://a" || m.host != "h" {
+		t.Errorf("newMessage = %+v", m)
+	}
+	if m := newMessage([]byte(`not json`)); m.kind != "" || string(m.data) != "not json" {
+		t.Errorf("newMessage(not json) = %+v", m)
+	}
+}
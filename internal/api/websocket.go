@@ -1,11 +1,18 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"log"
 	"net/http"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/barkinrl/pulsar/internal/metrics"
 	"github.com/gorilla/websocket"
 )
 
@@ -19,85 +26,377 @@ var upgrader = websocket.Upgrader{
 
 // Hub
 type Hub struct {
-	clients    map[*websocket.Conn]bool
-	broadcast  chan []byte
-	register   chan *websocket.Conn
-	unregister chan *websocket.Conn
-	mutex      sync.Mutex
+	clients       map[*Client]bool
+	broadcast     chan message
+	register      chan *Client
+	unregister    chan *Client
+	subscriptions chan subscription
+	replays       chan replayResult
+	stopping      chan struct{} // closed when Run starts shutting down
+	done          chan struct{} // closed when every client is gone
+
+	// pumps of connected clients, waited on during shutdown
+	pumps sync.WaitGroup
+
+	// optional; stores numbered messages so clients can resume with ?since=<seq>
+	replay *ReplayBuffer
+
+	// optional; keeps client topics across reconnects with ?session=<id>
+	sessions *SessionStore
+
+	clientCount atomic.Int64
+}
+
+// replayResult, the missed messages of a resuming client
+type replayResult struct {
+	client   *Client
+	msgs     [][]byte
+	last     int64
+	complete bool
 }
 
-func NewHub() *Hub {
+func NewHub(replay *ReplayBuffer, sessions *SessionStore) *Hub {
 	return &Hub{
-		clients:    make(map[*websocket.Conn]bool),
-		broadcast:  make(chan []byte),
-		register:   make(chan *websocket.Conn),
-		unregister: make(chan *websocket.Conn),
+		clients:       make(map[*Client]bool),
+		broadcast:     make(chan message, 256),
+		register:      make(chan *Client),
+		unregister:    make(chan *Client),
+		subscriptions: make(chan subscription),
+		replays:       make(chan replayResult),
+		replay:        replay,
+		sessions:      sessions,
+		stopping:      make(chan struct{}),
+		done:          make(chan struct{}),
 	}
 }
 
-// Run
-func (h *Hub) Run() {
+// Run, until ctx is cancelled. Then every client gets a "going away" close frame.
+//
+// Run never writes to a connection itself: messages are queued on each
+// client's send channel and written by its own goroutine, so one slow browser
+// cannot stall the others.
+func (h *Hub) Run(ctx context.Context) {
 	for {
 		select {
-		case conn := <-h.register:
-			h.mutex.Lock()
-			h.clients[conn] = true
-			h.mutex.Unlock()
+		case <-ctx.Done():
+			close(h.stopping)
+			h.closeAll()
+			close(h.done)
+			return
+
+		case c := <-h.register:
+			// ServeWs starts both pumps right after this receive
+			h.pumps.Add(2)
+			h.clients[c] = true
+			h.countClients()
 			log.Println("🟢 New WebSocket Client Connected")
+			if c.session != "" {
+				h.sendSession(c)
+			}
 
-		case conn := <-h.unregister:
-			h.mutex.Lock()
-			if _, ok := h.clients[conn]; ok {
-				delete(h.clients, conn)
-				conn.Close()
+		case c := <-h.unregister:
+			if _, ok := h.clients[c]; ok {
+				h.remove(c, websocket.CloseNormalClosure, "")
 			}
-			h.mutex.Unlock()
 			log.Println("🔴 WebSocket Client Disconnect")
 
-		case message := <-h.broadcast:
-			h.mutex.Lock()
-			for conn := range h.clients {
-				err := conn.WriteMessage(websocket.TextMessage, message)
-				if err != nil {
-					log.Println("WS write error:", err)
-					conn.Close()
-					delete(h.clients, conn)
+		case sub := <-h.subscriptions:
+			if _, ok := h.clients[sub.client]; ok {
+				h.applySubscription(sub)
+			}
+
+		case r := <-h.replays:
+			if _, ok := h.clients[r.client]; ok {
+				h.finishReplay(r)
+			}
+
+		case msg := <-h.broadcast:
+			for c := range h.clients {
+				if !c.topics.matches(msg) {
+					continue
 				}
+				if c.resuming {
+					h.hold(c, msg)
+					continue
+				}
+				h.deliver(c, msg)
 			}
-			h.mutex.Unlock()
+			metrics.HubMessages.Inc()
 		}
 	}
 }
 
-// Broadcast
-func (h *Hub) Broadcast(data interface{}) {
-	bytes, err := json.Marshal(data)
-	if err != nil {
-		log.Println("JSON Marshal error:", err)
+// hold keeps live messages of a resuming client until its replay is sent.
+// Must only be called from Run.
+func (h *Hub) hold(c *Client, msg message) {
+	if len(c.backlog) >= sendBufferSize {
+		log.Println("🐢 Slow WebSocket client dropped (replay)")
+		metrics.HubDropped.Inc()
+		h.remove(c, websocket.CloseTryAgainLater, "slow consumer")
 		return
 	}
-	h.broadcast <- bytes
+	c.backlog = append(c.backlog, msg)
 }
 
-// ServeWs
+// finishReplay sends the missed messages, then whatever arrived meanwhile,
+// then a "resume" frame. Must only be called from Run.
+func (h *Hub) finishReplay(r replayResult) {
+	c := r.client
+	sent := c.since
+	for _, data := range r.msgs {
+		msg := newMessage(data)
+		if msg.seq > sent {
+			sent = msg.seq
+		}
+		if c.topics.matches(msg) && !h.trySend(c, data) {
+			return // dropped while catching up
+		}
+	}
+	c.lastSeq = sent
+	backlog := c.backlog
+	c.backlog = nil
+	c.resuming = false
+	for _, msg := range backlog {
+		// skips what the replay already covered
+		if !h.deliver(c, msg) {
+			return
+		}
+	}
+	bytes, _ := json.Marshal(map[string]interface{}{
+		"type": "resume",
+		"data": map[string]interface{}{
+			"from":     c.since,
+			"seq":      r.last,
+			"replayed": len(r.msgs),
+			"complete": r.complete,
+		},
+	})
+	h.trySend(c, bytes)
+}
+
+// deliver sends a live message unless the client already got it: replicas
+// consume the bus independently, so after a reconnect the new replica may
+// still be behind the old one. False once the client is gone. Must only be
+// called from Run.
+func (h *Hub) deliver(c *Client, msg message) bool {
+	if msg.seq != 0 {
+		// a jump back further than the replay buffer means the counter was
+		// reset, not a duplicate
+		if msg.seq <= c.lastSeq && c.lastSeq-msg.seq < replaySize {
+			return true
+		}
+		c.lastSeq = msg.seq
+	}
+	return h.trySend(c, msg.data)
+}
+
+// trySend queues data for c without blocking. False if the client is gone,
+// dropped now or before. Must only be called from Run.
+func (h *Hub) trySend(c *Client, data []byte) bool {
+	if _, ok := h.clients[c]; !ok {
+		return false // send is closed
+	}
+	select {
+	case c.send <- data:
+		return true
+	default:
+		// send queue full: drop the client instead of blocking everyone
+		log.Println("🐢 Slow WebSocket client dropped")
+		metrics.HubDropped.Inc()
+		h.remove(c, websocket.CloseTryAgainLater, "slow consumer")
+		return false
+	}
+}
+
+// applySubscription updates the client's topics and answers with the
+// resulting list (or an error). Must only be called from Run.
+func (h *Hub) applySubscription(sub subscription) {
+	c := sub.client
+	err := sub.err
+
+	if err == nil && len(sub.frame.Topics) > maxTopicsPerFrame {
+		err = fmt.Errorf("at most %d topics per frame", maxTopicsPerFrame)
+	}
+	if err == nil {
+		switch sub.frame.Action {
+		case "subscribe":
+			for _, topic := range sub.frame.Topics {
+				if err = c.topics.add(topic); err != nil {
+					break
+				}
+			}
+		case "unsubscribe":
+			for _, topic := range sub.frame.Topics {
+				c.topics.remove(topic)
+			}
+		default:
+			err = fmt.Errorf("unknown action %q", sub.frame.Action)
+		}
+	}
+
+	if err == nil && c.session != "" {
+		h.sessions.save(c.session, c.topics.list())
+	}
+
+	var reply interface{}
+	if err != nil {
+		reply = map[string]interface{}{
+			"type": "error",
+			"data": map[string]string{"message": err.Error()},
+		}
+	} else {
+		reply = map[string]interface{}{
+			"type": "subscriptions",
+			"data": map[string][]string{"topics": c.topics.list()},
+		}
+	}
+	bytes, _ := json.Marshal(reply)
+	h.trySend(c, bytes)
+}
+
+// sendSession tells a new client its session id and topics, and stores
+// them. Must only be called from Run.
+func (h *Hub) sendSession(c *Client) {
+	topics := c.topics.list()
+	h.sessions.save(c.session, topics)
+
+	bytes, _ := json.Marshal(map[string]interface{}{
+		"type": "session",
+		"data": map[string]interface{}{
+			"id":     c.session,
+			"topics": topics,
+		},
+	})
+	h.trySend(c, bytes)
+}
+
+// remove must only be called from Run; removing twice is harmless.
+func (h *Hub) remove(c *Client, code int, reason string) {
+	delete(h.clients, c)
+	c.close(code, reason)
+	h.countClients()
+}
+
+func (h *Hub) countClients() {
+	h.clientCount.Store(int64(len(h.clients)))
+	metrics.HubClients.Set(float64(len(h.clients)))
+}
+
+// ClientCount, the number of clients connected to this replica
+func (h *Hub) ClientCount() int {
+	return int(h.clientCount.Load())
+}
+
+// Done is closed once the hub has stopped and disconnected its clients.
+func (h *Hub) Done() <-chan struct{} {
+	return h.done
+}
+
+func (h *Hub) closeAll() {
+	for c := range h.clients {
+		h.remove(c, websocket.CloseGoingAway, "server shutting down")
+	}
+	h.pumps.Wait()
+	log.Println("🔴 WebSocket Hub stopped, all clients disconnected")
+}
+
+// Broadcast sends an encoded JSON message. seq is the bus-wide sequence
+// number of the event (0 for none); with a replay buffer it is added to the
+// message, which is stored first.
+func (h *Hub) Broadcast(seq int64, payload []byte) {
+	if h.replay != nil && seq != 0 {
+		stamped, err := h.replay.Append(context.Background(), seq, payload)
+		if err != nil {
+			log.Printf("⚠️ Replay buffer error: %v", err)
+		}
+		if stamped != nil {
+			payload = stamped
+		}
+	}
+	select {
+	case h.broadcast <- newMessage(payload):
+	case <-h.stopping:
+	}
+}
+
+// ServeWs. Clients start subscribed to every topic unless they pass
+// ?topics=system,monitor:<id>,... ; they can change it later with
+// subscribe/unsubscribe frames. A reconnecting client passes ?since=<seq>
+// (the last "seq" it saw) to get the messages it missed, and ?session=<id>
+// (from the "session" frame) to get its topics back, on any replica.
 func (h *Hub) ServeWs(w http.ResponseWriter, r *http.Request) {
+	var since int64
+	if q := r.URL.Query().Get("since"); q != "" && h.replay != nil {
+		var err error
+		if since, err = strconv.ParseInt(q, 10, 64); err != nil || since < 0 {
+			http.Error(w, "invalid since", http.StatusBadRequest)
+			return
+		}
+	}
+
+	topics := newTopicSet(topicAll)
+	if q := r.URL.Query().Get("topics"); q != "" {
+		topics = newTopicSet()
+		for _, topic := range strings.Split(q, ",") {
+			if err := topics.add(strings.TrimSpace(topic)); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+	}
+
+	var session string
+	if h.sessions != nil {
+		requested := r.URL.Query().Get("session")
+		session = sessionID(requested)
+		if session == requested && r.URL.Query().Get("topics") == "" {
+			stored, ok, err := h.sessions.Load(r.Context(), session)
+			if err != nil {
+				log.Printf("⚠️ WebSocket session load error: %v", err)
+			}
+			if ok {
+				topics = newTopicSet(stored...)
+			}
+		}
+	}
+
 	conn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		log.Println("Upgrade error:", err)
 		return
 	}
-	h.register <- conn
 
+	c := newClient(h, conn, topics, since > 0)
+	c.session = session
+	c.since = since
 
-	go func() {
-		defer func() {
-			h.unregister <- conn
-		}()
-		for {
-			_, _, err := conn.ReadMessage()
+	select {
+	case h.register <- c:
+	case <-h.stopping:
+		conn.Close()
+		return
+	}
+
+	go c.writePump()
+	go c.readPump()
+
+	if c.resuming {
+		// registered first, so nothing published from now on can be missed
+		go func() {
+			// r.Context() is gone once the connection is hijacked
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			res := replayResult{client: c}
+			var err error
+			res.msgs, res.last, res.complete, err = h.replay.Since(ctx, since)
 			if err != nil {
-				break
+				log.Printf("⚠️ Replay error: %v", err)
 			}
-		}
-	}()
+			select {
+			case h.replays <- res:
+			case <-h.stopping:
+			}
+		}()
+	}
 }
@@ -0,0 +1,106 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: agents.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createAgent = `-- name: CreateAgent :one
+INSERT INTO agents (host, token_hash)
+VALUES ($1, $2)
+RETURNING id, host, token_hash, last_seen_at, created_at
+`
+
+type CreateAgentParams struct {
+	Host      string `json:"host"`
+	TokenHash string `json:"token_hash"`
+}
+
+func (q *Queries) CreateAgent(ctx context.Context, arg CreateAgentParams) (Agent, error) {
+	row := q.db.QueryRow(ctx, createAgent, arg.Host, arg.TokenHash)
+	var i Agent
+	err := row.Scan(
+		&i.ID,
+		&i.Host,
+		&i.TokenHash,
+		&i.LastSeenAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteAgent = `-- name: DeleteAgent :exec
+DELETE FROM agents
+WHERE id = $1
+`
+
+func (q *Queries) DeleteAgent(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteAgent, id)
+	return err
+}
+
+const getAgentByTokenHash = `-- name: GetAgentByTokenHash :one
+SELECT id, host, token_hash, last_seen_at, created_at FROM agents
+WHERE token_hash = $1
+`
+
+func (q *Queries) GetAgentByTokenHash(ctx context.Context, tokenHash string) (Agent, error) {
+	row := q.db.QueryRow(ctx, getAgentByTokenHash, tokenHash)
+	var i Agent
+	err := row.Scan(
+		&i.ID,
+		&i.Host,
+		&i.TokenHash,
+		&i.LastSeenAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAgents = `-- name: ListAgents :many
+SELECT id, host, token_hash, last_seen_at, created_at FROM agents
+ORDER BY host ASC
+`
+
+func (q *Queries) ListAgents(ctx context.Context) ([]Agent, error) {
+	rows, err := q.db.Query(ctx, listAgents)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Agent
+	for rows.Next() {
+		var i Agent
+		if err := rows.Scan(
+			&i.ID,
+			&i.Host,
+			&i.TokenHash,
+			&i.LastSeenAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const touchAgent = `-- name: TouchAgent :exec
+UPDATE agents
+SET last_seen_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) TouchAgent(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, touchAgent, id)
+	return err
+}
@@ -0,0 +1,320 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: alerts.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createAlertRule = `-- name: CreateAlertRule :one
+INSERT INTO alert_rules (
+    name, metric, comparison, threshold, duration_seconds, is_active
+) VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, name, metric, comparison, threshold, duration_seconds, is_active, created_at
+`
+
+type CreateAlertRuleParams struct {
+	Name            string  `json:"name"`
+	Metric          string  `json:"metric"`
+	Comparison      string  `json:"comparison"`
+	Threshold       float64 `json:"threshold"`
+	DurationSeconds int32   `json:"duration_seconds"`
+	IsActive        bool    `json:"is_active"`
+}
+
+func (q *Queries) CreateAlertRule(ctx context.Context, arg CreateAlertRuleParams) (AlertRule, error) {
+	row := q.db.QueryRow(ctx, createAlertRule,
+		arg.Name,
+		arg.Metric,
+		arg.Comparison,
+		arg.Threshold,
+		arg.DurationSeconds,
+		arg.IsActive,
+	)
+	var i AlertRule
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Metric,
+		&i.Comparison,
+		&i.Threshold,
+		&i.DurationSeconds,
+		&i.IsActive,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteAlertRule = `-- name: DeleteAlertRule :exec
+DELETE FROM alert_rules
+WHERE id = $1
+`
+
+func (q *Queries) DeleteAlertRule(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteAlertRule, id)
+	return err
+}
+
+const listActiveAlertRules = `-- name: ListActiveAlertRules :many
+SELECT id, name, metric, comparison, threshold, duration_seconds, is_active, created_at FROM alert_rules
+WHERE is_active = true
+`
+
+func (q *Queries) ListActiveAlertRules(ctx context.Context) ([]AlertRule, error) {
+	rows, err := q.db.Query(ctx, listActiveAlertRules)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AlertRule
+	for rows.Next() {
+		var i AlertRule
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Metric,
+			&i.Comparison,
+			&i.Threshold,
+			&i.DurationSeconds,
+			&i.IsActive,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAlertRules = `-- name: ListAlertRules :many
+SELECT id, name, metric, comparison, threshold, duration_seconds, is_active, created_at FROM alert_rules
+ORDER BY created_at ASC
+`
+
+func (q *Queries) ListAlertRules(ctx context.Context) ([]AlertRule, error) {
+	rows, err := q.db.Query(ctx, listAlertRules)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AlertRule
+	for rows.Next() {
+		var i AlertRule
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Metric,
+			&i.Comparison,
+			&i.Threshold,
+			&i.DurationSeconds,
+			&i.IsActive,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listIncidents = `-- name: ListIncidents :many
+SELECT i.id, i.rule_id, i.value, i.started_at, i.opened_at, i.resolved_at, i.host, r.name AS rule_name, r.metric, r.comparison, r.threshold
+FROM incidents i
+JOIN alert_rules r ON r.id = i.rule_id
+ORDER BY i.started_at DESC
+LIMIT 100
+`
+
+type ListIncidentsRow struct {
+	ID         pgtype.UUID        `json:"id"`
+	RuleID     pgtype.UUID        `json:"rule_id"`
+	Value      float64            `json:"value"`
+	StartedAt  pgtype.Timestamptz `json:"started_at"`
+	OpenedAt   pgtype.Timestamptz `json:"opened_at"`
+	ResolvedAt pgtype.Timestamptz `json:"resolved_at"`
+	Host       string             `json:"host"`
+	RuleName   string             `json:"rule_name"`
+	Metric     string             `json:"metric"`
+	Comparison string             `json:"comparison"`
+	Threshold  float64            `json:"threshold"`
+}
+
+func (q *Queries) ListIncidents(ctx context.Context) ([]ListIncidentsRow, error) {
+	rows, err := q.db.Query(ctx, listIncidents)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListIncidentsRow
+	for rows.Next() {
+		var i ListIncidentsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.RuleID,
+			&i.Value,
+			&i.StartedAt,
+			&i.OpenedAt,
+			&i.ResolvedAt,
+			&i.Host,
+			&i.RuleName,
+			&i.Metric,
+			&i.Comparison,
+			&i.Threshold,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOpenIncidents = `-- name: ListOpenIncidents :many
+SELECT id, rule_id, value, started_at, opened_at, resolved_at, host FROM incidents
+WHERE resolved_at IS NULL
+`
+
+func (q *Queries) ListOpenIncidents(ctx context.Context) ([]Incident, error) {
+	rows, err := q.db.Query(ctx, listOpenIncidents)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Incident
+	for rows.Next() {
+		var i Incident
+		if err := rows.Scan(
+			&i.ID,
+			&i.RuleID,
+			&i.Value,
+			&i.StartedAt,
+			&i.OpenedAt,
+			&i.ResolvedAt,
+			&i.Host,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const openIncident = `-- name: OpenIncident :one
+INSERT INTO incidents (rule_id, host, value, started_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (rule_id, host) WHERE resolved_at IS NULL DO NOTHING
+RETURNING id, rule_id, value, started_at, opened_at, resolved_at, host
+`
+
+type OpenIncidentParams struct {
+	RuleID    pgtype.UUID        `json:"rule_id"`
+	Host      string             `json:"host"`
+	Value     float64            `json:"value"`
+	StartedAt pgtype.Timestamptz `json:"started_at"`
+}
+
+// Açık incident varsa hiçbir şey dönmez (pgx.ErrNoRows)
+func (q *Queries) OpenIncident(ctx context.Context, arg OpenIncidentParams) (Incident, error) {
+	row := q.db.QueryRow(ctx, openIncident,
+		arg.RuleID,
+		arg.Host,
+		arg.Value,
+		arg.StartedAt,
+	)
+	var i Incident
+	err := row.Scan(
+		&i.ID,
+		&i.RuleID,
+		&i.Value,
+		&i.StartedAt,
+		&i.OpenedAt,
+		&i.ResolvedAt,
+		&i.Host,
+	)
+	return i, err
+}
+
+const resolveIncident = `-- name: ResolveIncident :one
+UPDATE incidents
+SET resolved_at = $3
+WHERE rule_id = $1 AND host = $2 AND resolved_at IS NULL
+RETURNING id, rule_id, value, started_at, opened_at, resolved_at, host
+`
+
+type ResolveIncidentParams struct {
+	RuleID     pgtype.UUID        `json:"rule_id"`
+	Host       string             `json:"host"`
+	ResolvedAt pgtype.Timestamptz `json:"resolved_at"`
+}
+
+func (q *Queries) ResolveIncident(ctx context.Context, arg ResolveIncidentParams) (Incident, error) {
+	row := q.db.QueryRow(ctx, resolveIncident, arg.RuleID, arg.Host, arg.ResolvedAt)
+	var i Incident
+	err := row.Scan(
+		&i.ID,
+		&i.RuleID,
+		&i.Value,
+		&i.StartedAt,
+		&i.OpenedAt,
+		&i.ResolvedAt,
+		&i.Host,
+	)
+	return i, err
+}
+
+const updateAlertRule = `-- name: UpdateAlertRule :one
+UPDATE alert_rules
+SET name = $2, metric = $3, comparison = $4, threshold = $5, duration_seconds = $6, is_active = $7
+WHERE id = $1
+RETURNING id, name, metric, comparison, threshold, duration_seconds, is_active, created_at
+`
+
+type UpdateAlertRuleParams struct {
+	ID              pgtype.UUID `json:"id"`
+	Name            string      `json:"name"`
+	Metric          string      `json:"metric"`
+	Comparison      string      `json:"comparison"`
+	Threshold       float64     `json:"threshold"`
+	DurationSeconds int32       `json:"duration_seconds"`
+	IsActive        bool        `json:"is_active"`
+}
+
+func (q *Queries) UpdateAlertRule(ctx context.Context, arg UpdateAlertRuleParams) (AlertRule, error) {
+	row := q.db.QueryRow(ctx, updateAlertRule,
+		arg.ID,
+		arg.Name,
+		arg.Metric,
+		arg.Comparison,
+		arg.Threshold,
+		arg.DurationSeconds,
+		arg.IsActive,
+	)
+	var i AlertRule
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Metric,
+		&i.Comparison,
+		&i.Threshold,
+		&i.DurationSeconds,
+		&i.IsActive,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -8,13 +8,80 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type Agent struct {
+	ID         pgtype.UUID        `json:"id"`
+	Host       string             `json:"host"`
+	TokenHash  string             `json:"token_hash"`
+	LastSeenAt pgtype.Timestamptz `json:"last_seen_at"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type AlertRule struct {
+	ID              pgtype.UUID        `json:"id"`
+	Name            string             `json:"name"`
+	Metric          string             `json:"metric"`
+	Comparison      string             `json:"comparison"`
+	Threshold       float64            `json:"threshold"`
+	DurationSeconds int32              `json:"duration_seconds"`
+	IsActive        bool               `json:"is_active"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+}
+
+type CgroupStat struct {
+	ID           pgtype.UUID        `json:"id"`
+	SystemStatID pgtype.UUID        `json:"system_stat_id"`
+	Path         string             `json:"path"`
+	ContainerID  string             `json:"container_id"`
+	CpuPercent   float64            `json:"cpu_percent"`
+	CpuLimit     float64            `json:"cpu_limit"`
+	MemoryBytes  int64              `json:"memory_bytes"`
+	MemoryLimit  int64              `json:"memory_limit"`
+	IoReadKbS    float64            `json:"io_read_kb_s"`
+	IoWriteKbS   float64            `json:"io_write_kb_s"`
+	Pids         int32              `json:"pids"`
+	PidsLimit    int32              `json:"pids_limit"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+}
+
+type ContentSnapshot struct {
+	ID        pgtype.UUID        `json:"id"`
+	MonitorID pgtype.UUID        `json:"monitor_id"`
+	Hash      string             `json:"hash"`
+	Content   string             `json:"content"`
+	Diff      string             `json:"diff"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type Incident struct {
+	ID         pgtype.UUID        `json:"id"`
+	RuleID     pgtype.UUID        `json:"rule_id"`
+	Value      float64            `json:"value"`
+	StartedAt  pgtype.Timestamptz `json:"started_at"`
+	OpenedAt   pgtype.Timestamptz `json:"opened_at"`
+	ResolvedAt pgtype.Timestamptz `json:"resolved_at"`
+	Host       string             `json:"host"`
+}
+
 type Monitor struct {
-	ID              pgtype.UUID      `json:"id"`
-	Url             string           `json:"url"`
-	IntervalSeconds int32            `json:"interval_seconds"`
-	IsActive        bool             `json:"is_active"`
-	LastCheck       pgtype.Timestamp `json:"last_check"`
-	CreatedAt       pgtype.Timestamp `json:"created_at"`
+	ID              pgtype.UUID        `json:"id"`
+	Url             string             `json:"url"`
+	IntervalSeconds int32              `json:"interval_seconds"`
+	IsActive        bool               `json:"is_active"`
+	LastCheck       pgtype.Timestamp   `json:"last_check"`
+	CreatedAt       pgtype.Timestamp   `json:"created_at"`
+	Type            string             `json:"type"`
+	Config          []byte             `json:"config"`
+	PushToken       pgtype.Text        `json:"push_token"`
+	LastHeartbeat   pgtype.Timestamptz `json:"last_heartbeat"`
+}
+
+type MonitorCredential struct {
+	MonitorID  pgtype.UUID        `json:"monitor_id"`
+	ProxyUrl   string             `json:"proxy_url"`
+	ClientCert string             `json:"client_cert"`
+	ClientKey  string             `json:"client_key"`
+	CaBundle   string             `json:"ca_bundle"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
 }
 
 type MonitorResult struct {
@@ -29,6 +96,99 @@ type MonitorResult struct {
 	TimingTtfb     int32            `json:"timing_ttfb"`
 	TimingDownload int32            `json:"timing_download"`
 	CreatedAt      pgtype.Timestamp `json:"created_at"`
+	RttMin         pgtype.Float8    `json:"rtt_min"`
+	RttAvg         pgtype.Float8    `json:"rtt_avg"`
+	RttMax         pgtype.Float8    `json:"rtt_max"`
+	Jitter         pgtype.Float8    `json:"jitter"`
+	PacketLoss     pgtype.Float8    `json:"packet_loss"`
+}
+
+type MonitorResultRedirect struct {
+	ID         pgtype.UUID        `json:"id"`
+	ResultID   pgtype.UUID        `json:"result_id"`
+	Position   int32              `json:"position"`
+	Url        string             `json:"url"`
+	StatusCode int32              `json:"status_code"`
+	Latency    int32              `json:"latency"`
+	TimingDns  int32              `json:"timing_dns"`
+	TimingTcp  int32              `json:"timing_tcp"`
+	TimingTls  int32              `json:"timing_tls"`
+	TimingTtfb int32              `json:"timing_ttfb"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type MonitorResultStep struct {
+	ID             pgtype.UUID        `json:"id"`
+	ResultID       pgtype.UUID        `json:"result_id"`
+	Position       int32              `json:"position"`
+	Name           string             `json:"name"`
+	Method         string             `json:"method"`
+	Url            string             `json:"url"`
+	StatusCode     int32              `json:"status_code"`
+	Latency        int32              `json:"latency"`
+	Error          string             `json:"error"`
+	TimingDns      int32              `json:"timing_dns"`
+	TimingTcp      int32              `json:"timing_tcp"`
+	TimingTls      int32              `json:"timing_tls"`
+	TimingTtfb     int32              `json:"timing_ttfb"`
+	TimingDownload int32              `json:"timing_download"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+}
+
+type Secret struct {
+	ID         pgtype.UUID        `json:"id"`
+	Name       string             `json:"name"`
+	MonitorID  pgtype.UUID        `json:"monitor_id"`
+	Ciphertext []byte             `json:"ciphertext"`
+	WrappedKey []byte             `json:"wrapped_key"`
+	KeyID      string             `json:"key_id"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
+}
+
+type SystemCpuStat struct {
+	ID           pgtype.UUID        `json:"id"`
+	SystemStatID pgtype.UUID        `json:"system_stat_id"`
+	Core         int32              `json:"core"`
+	Percent      float64            `json:"percent"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+}
+
+type SystemDiskStat struct {
+	ID           pgtype.UUID        `json:"id"`
+	SystemStatID pgtype.UUID        `json:"system_stat_id"`
+	Mountpoint   string             `json:"mountpoint"`
+	Device       string             `json:"device"`
+	Fstype       string             `json:"fstype"`
+	UsedBytes    int64              `json:"used_bytes"`
+	TotalBytes   int64              `json:"total_bytes"`
+	UsedPercent  float64            `json:"used_percent"`
+	ReadKbS      float64            `json:"read_kb_s"`
+	WriteKbS     float64            `json:"write_kb_s"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+}
+
+type SystemNetStat struct {
+	ID           pgtype.UUID        `json:"id"`
+	SystemStatID pgtype.UUID        `json:"system_stat_id"`
+	Interface    string             `json:"interface"`
+	RxKbS        float64            `json:"rx_kb_s"`
+	TxKbS        float64            `json:"tx_kb_s"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+}
+
+type SystemProcessStat struct {
+	ID            pgtype.UUID        `json:"id"`
+	SystemStatID  pgtype.UUID        `json:"system_stat_id"`
+	Pid           int32              `json:"pid"`
+	Name          string             `json:"name"`
+	Username      string             `json:"username"`
+	State         string             `json:"state"`
+	Threads       int32              `json:"threads"`
+	CpuPercent    float64            `json:"cpu_percent"`
+	RssBytes      int64              `json:"rss_bytes"`
+	MemoryPercent float64            `json:"memory_percent"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
 }
 
 type SystemStat struct {
@@ -42,4 +202,8 @@ type SystemStat struct {
 	ThreadsSleeping int32              `json:"threads_sleeping"`
 	ThreadsZombie   int32              `json:"threads_zombie"`
 	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	Load1           float64            `json:"load_1"`
+	Load5           float64            `json:"load_5"`
+	Load15          float64            `json:"load_15"`
+	Host            string             `json:"host"`
 }
@@ -22,19 +22,60 @@ func (q *Queries) CleanOldMonitorResults(ctx context.Context) error {
 	return err
 }
 
+const createContentSnapshot = `-- name: CreateContentSnapshot :one
+INSERT INTO content_snapshots (monitor_id, hash, content, diff)
+VALUES ($1, $2, $3, $4)
+RETURNING id, monitor_id, hash, content, diff, created_at
+`
+
+type CreateContentSnapshotParams struct {
+	MonitorID pgtype.UUID `json:"monitor_id"`
+	Hash      string      `json:"hash"`
+	Content   string      `json:"content"`
+	Diff      string      `json:"diff"`
+}
+
+func (q *Queries) CreateContentSnapshot(ctx context.Context, arg CreateContentSnapshotParams) (ContentSnapshot, error) {
+	row := q.db.QueryRow(ctx, createContentSnapshot,
+		arg.MonitorID,
+		arg.Hash,
+		arg.Content,
+		arg.Diff,
+	)
+	var i ContentSnapshot
+	err := row.Scan(
+		&i.ID,
+		&i.MonitorID,
+		&i.Hash,
+		&i.Content,
+		&i.Diff,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const createMonitor = `-- name: CreateMonitor :one
-INSERT INTO monitors (url, interval_seconds)
-VALUES ($1, $2)
-RETURNING id, url, interval_seconds, is_active, last_check, created_at
+INSERT INTO monitors (url, interval_seconds, type, config, push_token)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, url, interval_seconds, is_active, last_check, created_at, type, config, push_token, last_heartbeat
 `
 
 type CreateMonitorParams struct {
-	Url             string `json:"url"`
-	IntervalSeconds int32  `json:"interval_seconds"`
+	Url             string      `json:"url"`
+	IntervalSeconds int32       `json:"interval_seconds"`
+	Type            string      `json:"type"`
+	Config          []byte      `json:"config"`
+	PushToken       pgtype.Text `json:"push_token"`
 }
 
 func (q *Queries) CreateMonitor(ctx context.Context, arg CreateMonitorParams) (Monitor, error) {
-	row := q.db.QueryRow(ctx, createMonitor, arg.Url, arg.IntervalSeconds)
+	row := q.db.QueryRow(ctx, createMonitor,
+		arg.Url,
+		arg.IntervalSeconds,
+		arg.Type,
+		arg.Config,
+		arg.PushToken,
+	)
 	var i Monitor
 	err := row.Scan(
 		&i.ID,
@@ -43,6 +84,10 @@ func (q *Queries) CreateMonitor(ctx context.Context, arg CreateMonitorParams) (M
 		&i.IsActive,
 		&i.LastCheck,
 		&i.CreatedAt,
+		&i.Type,
+		&i.Config,
+		&i.PushToken,
+		&i.LastHeartbeat,
 	)
 	return i, err
 }
@@ -60,23 +105,33 @@ INSERT INTO monitor_results (
     timing_tls,
     timing_ttfb,
     timing_download,
+    rtt_min,
+    rtt_avg,
+    rtt_max,
+    jitter,
+    packet_loss,
     created_at
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW()
-) RETURNING id, monitor_id, status_code, status, latency, timing_dns, timing_tcp, timing_tls, timing_ttfb, timing_download, created_at
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, NOW()
+) RETURNING id, monitor_id, status_code, status, latency, timing_dns, timing_tcp, timing_tls, timing_ttfb, timing_download, created_at, rtt_min, rtt_avg, rtt_max, jitter, packet_loss
 `
 
 type CreateMonitorResultParams struct {
-	ID             pgtype.UUID `json:"id"`
-	MonitorID      pgtype.UUID `json:"monitor_id"`
-	StatusCode     int32       `json:"status_code"`
-	Status         string      `json:"status"`
-	Latency        int32       `json:"latency"`
-	TimingDns      int32       `json:"timing_dns"`
-	TimingTcp      int32       `json:"timing_tcp"`
-	TimingTls      int32       `json:"timing_tls"`
-	TimingTtfb     int32       `json:"timing_ttfb"`
-	TimingDownload int32       `json:"timing_download"`
+	ID             pgtype.UUID   `json:"id"`
+	MonitorID      pgtype.UUID   `json:"monitor_id"`
+	StatusCode     int32         `json:"status_code"`
+	Status         string        `json:"status"`
+	Latency        int32         `json:"latency"`
+	TimingDns      int32         `json:"timing_dns"`
+	TimingTcp      int32         `json:"timing_tcp"`
+	TimingTls      int32         `json:"timing_tls"`
+	TimingTtfb     int32         `json:"timing_ttfb"`
+	TimingDownload int32         `json:"timing_download"`
+	RttMin         pgtype.Float8 `json:"rtt_min"`
+	RttAvg         pgtype.Float8 `json:"rtt_avg"`
+	RttMax         pgtype.Float8 `json:"rtt_max"`
+	Jitter         pgtype.Float8 `json:"jitter"`
+	PacketLoss     pgtype.Float8 `json:"packet_loss"`
 }
 
 // --- YENİ EKLENENLER (History için) ---
@@ -92,6 +147,11 @@ func (q *Queries) CreateMonitorResult(ctx context.Context, arg CreateMonitorResu
 		arg.TimingTls,
 		arg.TimingTtfb,
 		arg.TimingDownload,
+		arg.RttMin,
+		arg.RttAvg,
+		arg.RttMax,
+		arg.Jitter,
+		arg.PacketLoss,
 	)
 	var i MonitorResult
 	err := row.Scan(
@@ -106,10 +166,98 @@ func (q *Queries) CreateMonitorResult(ctx context.Context, arg CreateMonitorResu
 		&i.TimingTtfb,
 		&i.TimingDownload,
 		&i.CreatedAt,
+		&i.RttMin,
+		&i.RttAvg,
+		&i.RttMax,
+		&i.Jitter,
+		&i.PacketLoss,
 	)
 	return i, err
 }
 
+const createMonitorResultRedirects = `-- name: CreateMonitorResultRedirects :exec
+INSERT INTO monitor_result_redirects (
+    result_id, position, url, status_code, latency,
+    timing_dns, timing_tcp, timing_tls, timing_ttfb
+)
+SELECT $1::uuid, unnest($2::int[]), unnest($3::text[]), unnest($4::int[]),
+    unnest($5::int[]), unnest($6::int[]), unnest($7::int[]),
+    unnest($8::int[]), unnest($9::int[])
+`
+
+type CreateMonitorResultRedirectsParams struct {
+	ResultID    pgtype.UUID `json:"result_id"`
+	Positions   []int32     `json:"positions"`
+	Urls        []string    `json:"urls"`
+	StatusCodes []int32     `json:"status_codes"`
+	Latencies   []int32     `json:"latencies"`
+	TimingDns   []int32     `json:"timing_dns"`
+	TimingTcp   []int32     `json:"timing_tcp"`
+	TimingTls   []int32     `json:"timing_tls"`
+	TimingTtfb  []int32     `json:"timing_ttfb"`
+}
+
+func (q *Queries) CreateMonitorResultRedirects(ctx context.Context, arg CreateMonitorResultRedirectsParams) error {
+	_, err := q.db.Exec(ctx, createMonitorResultRedirects,
+		arg.ResultID,
+		arg.Positions,
+		arg.Urls,
+		arg.StatusCodes,
+		arg.Latencies,
+		arg.TimingDns,
+		arg.TimingTcp,
+		arg.TimingTls,
+		arg.TimingTtfb,
+	)
+	return err
+}
+
+const createMonitorResultSteps = `-- name: CreateMonitorResultSteps :exec
+INSERT INTO monitor_result_steps (
+    result_id, position, name, method, url, status_code, latency, error,
+    timing_dns, timing_tcp, timing_tls, timing_ttfb, timing_download
+)
+SELECT $1::uuid, unnest($2::int[]), unnest($3::text[]), unnest($4::text[]),
+    unnest($5::text[]), unnest($6::int[]), unnest($7::int[]), unnest($8::text[]),
+    unnest($9::int[]), unnest($10::int[]), unnest($11::int[]),
+    unnest($12::int[]), unnest($13::int[])
+`
+
+type CreateMonitorResultStepsParams struct {
+	ResultID       pgtype.UUID `json:"result_id"`
+	Positions      []int32     `json:"positions"`
+	Names          []string    `json:"names"`
+	Methods        []string    `json:"methods"`
+	Urls           []string    `json:"urls"`
+	StatusCodes    []int32     `json:"status_codes"`
+	Latencies      []int32     `json:"latencies"`
+	Errors         []string    `json:"errors"`
+	TimingDns      []int32     `json:"timing_dns"`
+	TimingTcp      []int32     `json:"timing_tcp"`
+	TimingTls      []int32     `json:"timing_tls"`
+	TimingTtfb     []int32     `json:"timing_ttfb"`
+	TimingDownload []int32     `json:"timing_download"`
+}
+
+func (q *Queries) CreateMonitorResultSteps(ctx context.Context, arg CreateMonitorResultStepsParams) error {
+	_, err := q.db.Exec(ctx, createMonitorResultSteps,
+		arg.ResultID,
+		arg.Positions,
+		arg.Names,
+		arg.Methods,
+		arg.Urls,
+		arg.StatusCodes,
+		arg.Latencies,
+		arg.Errors,
+		arg.TimingDns,
+		arg.TimingTcp,
+		arg.TimingTls,
+		arg.TimingTtfb,
+		arg.TimingDownload,
+	)
+	return err
+}
+
 const deleteMonitor = `-- name: DeleteMonitor :exec
 DELETE FROM monitors WHERE id = $1
 `
@@ -119,8 +267,182 @@ func (q *Queries) DeleteMonitor(ctx context.Context, id pgtype.UUID) error {
 	return err
 }
 
+const deleteMonitorCredentials = `-- name: DeleteMonitorCredentials :exec
+DELETE FROM monitor_credentials
+WHERE monitor_id = $1
+`
+
+func (q *Queries) DeleteMonitorCredentials(ctx context.Context, monitorID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteMonitorCredentials, monitorID)
+	return err
+}
+
+const getLatestContentSnapshot = `-- name: GetLatestContentSnapshot :one
+SELECT id, monitor_id, hash, content, diff, created_at FROM content_snapshots
+WHERE monitor_id = $1
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestContentSnapshot(ctx context.Context, monitorID pgtype.UUID) (ContentSnapshot, error) {
+	row := q.db.QueryRow(ctx, getLatestContentSnapshot, monitorID)
+	var i ContentSnapshot
+	err := row.Scan(
+		&i.ID,
+		&i.MonitorID,
+		&i.Hash,
+		&i.Content,
+		&i.Diff,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getMonitor = `-- name: GetMonitor :one
+SELECT id, url, interval_seconds, is_active, last_check, created_at, type, config, push_token, last_heartbeat FROM monitors WHERE id = $1
+`
+
+func (q *Queries) GetMonitor(ctx context.Context, id pgtype.UUID) (Monitor, error) {
+	row := q.db.QueryRow(ctx, getMonitor, id)
+	var i Monitor
+	err := row.Scan(
+		&i.ID,
+		&i.Url,
+		&i.IntervalSeconds,
+		&i.IsActive,
+		&i.LastCheck,
+		&i.CreatedAt,
+		&i.Type,
+		&i.Config,
+		&i.PushToken,
+		&i.LastHeartbeat,
+	)
+	return i, err
+}
+
+const getMonitorByPushToken = `-- name: GetMonitorByPushToken :one
+SELECT id, url, interval_seconds, is_active, last_check, created_at, type, config, push_token, last_heartbeat FROM monitors WHERE push_token = $1
+`
+
+func (q *Queries) GetMonitorByPushToken(ctx context.Context, pushToken pgtype.Text) (Monitor, error) {
+	row := q.db.QueryRow(ctx, getMonitorByPushToken, pushToken)
+	var i Monitor
+	err := row.Scan(
+		&i.ID,
+		&i.Url,
+		&i.IntervalSeconds,
+		&i.IsActive,
+		&i.LastCheck,
+		&i.CreatedAt,
+		&i.Type,
+		&i.Config,
+		&i.PushToken,
+		&i.LastHeartbeat,
+	)
+	return i, err
+}
+
+const getMonitorCredentials = `-- name: GetMonitorCredentials :one
+SELECT monitor_id, proxy_url, client_cert, client_key, ca_bundle, updated_at FROM monitor_credentials
+WHERE monitor_id = $1
+`
+
+func (q *Queries) GetMonitorCredentials(ctx context.Context, monitorID pgtype.UUID) (MonitorCredential, error) {
+	row := q.db.QueryRow(ctx, getMonitorCredentials, monitorID)
+	var i MonitorCredential
+	err := row.Scan(
+		&i.MonitorID,
+		&i.ProxyUrl,
+		&i.ClientCert,
+		&i.ClientKey,
+		&i.CaBundle,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getMonitorResultRedirects = `-- name: GetMonitorResultRedirects :many
+SELECT id, result_id, position, url, status_code, latency, timing_dns, timing_tcp, timing_tls, timing_ttfb, created_at FROM monitor_result_redirects
+WHERE result_id = ANY($1::uuid[])
+ORDER BY result_id, position
+`
+
+func (q *Queries) GetMonitorResultRedirects(ctx context.Context, resultIds []pgtype.UUID) ([]MonitorResultRedirect, error) {
+	rows, err := q.db.Query(ctx, getMonitorResultRedirects, resultIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MonitorResultRedirect
+	for rows.Next() {
+		var i MonitorResultRedirect
+		if err := rows.Scan(
+			&i.ID,
+			&i.ResultID,
+			&i.Position,
+			&i.Url,
+			&i.StatusCode,
+			&i.Latency,
+			&i.TimingDns,
+			&i.TimingTcp,
+			&i.TimingTls,
+			&i.TimingTtfb,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMonitorResultSteps = `-- name: GetMonitorResultSteps :many
+SELECT id, result_id, position, name, method, url, status_code, latency, error, timing_dns, timing_tcp, timing_tls, timing_ttfb, timing_download, created_at FROM monitor_result_steps
+WHERE result_id = ANY($1::uuid[])
+ORDER BY result_id, position
+`
+
+func (q *Queries) GetMonitorResultSteps(ctx context.Context, resultIds []pgtype.UUID) ([]MonitorResultStep, error) {
+	rows, err := q.db.Query(ctx, getMonitorResultSteps, resultIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MonitorResultStep
+	for rows.Next() {
+		var i MonitorResultStep
+		if err := rows.Scan(
+			&i.ID,
+			&i.ResultID,
+			&i.Position,
+			&i.Name,
+			&i.Method,
+			&i.Url,
+			&i.StatusCode,
+			&i.Latency,
+			&i.Error,
+			&i.TimingDns,
+			&i.TimingTcp,
+			&i.TimingTls,
+			&i.TimingTtfb,
+			&i.TimingDownload,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getMonitorResults = `-- name: GetMonitorResults :many
-SELECT id, monitor_id, status_code, status, latency, timing_dns, timing_tcp, timing_tls, timing_ttfb, timing_download, created_at FROM monitor_results
+SELECT id, monitor_id, status_code, status, latency, timing_dns, timing_tcp, timing_tls, timing_ttfb, timing_download, created_at, rtt_min, rtt_avg, rtt_max, jitter, packet_loss FROM monitor_results
 WHERE monitor_id = $1
 ORDER BY created_at DESC
 LIMIT 50
@@ -148,6 +470,11 @@ func (q *Queries) GetMonitorResults(ctx context.Context, monitorID pgtype.UUID)
 			&i.TimingTtfb,
 			&i.TimingDownload,
 			&i.CreatedAt,
+			&i.RttMin,
+			&i.RttAvg,
+			&i.RttMax,
+			&i.Jitter,
+			&i.PacketLoss,
 		); err != nil {
 			return nil, err
 		}
@@ -160,7 +487,7 @@ func (q *Queries) GetMonitorResults(ctx context.Context, monitorID pgtype.UUID)
 }
 
 const getMonitorsToPing = `-- name: GetMonitorsToPing :many
-SELECT id, url, interval_seconds, is_active, last_check, created_at FROM monitors
+SELECT id, url, interval_seconds, is_active, last_check, created_at, type, config, push_token, last_heartbeat FROM monitors
 WHERE is_active = true 
 AND (last_check IS NULL OR last_check < NOW() - (interval_seconds || ' seconds')::INTERVAL)
 `
@@ -182,6 +509,10 @@ func (q *Queries) GetMonitorsToPing(ctx context.Context) ([]Monitor, error) {
 			&i.IsActive,
 			&i.LastCheck,
 			&i.CreatedAt,
+			&i.Type,
+			&i.Config,
+			&i.PushToken,
+			&i.LastHeartbeat,
 		); err != nil {
 			return nil, err
 		}
@@ -193,8 +524,63 @@ func (q *Queries) GetMonitorsToPing(ctx context.Context) ([]Monitor, error) {
 	return items, nil
 }
 
+const listMonitorCredentials = `-- name: ListMonitorCredentials :many
+SELECT monitor_id, proxy_url, client_cert, client_key, ca_bundle, updated_at FROM monitor_credentials
+`
+
+func (q *Queries) ListMonitorCredentials(ctx context.Context) ([]MonitorCredential, error) {
+	rows, err := q.db.Query(ctx, listMonitorCredentials)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MonitorCredential
+	for rows.Next() {
+		var i MonitorCredential
+		if err := rows.Scan(
+			&i.MonitorID,
+			&i.ProxyUrl,
+			&i.ClientCert,
+			&i.ClientKey,
+			&i.CaBundle,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMonitorIDs = `-- name: ListMonitorIDs :many
+SELECT id FROM monitors
+`
+
+func (q *Queries) ListMonitorIDs(ctx context.Context) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, listMonitorIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listMonitors = `-- name: ListMonitors :many
-SELECT id, url, interval_seconds, is_active, last_check, created_at FROM monitors
+SELECT id, url, interval_seconds, is_active, last_check, created_at, type, config, push_token, last_heartbeat FROM monitors
 ORDER BY created_at DESC
 `
 
@@ -214,6 +600,10 @@ func (q *Queries) ListMonitors(ctx context.Context) ([]Monitor, error) {
 			&i.IsActive,
 			&i.LastCheck,
 			&i.CreatedAt,
+			&i.Type,
+			&i.Config,
+			&i.PushToken,
+			&i.LastHeartbeat,
 		); err != nil {
 			return nil, err
 		}
@@ -225,6 +615,37 @@ func (q *Queries) ListMonitors(ctx context.Context) ([]Monitor, error) {
 	return items, nil
 }
 
+const pruneContentSnapshots = `-- name: PruneContentSnapshots :exec
+DELETE FROM content_snapshots
+WHERE content_snapshots.monitor_id = $1 AND id NOT IN (
+    SELECT latest.id FROM content_snapshots latest
+    WHERE latest.monitor_id = $1
+    ORDER BY latest.created_at DESC
+    LIMIT $2::int
+)
+`
+
+type PruneContentSnapshotsParams struct {
+	MonitorID pgtype.UUID `json:"monitor_id"`
+	Keep      int32       `json:"keep"`
+}
+
+func (q *Queries) PruneContentSnapshots(ctx context.Context, arg PruneContentSnapshotsParams) error {
+	_, err := q.db.Exec(ctx, pruneContentSnapshots, arg.MonitorID, arg.Keep)
+	return err
+}
+
+const recordHeartbeat = `-- name: RecordHeartbeat :exec
+UPDATE monitors
+SET last_heartbeat = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) RecordHeartbeat(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, recordHeartbeat, id)
+	return err
+}
+
 const updateMonitorLastCheck = `-- name: UpdateMonitorLastCheck :exec
 UPDATE monitors
 SET last_check = NOW()
@@ -235,3 +656,49 @@ func (q *Queries) UpdateMonitorLastCheck(ctx context.Context, id pgtype.UUID) er
 	_, err := q.db.Exec(ctx, updateMonitorLastCheck, id)
 	return err
 }
+
+const updateMonitorURL = `-- name: UpdateMonitorURL :exec
+UPDATE monitors
+SET url = $2
+WHERE id = $1
+`
+
+type UpdateMonitorURLParams struct {
+	ID  pgtype.UUID `json:"id"`
+	Url string      `json:"url"`
+}
+
+func (q *Queries) UpdateMonitorURL(ctx context.Context, arg UpdateMonitorURLParams) error {
+	_, err := q.db.Exec(ctx, updateMonitorURL, arg.ID, arg.Url)
+	return err
+}
+
+const upsertMonitorCredentials = `-- name: UpsertMonitorCredentials :exec
+INSERT INTO monitor_credentials (monitor_id, proxy_url, client_cert, client_key, ca_bundle, updated_at)
+VALUES ($1, $2, $3, $4, $5, NOW())
+ON CONFLICT (monitor_id) DO UPDATE SET
+    proxy_url = EXCLUDED.proxy_url,
+    client_cert = EXCLUDED.client_cert,
+    client_key = EXCLUDED.client_key,
+    ca_bundle = EXCLUDED.ca_bundle,
+    updated_at = NOW()
+`
+
+type UpsertMonitorCredentialsParams struct {
+	MonitorID  pgtype.UUID `json:"monitor_id"`
+	ProxyUrl   string      `json:"proxy_url"`
+	ClientCert string      `json:"client_cert"`
+	ClientKey  string      `json:"client_key"`
+	CaBundle   string      `json:"ca_bundle"`
+}
+
+func (q *Queries) UpsertMonitorCredentials(ctx context.Context, arg UpsertMonitorCredentialsParams) error {
+	_, err := q.db.Exec(ctx, upsertMonitorCredentials,
+		arg.MonitorID,
+		arg.ProxyUrl,
+		arg.ClientCert,
+		arg.ClientKey,
+		arg.CaBundle,
+	)
+	return err
+}
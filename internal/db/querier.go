@@ -16,18 +16,73 @@ type Querier interface {
 	// Grafik daha geniş görünsün diye 100 yaptık
 	// Veriyi 6 ay sakla (Best Practice: Uzun dönem analiz için)
 	CleanOldSystemStats(ctx context.Context) error
+	// Secrets that some monitor config or credentials refer to as {{secret:<id>}}
+	CountSecretReferences(ctx context.Context, ref string) (int64, error)
+	CreateAgent(ctx context.Context, arg CreateAgentParams) (Agent, error)
+	CreateAlertRule(ctx context.Context, arg CreateAlertRuleParams) (AlertRule, error)
+	CreateCgroupStats(ctx context.Context, arg CreateCgroupStatsParams) error
+	CreateContentSnapshot(ctx context.Context, arg CreateContentSnapshotParams) (ContentSnapshot, error)
 	CreateMonitor(ctx context.Context, arg CreateMonitorParams) (Monitor, error)
 	// --- YENİ EKLENENLER (History için) ---
 	CreateMonitorResult(ctx context.Context, arg CreateMonitorResultParams) (MonitorResult, error)
+	CreateMonitorResultRedirects(ctx context.Context, arg CreateMonitorResultRedirectsParams) error
+	CreateMonitorResultSteps(ctx context.Context, arg CreateMonitorResultStepsParams) error
+	CreateSecret(ctx context.Context, arg CreateSecretParams) (Secret, error)
+	CreateSystemCPUStats(ctx context.Context, arg CreateSystemCPUStatsParams) error
+	CreateSystemDiskStats(ctx context.Context, arg CreateSystemDiskStatsParams) error
+	CreateSystemNetStats(ctx context.Context, arg CreateSystemNetStatsParams) error
+	CreateSystemProcessStats(ctx context.Context, arg CreateSystemProcessStatsParams) error
 	CreateSystemStat(ctx context.Context, arg CreateSystemStatParams) (SystemStat, error)
+	DeleteAgent(ctx context.Context, id pgtype.UUID) error
+	DeleteAlertRule(ctx context.Context, id pgtype.UUID) error
 	DeleteMonitor(ctx context.Context, id pgtype.UUID) error
+	DeleteMonitorCredentials(ctx context.Context, monitorID pgtype.UUID) error
+	// Secrets a monitor's credentials no longer use
+	DeleteMonitorSecretsExcept(ctx context.Context, arg DeleteMonitorSecretsExceptParams) error
+	DeleteSecret(ctx context.Context, id pgtype.UUID) error
+	GetAgentByTokenHash(ctx context.Context, tokenHash string) (Agent, error)
+	GetCgroupStatHistory(ctx context.Context, arg GetCgroupStatHistoryParams) ([]CgroupStat, error)
+	GetDiskStatHistory(ctx context.Context, arg GetDiskStatHistoryParams) ([]GetDiskStatHistoryRow, error)
+	GetLatestContentSnapshot(ctx context.Context, monitorID pgtype.UUID) (ContentSnapshot, error)
+	GetMonitor(ctx context.Context, id pgtype.UUID) (Monitor, error)
+	GetMonitorByPushToken(ctx context.Context, pushToken pgtype.Text) (Monitor, error)
+	GetMonitorCredentials(ctx context.Context, monitorID pgtype.UUID) (MonitorCredential, error)
+	GetMonitorResultRedirects(ctx context.Context, resultIds []pgtype.UUID) ([]MonitorResultRedirect, error)
+	GetMonitorResultSteps(ctx context.Context, resultIds []pgtype.UUID) ([]MonitorResultStep, error)
 	// Bir monitörün son 50 kaydını getirir (Grafik için)
 	GetMonitorResults(ctx context.Context, monitorID pgtype.UUID) ([]MonitorResult, error)
 	// Kontrol zamanı gelmiş (veya hiç kontrol edilmemiş) aktif monitörleri getir
 	GetMonitorsToPing(ctx context.Context) ([]Monitor, error)
-	GetSystemStatHistory(ctx context.Context) ([]SystemStat, error)
+	GetProcessSnapshot(ctx context.Context, arg GetProcessSnapshotParams) ([]SystemProcessStat, error)
+	GetSecret(ctx context.Context, id pgtype.UUID) (Secret, error)
+	GetSecretByName(ctx context.Context, name string) (Secret, error)
+	// Buckets without samples are left out
+	GetSystemStatBuckets(ctx context.Context, arg GetSystemStatBucketsParams) ([]GetSystemStatBucketsRow, error)
+	GetSystemStatHistory(ctx context.Context, host string) ([]SystemStat, error)
+	ListActiveAlertRules(ctx context.Context) ([]AlertRule, error)
+	ListAgents(ctx context.Context) ([]Agent, error)
+	ListAlertRules(ctx context.Context) ([]AlertRule, error)
+	ListIncidents(ctx context.Context) ([]ListIncidentsRow, error)
+	ListMonitorCredentials(ctx context.Context) ([]MonitorCredential, error)
+	ListMonitorIDs(ctx context.Context) ([]pgtype.UUID, error)
 	ListMonitors(ctx context.Context) ([]Monitor, error)
+	ListOpenIncidents(ctx context.Context) ([]Incident, error)
+	ListSecrets(ctx context.Context) ([]Secret, error)
+	// Key rotation: secrets whose data key is wrapped with an older master key
+	ListSecretsToRewrap(ctx context.Context, keyID string) ([]Secret, error)
+	// Açık incident varsa hiçbir şey dönmez (pgx.ErrNoRows)
+	OpenIncident(ctx context.Context, arg OpenIncidentParams) (Incident, error)
+	PruneContentSnapshots(ctx context.Context, arg PruneContentSnapshotsParams) error
+	RecordHeartbeat(ctx context.Context, id pgtype.UUID) error
+	ResolveIncident(ctx context.Context, arg ResolveIncidentParams) (Incident, error)
+	RewrapSecret(ctx context.Context, arg RewrapSecretParams) error
+	TouchAgent(ctx context.Context, id pgtype.UUID) error
+	UpdateAlertRule(ctx context.Context, arg UpdateAlertRuleParams) (AlertRule, error)
 	UpdateMonitorLastCheck(ctx context.Context, id pgtype.UUID) error
+	UpdateMonitorURL(ctx context.Context, arg UpdateMonitorURLParams) error
+	// Yeni değer, yeni data key
+	UpdateSecret(ctx context.Context, arg UpdateSecretParams) (Secret, error)
+	UpsertMonitorCredentials(ctx context.Context, arg UpsertMonitorCredentialsParams) error
 }
 
 var _ Querier = (*Queries)(nil)
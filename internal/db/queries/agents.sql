@@ -0,0 +1,21 @@
+-- name: CreateAgent :one
+INSERT INTO agents (host, token_hash)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: ListAgents :many
+SELECT * FROM agents
+ORDER BY host ASC;
+
+-- name: GetAgentByTokenHash :one
+SELECT * FROM agents
+WHERE token_hash = $1;
+
+-- name: TouchAgent :exec
+UPDATE agents
+SET last_seen_at = NOW()
+WHERE id = $1;
+
+-- name: DeleteAgent :exec
+DELETE FROM agents
+WHERE id = $1;
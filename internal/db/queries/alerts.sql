@@ -0,0 +1,47 @@
+-- name: CreateAlertRule :one
+INSERT INTO alert_rules (
+    name, metric, comparison, threshold, duration_seconds, is_active
+) VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: ListAlertRules :many
+SELECT * FROM alert_rules
+ORDER BY created_at ASC;
+
+-- name: ListActiveAlertRules :many
+SELECT * FROM alert_rules
+WHERE is_active = true;
+
+-- name: UpdateAlertRule :one
+UPDATE alert_rules
+SET name = $2, metric = $3, comparison = $4, threshold = $5, duration_seconds = $6, is_active = $7
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteAlertRule :exec
+DELETE FROM alert_rules
+WHERE id = $1;
+
+-- Açık incident varsa hiçbir şey dönmez (pgx.ErrNoRows)
+-- name: OpenIncident :one
+INSERT INTO incidents (rule_id, host, value, started_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (rule_id, host) WHERE resolved_at IS NULL DO NOTHING
+RETURNING *;
+
+-- name: ResolveIncident :one
+UPDATE incidents
+SET resolved_at = $3
+WHERE rule_id = $1 AND host = $2 AND resolved_at IS NULL
+RETURNING *;
+
+-- name: ListOpenIncidents :many
+SELECT * FROM incidents
+WHERE resolved_at IS NULL;
+
+-- name: ListIncidents :many
+SELECT i.*, r.name AS rule_name, r.metric, r.comparison, r.threshold
+FROM incidents i
+JOIN alert_rules r ON r.id = i.rule_id
+ORDER BY i.started_at DESC
+LIMIT 100;
@@ -1,17 +1,36 @@
 -- name: CreateMonitor :one
-INSERT INTO monitors (url, interval_seconds)
-VALUES ($1, $2)
+INSERT INTO monitors (url, interval_seconds, type, config, push_token)
+VALUES ($1, $2, $3, $4, $5)
 RETURNING *;
 
+-- name: GetMonitor :one
+SELECT * FROM monitors WHERE id = $1;
+
+-- name: GetMonitorByPushToken :one
+SELECT * FROM monitors WHERE push_token = $1;
+
+-- name: RecordHeartbeat :exec
+UPDATE monitors
+SET last_heartbeat = NOW()
+WHERE id = $1;
+
 -- name: ListMonitors :many
 SELECT * FROM monitors
 ORDER BY created_at DESC;
 
+-- name: ListMonitorIDs :many
+SELECT id FROM monitors;
+
 -- name: GetMonitorsToPing :many
 SELECT * FROM monitors
 WHERE is_active = true 
 AND (last_check IS NULL OR last_check < NOW() - (interval_seconds || ' seconds')::INTERVAL);
 
+-- name: UpdateMonitorURL :exec
+UPDATE monitors
+SET url = $2
+WHERE id = $1;
+
 -- name: UpdateMonitorLastCheck :exec
 UPDATE monitors
 SET last_check = NOW()
@@ -33,11 +52,45 @@ INSERT INTO monitor_results (
     timing_tls,
     timing_ttfb,
     timing_download,
+    rtt_min,
+    rtt_avg,
+    rtt_max,
+    jitter,
+    packet_loss,
     created_at
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW()
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, NOW()
 ) RETURNING *;
 
+-- name: CreateMonitorResultSteps :exec
+INSERT INTO monitor_result_steps (
+    result_id, position, name, method, url, status_code, latency, error,
+    timing_dns, timing_tcp, timing_tls, timing_ttfb, timing_download
+)
+SELECT @result_id::uuid, unnest(@positions::int[]), unnest(@names::text[]), unnest(@methods::text[]),
+    unnest(@urls::text[]), unnest(@status_codes::int[]), unnest(@latencies::int[]), unnest(@errors::text[]),
+    unnest(@timing_dns::int[]), unnest(@timing_tcp::int[]), unnest(@timing_tls::int[]),
+    unnest(@timing_ttfb::int[]), unnest(@timing_download::int[]);
+
+-- name: GetMonitorResultSteps :many
+SELECT * FROM monitor_result_steps
+WHERE result_id = ANY(@result_ids::uuid[])
+ORDER BY result_id, position;
+
+-- name: CreateMonitorResultRedirects :exec
+INSERT INTO monitor_result_redirects (
+    result_id, position, url, status_code, latency,
+    timing_dns, timing_tcp, timing_tls, timing_ttfb
+)
+SELECT @result_id::uuid, unnest(@positions::int[]), unnest(@urls::text[]), unnest(@status_codes::int[]),
+    unnest(@latencies::int[]), unnest(@timing_dns::int[]), unnest(@timing_tcp::int[]),
+    unnest(@timing_tls::int[]), unnest(@timing_ttfb::int[]);
+
+-- name: GetMonitorResultRedirects :many
+SELECT * FROM monitor_result_redirects
+WHERE result_id = ANY(@result_ids::uuid[])
+ORDER BY result_id, position;
+
 -- name: GetMonitorResults :many
 SELECT * FROM monitor_results
 WHERE monitor_id = $1
@@ -46,4 +99,45 @@ LIMIT 50;
 
 -- name: CleanOldMonitorResults :exec
 DELETE FROM monitor_results
-WHERE created_at < NOW() - INTERVAL '7 days';
\ No newline at end of file
+WHERE created_at < NOW() - INTERVAL '7 days';
+
+-- name: GetLatestContentSnapshot :one
+SELECT * FROM content_snapshots
+WHERE monitor_id = $1
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: CreateContentSnapshot :one
+INSERT INTO content_snapshots (monitor_id, hash, content, diff)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: PruneContentSnapshots :exec
+DELETE FROM content_snapshots
+WHERE content_snapshots.monitor_id = $1 AND id NOT IN (
+    SELECT latest.id FROM content_snapshots latest
+    WHERE latest.monitor_id = $1
+    ORDER BY latest.created_at DESC
+    LIMIT @keep::int
+);
+
+-- name: GetMonitorCredentials :one
+SELECT * FROM monitor_credentials
+WHERE monitor_id = $1;
+
+-- name: ListMonitorCredentials :many
+SELECT * FROM monitor_credentials;
+
+-- name: UpsertMonitorCredentials :exec
+INSERT INTO monitor_credentials (monitor_id, proxy_url, client_cert, client_key, ca_bundle, updated_at)
+VALUES ($1, $2, $3, $4, $5, NOW())
+ON CONFLICT (monitor_id) DO UPDATE SET
+    proxy_url = EXCLUDED.proxy_url,
+    client_cert = EXCLUDED.client_cert,
+    client_key = EXCLUDED.client_key,
+    ca_bundle = EXCLUDED.ca_bundle,
+    updated_at = NOW();
+
+-- name: DeleteMonitorCredentials :exec
+DELETE FROM monitor_credentials
+WHERE monitor_id = $1;
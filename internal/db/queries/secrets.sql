@@ -0,0 +1,50 @@
+-- name: CreateSecret :one
+INSERT INTO secrets (id, name, monitor_id, ciphertext, wrapped_key, key_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetSecret :one
+SELECT * FROM secrets
+WHERE id = $1;
+
+-- name: GetSecretByName :one
+SELECT * FROM secrets
+WHERE name = $1;
+
+-- name: ListSecrets :many
+SELECT * FROM secrets
+ORDER BY name ASC;
+
+-- Yeni değer, yeni data key
+-- name: UpdateSecret :one
+UPDATE secrets
+SET ciphertext = $2, wrapped_key = $3, key_id = $4, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteSecret :exec
+DELETE FROM secrets
+WHERE id = $1;
+
+-- Secrets that some monitor config or credentials refer to as {{secret:<id>}}
+-- name: CountSecretReferences :one
+SELECT (
+    (SELECT COUNT(*) FROM monitors WHERE strpos(url || config::text, @ref::text) > 0) +
+    (SELECT COUNT(*) FROM monitor_credentials
+     WHERE strpos(proxy_url || client_cert || client_key || ca_bundle, @ref::text) > 0)
+)::bigint;
+
+-- Secrets a monitor's credentials no longer use
+-- name: DeleteMonitorSecretsExcept :exec
+DELETE FROM secrets
+WHERE monitor_id = @monitor_id AND NOT (id = ANY(@keep::uuid[]));
+
+-- Key rotation: secrets whose data key is wrapped with an older master key
+-- name: ListSecretsToRewrap :many
+SELECT * FROM secrets
+WHERE key_id <> $1;
+
+-- name: RewrapSecret :exec
+UPDATE secrets
+SET wrapped_key = @wrapped_key, key_id = @key_id
+WHERE id = @id AND key_id = @old_key_id;
@@ -7,15 +7,93 @@ INSERT INTO system_stats (
     threads_total,
     threads_running,
     threads_sleeping,
-    threads_zombie
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+    threads_zombie,
+    load_1,
+    load_5,
+    load_15,
+    host
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 RETURNING *;
 
+-- name: CreateSystemDiskStats :exec
+INSERT INTO system_disk_stats (
+    system_stat_id, mountpoint, device, fstype,
+    used_bytes, total_bytes, used_percent, read_kb_s, write_kb_s
+)
+SELECT @system_stat_id::uuid, unnest(@mountpoints::text[]), unnest(@devices::text[]), unnest(@fstypes::text[]),
+    unnest(@used_bytes::bigint[]), unnest(@total_bytes::bigint[]), unnest(@used_percents::float8[]),
+    unnest(@read_kb_s::float8[]), unnest(@write_kb_s::float8[]);
+
+-- name: CreateSystemNetStats :exec
+INSERT INTO system_net_stats (system_stat_id, interface, rx_kb_s, tx_kb_s)
+SELECT @system_stat_id::uuid, unnest(@interfaces::text[]), unnest(@rx_kb_s::float8[]), unnest(@tx_kb_s::float8[]);
+
+-- name: CreateSystemCPUStats :exec
+INSERT INTO system_cpu_stats (system_stat_id, core, percent)
+SELECT @system_stat_id::uuid, unnest(@cores::int[]), unnest(@percents::float8[]);
+
+-- name: CreateSystemProcessStats :exec
+INSERT INTO system_process_stats (
+    system_stat_id, pid, name, username, state, threads,
+    cpu_percent, rss_bytes, memory_percent
+)
+SELECT @system_stat_id::uuid, unnest(@pids::int[]), unnest(@names::text[]), unnest(@usernames::text[]),
+    unnest(@states::text[]), unnest(@threads::int[]), unnest(@cpu_percents::float8[]),
+    unnest(@rss_bytes::bigint[]), unnest(@memory_percents::float8[]);
+
+-- name: GetProcessSnapshot :many
+SELECT * FROM system_process_stats
+WHERE system_stat_id = (
+    SELECT ps.system_stat_id FROM system_process_stats ps
+    JOIN system_stats s ON s.id = ps.system_stat_id
+    WHERE s.host = $1 AND ps.created_at <= $2
+    ORDER BY ps.created_at DESC
+    LIMIT 1
+)
+ORDER BY rss_bytes DESC;
+
+-- name: CreateCgroupStats :exec
+INSERT INTO cgroup_stats (
+    system_stat_id, path, container_id, cpu_percent, cpu_limit,
+    memory_bytes, memory_limit, io_read_kb_s, io_write_kb_s, pids, pids_limit
+)
+SELECT @system_stat_id::uuid, unnest(@paths::text[]), unnest(@container_ids::text[]), unnest(@cpu_percents::float8[]),
+    unnest(@cpu_limits::float8[]), unnest(@memory_bytes::bigint[]), unnest(@memory_limits::bigint[]),
+    unnest(@io_read_kb_s::float8[]), unnest(@io_write_kb_s::float8[]), unnest(@pids::int[]), unnest(@pids_limits::int[]);
+
+-- name: GetCgroupStatHistory :many
+SELECT c.* FROM cgroup_stats c
+JOIN system_stats s ON s.id = c.system_stat_id
+WHERE s.host = $1 AND c.created_at >= $2
+ORDER BY c.path, c.created_at ASC;
+
+-- name: GetDiskStatHistory :many
+SELECT d.mountpoint, d.used_percent, d.created_at FROM system_disk_stats d
+JOIN system_stats s ON s.id = d.system_stat_id
+WHERE s.host = $1 AND d.created_at >= $2
+ORDER BY d.mountpoint, d.created_at ASC;
+
 -- name: GetSystemStatHistory :many
 SELECT * FROM system_stats
+WHERE host = $1
 ORDER BY created_at DESC
 LIMIT 100; 
 
+-- Buckets without samples are left out
+-- name: GetSystemStatBuckets :many
+SELECT
+    date_bin(@bucket::interval, created_at, @start_at::timestamptz)::timestamptz AS bucket_start,
+    MIN(cpu_percent)::float8 AS cpu_min, AVG(cpu_percent)::float8 AS cpu_avg, MAX(cpu_percent)::float8 AS cpu_max,
+    MIN(memory_percent)::float8 AS memory_min, AVG(memory_percent)::float8 AS memory_avg, MAX(memory_percent)::float8 AS memory_max,
+    MIN(disk_percent)::float8 AS disk_min, AVG(disk_percent)::float8 AS disk_avg, MAX(disk_percent)::float8 AS disk_max,
+    MIN(net_kb_s)::float8 AS net_min, AVG(net_kb_s)::float8 AS net_avg, MAX(net_kb_s)::float8 AS net_max,
+    MIN(threads_total)::float8 AS threads_min, AVG(threads_total)::float8 AS threads_avg, MAX(threads_total)::float8 AS threads_max,
+    MIN(load_1)::float8 AS load1_min, AVG(load_1)::float8 AS load1_avg, MAX(load_1)::float8 AS load1_max
+FROM system_stats
+WHERE host = @host AND created_at >= @start_at::timestamptz AND created_at < @end_at::timestamptz
+GROUP BY bucket_start
+ORDER BY bucket_start ASC;
+
 -- name: CleanOldSystemStats :exec
 DELETE FROM system_stats
 WHERE created_at < NOW() - INTERVAL '6 months';
\ No newline at end of file
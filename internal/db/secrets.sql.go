@@ -0,0 +1,262 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: secrets.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countSecretReferences = `-- name: CountSecretReferences :one
+SELECT (
+    (SELECT COUNT(*) FROM monitors WHERE strpos(url || config::text, $1::text) > 0) +
+    (SELECT COUNT(*) FROM monitor_credentials
+     WHERE strpos(proxy_url || client_cert || client_key || ca_bundle, $1::text) > 0)
+)::bigint
+`
+
+// Secrets that some monitor config or credentials refer to as {{secret:<id>}}
+func (q *Queries) CountSecretReferences(ctx context.Context, ref string) (int64, error) {
+	row := q.db.QueryRow(ctx, countSecretReferences, ref)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const createSecret = `-- name: CreateSecret :one
+INSERT INTO secrets (id, name, monitor_id, ciphertext, wrapped_key, key_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, name, monitor_id, ciphertext, wrapped_key, key_id, created_at, updated_at
+`
+
+type CreateSecretParams struct {
+	ID         pgtype.UUID `json:"id"`
+	Name       string      `json:"name"`
+	MonitorID  pgtype.UUID `json:"monitor_id"`
+	Ciphertext []byte      `json:"ciphertext"`
+	WrappedKey []byte      `json:"wrapped_key"`
+	KeyID      string      `json:"key_id"`
+}
+
+func (q *Queries) CreateSecret(ctx context.Context, arg CreateSecretParams) (Secret, error) {
+	row := q.db.QueryRow(ctx, createSecret,
+		arg.ID,
+		arg.Name,
+		arg.MonitorID,
+		arg.Ciphertext,
+		arg.WrappedKey,
+		arg.KeyID,
+	)
+	var i Secret
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.MonitorID,
+		&i.Ciphertext,
+		&i.WrappedKey,
+		&i.KeyID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteMonitorSecretsExcept = `-- name: DeleteMonitorSecretsExcept :exec
+DELETE FROM secrets
+WHERE monitor_id = $1 AND NOT (id = ANY($2::uuid[]))
+`
+
+type DeleteMonitorSecretsExceptParams struct {
+	MonitorID pgtype.UUID   `json:"monitor_id"`
+	Keep      []pgtype.UUID `json:"keep"`
+}
+
+// Secrets a monitor's credentials no longer use
+func (q *Queries) DeleteMonitorSecretsExcept(ctx context.Context, arg DeleteMonitorSecretsExceptParams) error {
+	_, err := q.db.Exec(ctx, deleteMonitorSecretsExcept, arg.MonitorID, arg.Keep)
+	return err
+}
+
+const deleteSecret = `-- name: DeleteSecret :exec
+DELETE FROM secrets
+WHERE id = $1
+`
+
+func (q *Queries) DeleteSecret(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteSecret, id)
+	return err
+}
+
+const getSecret = `-- name: GetSecret :one
+SELECT id, name, monitor_id, ciphertext, wrapped_key, key_id, created_at, updated_at FROM secrets
+WHERE id = $1
+`
+
+func (q *Queries) GetSecret(ctx context.Context, id pgtype.UUID) (Secret, error) {
+	row := q.db.QueryRow(ctx, getSecret, id)
+	var i Secret
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.MonitorID,
+		&i.Ciphertext,
+		&i.WrappedKey,
+		&i.KeyID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getSecretByName = `-- name: GetSecretByName :one
+SELECT id, name, monitor_id, ciphertext, wrapped_key, key_id, created_at, updated_at FROM secrets
+WHERE name = $1
+`
+
+func (q *Queries) GetSecretByName(ctx context.Context, name string) (Secret, error) {
+	row := q.db.QueryRow(ctx, getSecretByName, name)
+	var i Secret
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.MonitorID,
+		&i.Ciphertext,
+		&i.WrappedKey,
+		&i.KeyID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listSecrets = `-- name: ListSecrets :many
+SELECT id, name, monitor_id, ciphertext, wrapped_key, key_id, created_at, updated_at FROM secrets
+ORDER BY name ASC
+`
+
+func (q *Queries) ListSecrets(ctx context.Context) ([]Secret, error) {
+	rows, err := q.db.Query(ctx, listSecrets)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Secret
+	for rows.Next() {
+		var i Secret
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.MonitorID,
+			&i.Ciphertext,
+			&i.WrappedKey,
+			&i.KeyID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSecretsToRewrap = `-- name: ListSecretsToRewrap :many
+SELECT id, name, monitor_id, ciphertext, wrapped_key, key_id, created_at, updated_at FROM secrets
+WHERE key_id <> $1
+`
+
+// Key rotation: secrets whose data key is wrapped with an older master key
+func (q *Queries) ListSecretsToRewrap(ctx context.Context, keyID string) ([]Secret, error) {
+	rows, err := q.db.Query(ctx, listSecretsToRewrap, keyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Secret
+	for rows.Next() {
+		var i Secret
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.MonitorID,
+			&i.Ciphertext,
+			&i.WrappedKey,
+			&i.KeyID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const rewrapSecret = `-- name: RewrapSecret :exec
+UPDATE secrets
+SET wrapped_key = $1, key_id = $2
+WHERE id = $3 AND key_id = $4
+`
+
+type RewrapSecretParams struct {
+	WrappedKey []byte      `json:"wrapped_key"`
+	KeyID      string      `json:"key_id"`
+	ID         pgtype.UUID `json:"id"`
+	OldKeyID   string      `json:"old_key_id"`
+}
+
+func (q *Queries) RewrapSecret(ctx context.Context, arg RewrapSecretParams) error {
+	_, err := q.db.Exec(ctx, rewrapSecret,
+		arg.WrappedKey,
+		arg.KeyID,
+		arg.ID,
+		arg.OldKeyID,
+	)
+	return err
+}
+
+const updateSecret = `-- name: UpdateSecret :one
+UPDATE secrets
+SET ciphertext = $2, wrapped_key = $3, key_id = $4, updated_at = NOW()
+WHERE id = $1
+RETURNING id, name, monitor_id, ciphertext, wrapped_key, key_id, created_at, updated_at
+`
+
+type UpdateSecretParams struct {
+	ID         pgtype.UUID `json:"id"`
+	Ciphertext []byte      `json:"ciphertext"`
+	WrappedKey []byte      `json:"wrapped_key"`
+	KeyID      string      `json:"key_id"`
+}
+
+// Yeni değer, yeni data key
+func (q *Queries) UpdateSecret(ctx context.Context, arg UpdateSecretParams) (Secret, error) {
+	row := q.db.QueryRow(ctx, updateSecret,
+		arg.ID,
+		arg.Ciphertext,
+		arg.WrappedKey,
+		arg.KeyID,
+	)
+	var i Secret
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.MonitorID,
+		&i.Ciphertext,
+		&i.WrappedKey,
+		&i.KeyID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -7,6 +7,8 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const cleanOldSystemStats = `-- name: CleanOldSystemStats :exec
@@ -22,6 +24,159 @@ func (q *Queries) CleanOldSystemStats(ctx context.Context) error {
 	return err
 }
 
+const createCgroupStats = `-- name: CreateCgroupStats :exec
+INSERT INTO cgroup_stats (
+    system_stat_id, path, container_id, cpu_percent, cpu_limit,
+    memory_bytes, memory_limit, io_read_kb_s, io_write_kb_s, pids, pids_limit
+)
+SELECT $1::uuid, unnest($2::text[]), unnest($3::text[]), unnest($4::float8[]),
+    unnest($5::float8[]), unnest($6::bigint[]), unnest($7::bigint[]),
+    unnest($8::float8[]), unnest($9::float8[]), unnest($10::int[]), unnest($11::int[])
+`
+
+type CreateCgroupStatsParams struct {
+	SystemStatID pgtype.UUID `json:"system_stat_id"`
+	Paths        []string    `json:"paths"`
+	ContainerIds []string    `json:"container_ids"`
+	CpuPercents  []float64   `json:"cpu_percents"`
+	CpuLimits    []float64   `json:"cpu_limits"`
+	MemoryBytes  []int64     `json:"memory_bytes"`
+	MemoryLimits []int64     `json:"memory_limits"`
+	IoReadKbS    []float64   `json:"io_read_kb_s"`
+	IoWriteKbS   []float64   `json:"io_write_kb_s"`
+	Pids         []int32     `json:"pids"`
+	PidsLimits   []int32     `json:"pids_limits"`
+}
+
+func (q *Queries) CreateCgroupStats(ctx context.Context, arg CreateCgroupStatsParams) error {
+	_, err := q.db.Exec(ctx, createCgroupStats,
+		arg.SystemStatID,
+		arg.Paths,
+		arg.ContainerIds,
+		arg.CpuPercents,
+		arg.CpuLimits,
+		arg.MemoryBytes,
+		arg.MemoryLimits,
+		arg.IoReadKbS,
+		arg.IoWriteKbS,
+		arg.Pids,
+		arg.PidsLimits,
+	)
+	return err
+}
+
+const createSystemCPUStats = `-- name: CreateSystemCPUStats :exec
+INSERT INTO system_cpu_stats (system_stat_id, core, percent)
+SELECT $1::uuid, unnest($2::int[]), unnest($3::float8[])
+`
+
+type CreateSystemCPUStatsParams struct {
+	SystemStatID pgtype.UUID `json:"system_stat_id"`
+	Cores        []int32     `json:"cores"`
+	Percents     []float64   `json:"percents"`
+}
+
+func (q *Queries) CreateSystemCPUStats(ctx context.Context, arg CreateSystemCPUStatsParams) error {
+	_, err := q.db.Exec(ctx, createSystemCPUStats, arg.SystemStatID, arg.Cores, arg.Percents)
+	return err
+}
+
+const createSystemDiskStats = `-- name: CreateSystemDiskStats :exec
+INSERT INTO system_disk_stats (
+    system_stat_id, mountpoint, device, fstype,
+    used_bytes, total_bytes, used_percent, read_kb_s, write_kb_s
+)
+SELECT $1::uuid, unnest($2::text[]), unnest($3::text[]), unnest($4::text[]),
+    unnest($5::bigint[]), unnest($6::bigint[]), unnest($7::float8[]),
+    unnest($8::float8[]), unnest($9::float8[])
+`
+
+type CreateSystemDiskStatsParams struct {
+	SystemStatID pgtype.UUID `json:"system_stat_id"`
+	Mountpoints  []string    `json:"mountpoints"`
+	Devices      []string    `json:"devices"`
+	Fstypes      []string    `json:"fstypes"`
+	UsedBytes    []int64     `json:"used_bytes"`
+	TotalBytes   []int64     `json:"total_bytes"`
+	UsedPercents []float64   `json:"used_percents"`
+	ReadKbS      []float64   `json:"read_kb_s"`
+	WriteKbS     []float64   `json:"write_kb_s"`
+}
+
+func (q *Queries) CreateSystemDiskStats(ctx context.Context, arg CreateSystemDiskStatsParams) error {
+	_, err := q.db.Exec(ctx, createSystemDiskStats,
+		arg.SystemStatID,
+		arg.Mountpoints,
+		arg.Devices,
+		arg.Fstypes,
+		arg.UsedBytes,
+		arg.TotalBytes,
+		arg.UsedPercents,
+		arg.ReadKbS,
+		arg.WriteKbS,
+	)
+	return err
+}
+
+const createSystemNetStats = `-- name: CreateSystemNetStats :exec
+INSERT INTO system_net_stats (system_stat_id, interface, rx_kb_s, tx_kb_s)
+SELECT $1::uuid, unnest($2::text[]), unnest($3::float8[]), unnest($4::float8[])
+`
+
+type CreateSystemNetStatsParams struct {
+	SystemStatID pgtype.UUID `json:"system_stat_id"`
+	Interfaces   []string    `json:"interfaces"`
+	RxKbS        []float64   `json:"rx_kb_s"`
+	TxKbS        []float64   `json:"tx_kb_s"`
+}
+
+func (q *Queries) CreateSystemNetStats(ctx context.Context, arg CreateSystemNetStatsParams) error {
+	_, err := q.db.Exec(ctx, createSystemNetStats,
+		arg.SystemStatID,
+		arg.Interfaces,
+		arg.RxKbS,
+		arg.TxKbS,
+	)
+	return err
+}
+
+const createSystemProcessStats = `-- name: CreateSystemProcessStats :exec
+INSERT INTO system_process_stats (
+    system_stat_id, pid, name, username, state, threads,
+    cpu_percent, rss_bytes, memory_percent
+)
+SELECT $1::uuid, unnest($2::int[]), unnest($3::text[]), unnest($4::text[]),
+    unnest($5::text[]), unnest($6::int[]), unnest($7::float8[]),
+    unnest($8::bigint[]), unnest($9::float8[])
+`
+
+type CreateSystemProcessStatsParams struct {
+	SystemStatID   pgtype.UUID `json:"system_stat_id"`
+	Pids           []int32     `json:"pids"`
+	Names          []string    `json:"names"`
+	Usernames      []string    `json:"usernames"`
+	States         []string    `json:"states"`
+	Threads        []int32     `json:"threads"`
+	CpuPercents    []float64   `json:"cpu_percents"`
+	RssBytes       []int64     `json:"rss_bytes"`
+	MemoryPercents []float64   `json:"memory_percents"`
+}
+
+func (q *Queries) CreateSystemProcessStats(ctx context.Context, arg CreateSystemProcessStatsParams) error {
+	_, err := q.db.Exec(ctx, createSystemProcessStats,
+		arg.SystemStatID,
+		arg.Pids,
+		arg.Names,
+		arg.Usernames,
+		arg.States,
+		arg.Threads,
+		arg.CpuPercents,
+		arg.RssBytes,
+		arg.MemoryPercents,
+	)
+	return err
+}
+
 const createSystemStat = `-- name: CreateSystemStat :one
 INSERT INTO system_stats (
     cpu_percent, 
@@ -31,9 +186,13 @@ INSERT INTO system_stats (
     threads_total,
     threads_running,
     threads_sleeping,
-    threads_zombie
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-RETURNING id, cpu_percent, memory_percent, disk_percent, net_kb_s, threads_total, threads_running, threads_sleeping, threads_zombie, created_at
+    threads_zombie,
+    load_1,
+    load_5,
+    load_15,
+    host
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+RETURNING id, cpu_percent, memory_percent, disk_percent, net_kb_s, threads_total, threads_running, threads_sleeping, threads_zombie, created_at, load_1, load_5, load_15, host
 `
 
 type CreateSystemStatParams struct {
@@ -45,6 +204,10 @@ type CreateSystemStatParams struct {
 	ThreadsRunning  int32   `json:"threads_running"`
 	ThreadsSleeping int32   `json:"threads_sleeping"`
 	ThreadsZombie   int32   `json:"threads_zombie"`
+	Load1           float64 `json:"load_1"`
+	Load5           float64 `json:"load_5"`
+	Load15          float64 `json:"load_15"`
+	Host            string  `json:"host"`
 }
 
 func (q *Queries) CreateSystemStat(ctx context.Context, arg CreateSystemStatParams) (SystemStat, error) {
@@ -57,6 +220,10 @@ func (q *Queries) CreateSystemStat(ctx context.Context, arg CreateSystemStatPara
 		arg.ThreadsRunning,
 		arg.ThreadsSleeping,
 		arg.ThreadsZombie,
+		arg.Load1,
+		arg.Load5,
+		arg.Load15,
+		arg.Host,
 	)
 	var i SystemStat
 	err := row.Scan(
@@ -70,18 +237,246 @@ func (q *Queries) CreateSystemStat(ctx context.Context, arg CreateSystemStatPara
 		&i.ThreadsSleeping,
 		&i.ThreadsZombie,
 		&i.CreatedAt,
+		&i.Load1,
+		&i.Load5,
+		&i.Load15,
+		&i.Host,
 	)
 	return i, err
 }
 
+const getCgroupStatHistory = `-- name: GetCgroupStatHistory :many
+SELECT c.id, c.system_stat_id, c.path, c.container_id, c.cpu_percent, c.cpu_limit, c.memory_bytes, c.memory_limit, c.io_read_kb_s, c.io_write_kb_s, c.pids, c.pids_limit, c.created_at FROM cgroup_stats c
+JOIN system_stats s ON s.id = c.system_stat_id
+WHERE s.host = $1 AND c.created_at >= $2
+ORDER BY c.path, c.created_at ASC
+`
+
+type GetCgroupStatHistoryParams struct {
+	Host      string             `json:"host"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) GetCgroupStatHistory(ctx context.Context, arg GetCgroupStatHistoryParams) ([]CgroupStat, error) {
+	rows, err := q.db.Query(ctx, getCgroupStatHistory, arg.Host, arg.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CgroupStat
+	for rows.Next() {
+		var i CgroupStat
+		if err := rows.Scan(
+			&i.ID,
+			&i.SystemStatID,
+			&i.Path,
+			&i.ContainerID,
+			&i.CpuPercent,
+			&i.CpuLimit,
+			&i.MemoryBytes,
+			&i.MemoryLimit,
+			&i.IoReadKbS,
+			&i.IoWriteKbS,
+			&i.Pids,
+			&i.PidsLimit,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDiskStatHistory = `-- name: GetDiskStatHistory :many
+SELECT d.mountpoint, d.used_percent, d.created_at FROM system_disk_stats d
+JOIN system_stats s ON s.id = d.system_stat_id
+WHERE s.host = $1 AND d.created_at >= $2
+ORDER BY d.mountpoint, d.created_at ASC
+`
+
+type GetDiskStatHistoryParams struct {
+	Host      string             `json:"host"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type GetDiskStatHistoryRow struct {
+	Mountpoint  string             `json:"mountpoint"`
+	UsedPercent float64            `json:"used_percent"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) GetDiskStatHistory(ctx context.Context, arg GetDiskStatHistoryParams) ([]GetDiskStatHistoryRow, error) {
+	rows, err := q.db.Query(ctx, getDiskStatHistory, arg.Host, arg.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDiskStatHistoryRow
+	for rows.Next() {
+		var i GetDiskStatHistoryRow
+		if err := rows.Scan(&i.Mountpoint, &i.UsedPercent, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProcessSnapshot = `-- name: GetProcessSnapshot :many
+SELECT id, system_stat_id, pid, name, username, state, threads, cpu_percent, rss_bytes, memory_percent, created_at FROM system_process_stats
+WHERE system_stat_id = (
+    SELECT ps.system_stat_id FROM system_process_stats ps
+    JOIN system_stats s ON s.id = ps.system_stat_id
+    WHERE s.host = $1 AND ps.created_at <= $2
+    ORDER BY ps.created_at DESC
+    LIMIT 1
+)
+ORDER BY rss_bytes DESC
+`
+
+type GetProcessSnapshotParams struct {
+	Host      string             `json:"host"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) GetProcessSnapshot(ctx context.Context, arg GetProcessSnapshotParams) ([]SystemProcessStat, error) {
+	rows, err := q.db.Query(ctx, getProcessSnapshot, arg.Host, arg.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SystemProcessStat
+	for rows.Next() {
+		var i SystemProcessStat
+		if err := rows.Scan(
+			&i.ID,
+			&i.SystemStatID,
+			&i.Pid,
+			&i.Name,
+			&i.Username,
+			&i.State,
+			&i.Threads,
+			&i.CpuPercent,
+			&i.RssBytes,
+			&i.MemoryPercent,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSystemStatBuckets = `-- name: GetSystemStatBuckets :many
+SELECT
+    date_bin($1::interval, created_at, $2::timestamptz)::timestamptz AS bucket_start,
+    MIN(cpu_percent)::float8 AS cpu_min, AVG(cpu_percent)::float8 AS cpu_avg, MAX(cpu_percent)::float8 AS cpu_max,
+    MIN(memory_percent)::float8 AS memory_min, AVG(memory_percent)::float8 AS memory_avg, MAX(memory_percent)::float8 AS memory_max,
+    MIN(disk_percent)::float8 AS disk_min, AVG(disk_percent)::float8 AS disk_avg, MAX(disk_percent)::float8 AS disk_max,
+    MIN(net_kb_s)::float8 AS net_min, AVG(net_kb_s)::float8 AS net_avg, MAX(net_kb_s)::float8 AS net_max,
+    MIN(threads_total)::float8 AS threads_min, AVG(threads_total)::float8 AS threads_avg, MAX(threads_total)::float8 AS threads_max,
+    MIN(load_1)::float8 AS load1_min, AVG(load_1)::float8 AS load1_avg, MAX(load_1)::float8 AS load1_max
+FROM system_stats
+WHERE host = $3 AND created_at >= $2::timestamptz AND created_at < $4::timestamptz
+GROUP BY bucket_start
+ORDER BY bucket_start ASC
+`
+
+type GetSystemStatBucketsParams struct {
+	Bucket  pgtype.Interval    `json:"bucket"`
+	StartAt pgtype.Timestamptz `json:"start_at"`
+	Host    string             `json:"host"`
+	EndAt   pgtype.Timestamptz `json:"end_at"`
+}
+
+type GetSystemStatBucketsRow struct {
+	BucketStart pgtype.Timestamptz `json:"bucket_start"`
+	CpuMin      float64            `json:"cpu_min"`
+	CpuAvg      float64            `json:"cpu_avg"`
+	CpuMax      float64            `json:"cpu_max"`
+	MemoryMin   float64            `json:"memory_min"`
+	MemoryAvg   float64            `json:"memory_avg"`
+	MemoryMax   float64            `json:"memory_max"`
+	DiskMin     float64            `json:"disk_min"`
+	DiskAvg     float64            `json:"disk_avg"`
+	DiskMax     float64            `json:"disk_max"`
+	NetMin      float64            `json:"net_min"`
+	NetAvg      float64            `json:"net_avg"`
+	NetMax      float64            `json:"net_max"`
+	ThreadsMin  float64            `json:"threads_min"`
+	ThreadsAvg  float64            `json:"threads_avg"`
+	ThreadsMax  float64            `json:"threads_max"`
+	Load1Min    float64            `json:"load1_min"`
+	Load1Avg    float64            `json:"load1_avg"`
+	Load1Max    float64            `json:"load1_max"`
+}
+
+// Buckets without samples are left out
+func (q *Queries) GetSystemStatBuckets(ctx context.Context, arg GetSystemStatBucketsParams) ([]GetSystemStatBucketsRow, error) {
+	rows, err := q.db.Query(ctx, getSystemStatBuckets,
+		arg.Bucket,
+		arg.StartAt,
+		arg.Host,
+		arg.EndAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSystemStatBucketsRow
+	for rows.Next() {
+		var i GetSystemStatBucketsRow
+		if err := rows.Scan(
+			&i.BucketStart,
+			&i.CpuMin,
+			&i.CpuAvg,
+			&i.CpuMax,
+			&i.MemoryMin,
+			&i.MemoryAvg,
+			&i.MemoryMax,
+			&i.DiskMin,
+			&i.DiskAvg,
+			&i.DiskMax,
+			&i.NetMin,
+			&i.NetAvg,
+			&i.NetMax,
+			&i.ThreadsMin,
+			&i.ThreadsAvg,
+			&i.ThreadsMax,
+			&i.Load1Min,
+			&i.Load1Avg,
+			&i.Load1Max,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getSystemStatHistory = `-- name: GetSystemStatHistory :many
-SELECT id, cpu_percent, memory_percent, disk_percent, net_kb_s, threads_total, threads_running, threads_sleeping, threads_zombie, created_at FROM system_stats
+SELECT id, cpu_percent, memory_percent, disk_percent, net_kb_s, threads_total, threads_running, threads_sleeping, threads_zombie, created_at, load_1, load_5, load_15, host FROM system_stats
+WHERE host = $1
 ORDER BY created_at DESC
 LIMIT 100
 `
 
-func (q *Queries) GetSystemStatHistory(ctx context.Context) ([]SystemStat, error) {
-	rows, err := q.db.Query(ctx, getSystemStatHistory)
+func (q *Queries) GetSystemStatHistory(ctx context.Context, host string) ([]SystemStat, error) {
+	rows, err := q.db.Query(ctx, getSystemStatHistory, host)
 	if err != nil {
 		return nil, err
 	}
@@ -100,6 +495,10 @@ func (q *Queries) GetSystemStatHistory(ctx context.Context) ([]SystemStat, error
 			&i.ThreadsSleeping,
 			&i.ThreadsZombie,
 			&i.CreatedAt,
+			&i.Load1,
+			&i.Load5,
+			&i.Load15,
+			&i.Host,
 		); err != nil {
 			return nil, err
 		}
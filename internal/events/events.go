@@ -0,0 +1,113 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	pulsarv1 "github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// SchemaVersion of the events this build publishes. Bump it only for changes
+// older readers can't handle (removed/renumbered fields, changed meaning);
+// new fields are ignored by older readers and don't need a bump.
+const SchemaVersion = 1
+
+// ErrUnsupportedVersion, the event was published by a newer, incompatible worker
+var ErrUnsupportedVersion = errors.New("unsupported event schema version")
+
+// Encode stamps the event with the current schema version and marshals it.
+func Encode(ev *pulsarv1.Event) ([]byte, error) {
+	ev.SchemaVersion = SchemaVersion
+	if ev.PublishedAt == 0 {
+		ev.PublishedAt = time.Now().UnixMilli()
+	}
+	return proto.Marshal(ev)
+}
+
+// Decode reads an event published by any compatible worker, including the
+// JSON messages of workers from before the schema existed (version 0).
+func Decode(payload []byte) (*pulsarv1.Event, error) {
+	if len(payload) > 0 && payload[0] == '{' {
+		return decodeLegacy(payload)
+	}
+
+	ev := &pulsarv1.Event{}
+	if err := proto.Unmarshal(payload, ev); err != nil {
+		return nil, err
+	}
+	if ev.SchemaVersion > SchemaVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, ev.SchemaVersion)
+	}
+	return ev, nil
+}
+
+// decodeLegacy converts the old {"type": ..., "data": {...}} messages.
+func decodeLegacy(payload []byte) (*pulsarv1.Event, error) {
+	var msg struct {
+		Type string          `json:"type"`
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(payload, &msg); err != nil {
+		return nil, err
+	}
+
+	switch msg.Type {
+	case "monitor_update":
+		var d struct {
+			MonitorID string             `json:"monitor_id"`
+			URL       string             `json:"url"`
+			Status    string             `json:"status"`
+			Code      int32              `json:"code"`
+			Latency   int32              `json:"latency"`
+			Time      string             `json:"time"`
+			Timing    map[string]float64 `json:"timing"`
+		}
+		if err := json.Unmarshal(msg.Data, &d); err != nil {
+			return nil, err
+		}
+		return &pulsarv1.Event{Payload: &pulsarv1.Event_MonitorUpdate{MonitorUpdate: &pulsarv1.MonitorUpdate{
+			MonitorId: d.MonitorID,
+			Url:       d.URL,
+			Status:    d.Status,
+			Code:      d.Code,
+			Latency:   d.Latency,
+			Time:      d.Time,
+			Timing: &pulsarv1.MonitorTiming{
+				Dns:      int32(d.Timing["dns"]),
+				Tcp:      int32(d.Timing["connect"]),
+				Tls:      int32(d.Timing["tls"]),
+				Ttfb:     int32(d.Timing["ttfb"]),
+				Download: int32(d.Timing["download"]),
+			},
+		}}}, nil
+
+	case "system":
+		var d struct {
+			CPU     *pulsarv1.ResourceUsage `json:"cpu"`
+			Memory  *pulsarv1.ResourceUsage `json:"memory"`
+			Disk    *pulsarv1.ResourceUsage `json:"disk"`
+			Network *pulsarv1.ResourceUsage `json:"network"`
+			Threads *pulsarv1.ThreadUsage   `json:"threads"`
+			Uptime  uint64                  `json:"uptime"`
+			OS      string                  `json:"os"`
+		}
+		if err := json.Unmarshal(msg.Data, &d); err != nil {
+			return nil, err
+		}
+		return &pulsarv1.Event{Payload: &pulsarv1.Event_System{System: &pulsarv1.SystemStatsResponse{
+			Cpu:     d.CPU,
+			Memory:  d.Memory,
+			Disk:    d.Disk,
+			Network: d.Network,
+			Threads: d.Threads,
+			Info: &pulsarv1.SystemInfo{
+				UptimeSeconds: d.Uptime,
+				Platform:      d.OS,
+			},
+		}}}, nil
+	}
+	return nil, fmt.Errorf("unknown event type %q", msg.Type)
+}
@@ -0,0 +1,353 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	pulsarv1 "github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// Stream, the Redis stream events are published on
+	Stream = "pulsar:events"
+
+	// counter behind Event.seq
+	seqKey = "pulsar:events:seq"
+
+	// roughly how many events the stream keeps (XADD MAXLEN ~)
+	streamMaxLen = 10000
+
+	eventField = "event"
+
+	readCount = 100
+	readBlock = 2 * time.Second
+
+	// pending entries idle this long are claimed again (crashed consumer or
+	// failed handler)
+	claimIdle     = time.Minute
+	claimInterval = 30 * time.Second
+
+	// entries delivered this often are acknowledged and dropped
+	maxDeliveries = 5
+
+	// group creation is retried up to this far apart
+	maxBackoff = 30 * time.Second
+
+	// groups whose consumers were all idle this long belong to consumers
+	// that are gone for good, see PruneGroups
+	staleGroupIdle = 24 * time.Hour
+	pruneInterval  = time.Hour
+)
+
+// publishScript numbers the entry and appends it in one step, so entries
+// land on the stream in seq order even with many publishers; with a
+// separate INCR a later seq could be appended first and be taken for a
+// duplicate by the API. The seq goes at the end of the encoded event as
+// field 3 (Event.seq, varint): the last value of a field wins in protobuf.
+var publishScript = redis.NewScript(`
+local seq = redis.call('INCR', KEYS[2])
+local bytes = {24}
+local n = seq
+while n >= 128 do
+	table.insert(bytes, n % 128 + 128)
+	n = math.floor(n / 128)
+end
+table.insert(bytes, n)
+redis.call('XADD', KEYS[1], 'MAXLEN', '~', ARGV[2], '*', ARGV[3], ARGV[1] .. string.char(unpack(bytes)))
+return seq
+`)
+
+// Publish numbers the event, encodes it and appends it to the stream. The
+// sequence number is shared by every consumer, so all API replicas number
+// their WebSocket messages the same way.
+func Publish(ctx context.Context, rdb *redis.Client, ev *pulsarv1.Event) error {
+	ev.Seq = 0 // set by the script
+	payload, err := Encode(ev)
+	if err != nil {
+		return err
+	}
+	seq, err := publishScript.Run(ctx, rdb, []string{Stream, seqKey}, payload, streamMaxLen, eventField).Int64()
+	if err != nil {
+		return err
+	}
+	ev.Seq = seq
+	return nil
+}
+
+// Handler processes one event. An entry is acknowledged once its handler
+// returns nil; otherwise it stays pending and is retried later.
+type Handler func(ctx context.Context, ev *pulsarv1.Event) error
+
+// Consumer reads the stream as a member of a consumer group. Every group gets
+// each event once: consumers that all need every event (API replicas) use a
+// group each, consumers that share the work (notifiers) share a group.
+type Consumer struct {
+	rdb   *redis.Client
+	group string
+	name  string
+
+	prunePrefix string
+}
+
+func NewConsumer(rdb *redis.Client, group, name string) *Consumer {
+	return &Consumer{rdb: rdb, group: group, name: name}
+}
+
+// PruneGroups makes Run destroy the other groups starting with prefix once
+// their consumers were all idle for a day: groups of replicas that are gone
+// for good, e.g. restarted without a stable INSTANCE_ID.
+func (c *Consumer) PruneGroups(prefix string) {
+	c.prunePrefix = prefix
+}
+
+// Run consumes until ctx is done. It first retries what this consumer left
+// pending before a restart, then reads new entries. Redis errors are retried,
+// so it only returns once ctx is done.
+func (c *Consumer) Run(ctx context.Context, handle Handler) error {
+	if !c.createGroup(ctx) {
+		return nil
+	}
+
+	// own pending entries first ("0"), then new ones (">"); what fails here
+	// is claimed again later
+	if err := c.read(ctx, "0", handle); err != nil && ctx.Err() == nil {
+		log.Printf("⚠️ Event stream pending read error (%s): %v", c.group, err)
+	}
+
+	lastClaim := time.Now()
+	var lastPrune time.Time
+	for ctx.Err() == nil {
+		if err := c.read(ctx, ">", handle); err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			log.Printf("⚠️ Event stream read error (%s): %v", c.group, err)
+			if strings.HasPrefix(err.Error(), "NOGROUP") {
+				// destroyed meanwhile: start over from new entries
+				c.createGroup(ctx)
+				continue
+			}
+			time.Sleep(time.Second)
+			continue
+		}
+		if time.Since(lastClaim) > claimInterval {
+			c.claim(ctx, handle)
+			lastClaim = time.Now()
+		}
+		if c.prunePrefix != "" && time.Since(lastPrune) > pruneInterval {
+			c.prune(ctx)
+			lastPrune = time.Now()
+		}
+	}
+	return nil
+}
+
+// createGroup creates the group (and the stream) if needed, retrying with
+// backoff; false once ctx is done.
+func (c *Consumer) createGroup(ctx context.Context) bool {
+	backoff := time.Second
+	for {
+		err := c.rdb.XGroupCreateMkStream(ctx, Stream, c.group, "$").Err()
+		if err == nil || strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			return true
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+		log.Printf("⚠️ Event stream group error (%s), retrying in %s: %v", c.group, backoff, err)
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, maxBackoff)
+	}
+}
+
+// prune destroys the stale groups starting with prunePrefix. Groups without
+// consumers are left alone: they may have been created a moment ago.
+func (c *Consumer) prune(ctx context.Context) {
+	groups, err := c.rdb.XInfoGroups(ctx, Stream).Result()
+	if err != nil {
+		log.Printf("⚠️ Event stream groups error: %v", err)
+		return
+	}
+	for _, g := range groups {
+		if g.Name == c.group || !strings.HasPrefix(g.Name, c.prunePrefix) || g.Consumers == 0 {
+			continue
+		}
+		consumers, err := c.rdb.XInfoConsumers(ctx, Stream, g.Name).Result()
+		if err != nil {
+			log.Printf("⚠️ Event stream consumers error (%s): %v", g.Name, err)
+			continue
+		}
+		stale := true
+		for _, consumer := range consumers {
+			if consumer.Idle < staleGroupIdle {
+				stale = false
+				break
+			}
+		}
+		if !stale {
+			continue
+		}
+		if err := c.rdb.XGroupDestroy(ctx, Stream, g.Name).Err(); err != nil {
+			log.Printf("⚠️ Event stream group destroy error (%s): %v", g.Name, err)
+			continue
+		}
+		log.Printf("🧹 Stale consumer group removed: %s", g.Name)
+	}
+}
+
+// read reads from id until there is nothing left ("0") or one batch (">").
+func (c *Consumer) read(ctx context.Context, id string, handle Handler) error {
+	for {
+		block := readBlock
+		if id != ">" {
+			block = -1 // pending entries: don't block
+		}
+		streams, err := c.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    c.group,
+			Consumer: c.name,
+			Streams:  []string{Stream, id},
+			Count:    readCount,
+			Block:    block,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var n int
+		for _, s := range streams {
+			n = len(s.Messages)
+			for _, msg := range s.Messages {
+				c.process(ctx, msg, handle)
+				if id != ">" {
+					id = msg.ID
+				}
+			}
+		}
+		if id == ">" || n == 0 {
+			return nil
+		}
+	}
+}
+
+// claim takes over entries that stayed pending too long, dropping the ones
+// that keep failing.
+func (c *Consumer) claim(ctx context.Context, handle Handler) {
+	pending, err := c.rdb.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: Stream,
+		Group:  c.group,
+		Idle:   claimIdle,
+		Start:  "-",
+		End:    "+",
+		Count:  readCount,
+	}).Result()
+	if err != nil {
+		log.Printf("⚠️ Event stream pending error (%s): %v", c.group, err)
+		return
+	}
+	for _, p := range pending {
+		if p.RetryCount >= maxDeliveries {
+			log.Printf("⚠️ Dropping event %s after %d deliveries (%s)", p.ID, p.RetryCount, c.group)
+			c.rdb.XAck(ctx, Stream, c.group, p.ID)
+		}
+	}
+
+	msgs, _, err := c.rdb.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+		Stream:   Stream,
+		Group:    c.group,
+		Consumer: c.name,
+		MinIdle:  claimIdle,
+		Start:    "0",
+		Count:    readCount,
+	}).Result()
+	if err != nil {
+		log.Printf("⚠️ Event stream claim error (%s): %v", c.group, err)
+		return
+	}
+	for _, msg := range msgs {
+		c.process(ctx, msg, handle)
+	}
+}
+
+func (c *Consumer) process(ctx context.Context, msg redis.XMessage, handle Handler) {
+	ev, err := decodeEntry(msg)
+	if err != nil {
+		// can't ever succeed: acknowledge so it isn't retried
+		log.Printf("⚠️ Event decode error (%s): %v", msg.ID, err)
+		c.rdb.XAck(ctx, Stream, c.group, msg.ID)
+		return
+	}
+	if err := handle(ctx, ev); err != nil {
+		log.Printf("⚠️ Event handler error (%s, %s): %v", c.group, msg.ID, err)
+		return
+	}
+	c.rdb.XAck(ctx, Stream, c.group, msg.ID)
+}
+
+// Follow reads every event published after it was called, without a consumer
+// group or acknowledgements. For short-lived readers like RPC streams. started
+// is called once the starting position is known.
+func Follow(ctx context.Context, rdb *redis.Client, started func() error, handle func(ev *pulsarv1.Event) error) error {
+	// start after the current last entry, not at "$", so nothing published
+	// between two reads is missed
+	id := "0"
+	last, err := rdb.XRevRangeN(ctx, Stream, "+", "-", 1).Result()
+	if err != nil {
+		return err
+	}
+	if len(last) > 0 {
+		id = last[0].ID
+	}
+	if err := started(); err != nil {
+		return err
+	}
+
+	for ctx.Err() == nil {
+		streams, err := rdb.XRead(ctx, &redis.XReadArgs{
+			Streams: []string{Stream, id},
+			Count:   readCount,
+			Block:   readBlock,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			return err
+		}
+		for _, s := range streams {
+			for _, msg := range s.Messages {
+				id = msg.ID
+				ev, err := decodeEntry(msg)
+				if err != nil {
+					log.Printf("⚠️ Event decode error (%s): %v", msg.ID, err)
+					continue
+				}
+				if err := handle(ev); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func decodeEntry(msg redis.XMessage) (*pulsarv1.Event, error) {
+	payload, ok := msg.Values[eventField].(string)
+	if !ok {
+		return nil, errors.New("entry without event field")
+	}
+	return Decode([]byte(payload))
+}
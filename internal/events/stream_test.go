@@ -0,0 +1,86 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	pulsarv1 "github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestRedis(t *testing.T) *redis.Client {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	return rdb
+}
+
+func streamSeqs(t *testing.T, rdb *redis.Client) []int64 {
+	t.Helper()
+	entries, err := rdb.XRange(context.Background(), Stream, "-", "+").Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var seqs []int64
+	for _, e := range entries {
+		ev, err := decodeEntry(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		seqs = append(seqs, ev.Seq)
+	}
+	return seqs
+}
+
+func TestPublishSeq(t *testing.T) {
+	rdb := newTestRedis(t)
+	ctx := context.Background()
+
+	// multi-byte varints
+	for _, start := range []int64{0, 126, 16382, 1 << 40} {
+		rdb.Set(ctx, seqKey, start, 0)
+		ev := &pulsarv1.Event{Seq: 7, Payload: &pulsarv1.Event_MonitorUpdate{MonitorUpdate: &pulsarv1.MonitorUpdate{MonitorId: "m"}}}
+		if err := Publish(ctx, rdb, ev); err != nil {
+			t.Fatal(err)
+		}
+		if ev.Seq != start+1 {
+			t.Errorf("Publish seq = %d, want %d", ev.Seq, start+1)
+		}
+		seqs := streamSeqs(t, rdb)
+		if got := seqs[len(seqs)-1]; got != start+1 {
+			t.Errorf("stream seq = %d, want %d", got, start+1)
+		}
+	}
+}
+
+func TestPublishOrder(t *testing.T) {
+	rdb := newTestRedis(t)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				if err := Publish(ctx, rdb, &pulsarv1.Event{}); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	seqs := streamSeqs(t, rdb)
+	if len(seqs) != 200 {
+		t.Fatalf("%d entries, want 200", len(seqs))
+	}
+	for i, seq := range seqs {
+		if seq != int64(i+1) {
+			t.Fatalf("entry %d has seq %d", i, seq)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	healthServiceName = "grpc.health.v1.Health"
+	checkProcedure    = "/" + healthServiceName + "/Check"
+	watchProcedure    = "/" + healthServiceName + "/Watch"
+	watchInterval     = 5 * time.Second
+)
+
+// NewGRPCHandler serves grpc.health.v1.Health (gRPC, gRPC-Web and Connect).
+//
+// The empty service name and every name added with AddService map to the
+// overall readiness; a registered check name ("postgres", "redis"...) maps to
+// that check alone.
+func NewGRPCHandler(c *Checker) (string, http.Handler) {
+	mux := http.NewServeMux()
+
+	mux.Handle(checkProcedure, connect.NewUnaryHandler(
+		checkProcedure,
+		func(ctx context.Context, req *connect.Request[healthpb.HealthCheckRequest]) (*connect.Response[healthpb.HealthCheckResponse], error) {
+			status, err := c.servingStatus(ctx, req.Msg.Service)
+			if err != nil {
+				return nil, err
+			}
+			return connect.NewResponse(&healthpb.HealthCheckResponse{Status: status}), nil
+		},
+	))
+
+	mux.Handle(watchProcedure, connect.NewServerStreamHandler(
+		watchProcedure,
+		func(ctx context.Context, req *connect.Request[healthpb.HealthCheckRequest], stream *connect.ServerStream[healthpb.HealthCheckResponse]) error {
+			ticker := time.NewTicker(watchInterval)
+			defer ticker.Stop()
+
+			last := healthpb.HealthCheckResponse_ServingStatus(-1)
+			for {
+				status, err := c.servingStatus(ctx, req.Msg.Service)
+				if err != nil {
+					// Watch reports unknown services instead of failing (see health.proto)
+					status = healthpb.HealthCheckResponse_SERVICE_UNKNOWN
+				}
+				if status != last {
+					if err := stream.Send(&healthpb.HealthCheckResponse{Status: status}); err != nil {
+						return err
+					}
+					last = status
+				}
+
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-ticker.C:
+				}
+			}
+		},
+	))
+
+	return "/" + healthServiceName + "/", mux
+}
+
+// AddService makes a gRPC service name report the overall readiness.
+func (c *Checker) AddService(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.services == nil {
+		c.services = make(map[string]bool)
+	}
+	c.services[name] = true
+}
+
+func (c *Checker) servingStatus(ctx context.Context, service string) (healthpb.HealthCheckResponse_ServingStatus, error) {
+	c.mu.RLock()
+	overall := service == "" || c.services[service]
+	c.mu.RUnlock()
+
+	only := ""
+	if !overall {
+		if !c.Has(service) {
+			return healthpb.HealthCheckResponse_SERVICE_UNKNOWN,
+				connect.NewError(connect.CodeNotFound, fmt.Errorf("unknown service %q", service))
+		}
+		only = service
+	}
+
+	if _, ok := c.Run(ctx, only); !ok {
+		return healthpb.HealthCheckResponse_NOT_SERVING, nil
+	}
+	return healthpb.HealthCheckResponse_SERVING, nil
+}
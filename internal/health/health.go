@@ -0,0 +1,155 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// CheckFunc reports whether a dependency is usable.
+type CheckFunc func(ctx context.Context) error
+
+type check struct {
+	name string
+	fn   CheckFunc
+}
+
+// Checker keeps the readiness checks of a process.
+type Checker struct {
+	mu       sync.RWMutex
+	checks   []check
+	services map[string]bool
+	timeout  time.Duration
+	draining atomic.Bool
+}
+
+// Result of a single readiness round.
+type Result struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+func NewChecker() *Checker {
+	return &Checker{timeout: 2 * time.Second}
+}
+
+// Register adds a named readiness check (e.g. "postgres", "redis").
+func (c *Checker) Register(name string, fn CheckFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks = append(c.checks, check{name: name, fn: fn})
+}
+
+// Has reports whether a check with the given name exists.
+func (c *Checker) Has(name string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, chk := range c.checks {
+		if chk.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Drain marks the process as shutting down: readiness fails from now on so
+// load balancers stop routing new traffic while in-flight work finishes.
+func (c *Checker) Drain() {
+	c.draining.Store(true)
+}
+
+// DrainDelay is how long a process keeps serving after Drain, so load
+// balancers see /readyz fail before connections close: SHUTDOWN_DELAY
+// (e.g. "5s", "0" to turn it off), 5s by default.
+func DrainDelay() time.Duration {
+	if d, err := time.ParseDuration(os.Getenv("SHUTDOWN_DELAY")); err == nil && d >= 0 {
+		return d
+	}
+	return 5 * time.Second
+}
+
+// Run executes the checks concurrently. If only is not empty, just that check runs.
+func (c *Checker) Run(ctx context.Context, only string) (Result, bool) {
+	c.mu.RLock()
+	checks := make([]check, 0, len(c.checks))
+	for _, chk := range c.checks {
+		if only == "" || chk.name == only {
+			checks = append(checks, chk)
+		}
+	}
+	c.mu.RUnlock()
+
+	if c.draining.Load() {
+		checks = append(checks, check{name: "shutdown", fn: func(context.Context) error {
+			return errors.New("shutting down")
+		}})
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var (
+		wg  sync.WaitGroup
+		mu  sync.Mutex
+		ok  = true
+		res = Result{Status: "ok", Checks: make(map[string]string, len(checks))}
+	)
+	for _, chk := range checks {
+		wg.Add(1)
+		go func(chk check) {
+			defer wg.Done()
+			err := chk.fn(ctx)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				ok = false
+				res.Checks[chk.name] = err.Error()
+				return
+			}
+			res.Checks[chk.name] = "ok"
+		}(chk)
+	}
+	wg.Wait()
+
+	if !ok {
+		res.Status = "fail"
+	}
+	return res, ok
+}
+
+// LiveHandler (/healthz) answers as long as the process can serve HTTP.
+func (c *Checker) LiveHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("ok"))
+	}
+}
+
+// ReadyHandler (/readyz) runs every check and returns 503 if any of them fails.
+// A single check can be selected with ?check=<name>.
+func (c *Checker) ReadyHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, ok := c.Run(r.Context(), r.URL.Query().Get("check"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		if !ok {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		json.NewEncoder(w).Encode(res)
+	}
+}
+
+// Mount registers /healthz, /readyz and the gRPC health service on mux.
+func (c *Checker) Mount(mux *http.ServeMux) {
+	mux.HandleFunc("/healthz", c.LiveHandler())
+	mux.HandleFunc("/readyz", c.ReadyHandler())
+	mux.Handle(NewGRPCHandler(c))
+}
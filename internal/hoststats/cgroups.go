@@ -0,0 +1,191 @@
+package hoststats
+
+import (
+	"bufio"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// topCgroups, how many cgroups (by memory) a sample keeps
+const topCgroups = 50
+
+// CgroupStats, what one cgroup (a container, a systemd service...) uses.
+// Limits are 0 when there is none.
+type CgroupStats struct {
+	Path        string // relative to the cgroup root, e.g. /system.slice/docker-<id>.scope
+	ContainerID string // short id, when the path belongs to a container
+
+	CPUPercent float64 // since the previous reading, 100 = one full core
+	CPULimit   float64 // in cores, from cpu.max
+
+	MemoryBytes uint64
+	MemoryLimit uint64
+
+	IOReadKBps  float64
+	IOWriteKBps float64
+
+	Pids      int32
+	PidsLimit int32
+}
+
+var containerIDPattern = regexp.MustCompile(`[0-9a-f]{64}`)
+
+// cgroupTable keeps each cgroup's CPU time and IO bytes from the previous
+// reading, to turn them into rates.
+type cgroupTable struct {
+	at  time.Time
+	cpu map[string]uint64 // usage_usec
+	io  counterRates
+}
+
+// cgroupRoot, where the cgroup v2 hierarchy is mounted. CGROUP_ROOT wins,
+// otherwise it is found under HOST_SYS like the rest of gopsutil's reads.
+func cgroupRoot() string {
+	if root := os.Getenv("CGROUP_ROOT"); root != "" {
+		return root
+	}
+	sys := os.Getenv("HOST_SYS")
+	if sys == "" {
+		sys = "/sys"
+	}
+	return filepath.Join(sys, "fs", "cgroup")
+}
+
+// read walks the cgroup v2 hierarchy and returns the cgroups that have
+// processes of their own, the heaviest (by memory) first. On cgroup v1 hosts
+// it returns nothing.
+func (t *cgroupTable) read(root string) []CgroupStats {
+	if _, err := os.Stat(filepath.Join(root, "cgroup.controllers")); err != nil {
+		return nil
+	}
+
+	now := time.Now()
+	elapsed := now.Sub(t.at).Seconds()
+	cpu := map[string]uint64{}
+	io := map[string][2]uint64{}
+
+	var cgroups []CgroupStats
+	filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || !d.IsDir() || path == root {
+			return nil
+		}
+		// v2 only lets leaves hold processes (besides the root), so this
+		// doesn't count anything twice
+		if procs, err := os.ReadFile(filepath.Join(path, "cgroup.procs")); err != nil || len(strings.TrimSpace(string(procs))) == 0 {
+			return nil
+		}
+
+		rel := strings.TrimPrefix(path, root)
+		c := CgroupStats{Path: rel}
+		if id := containerIDPattern.FindString(filepath.Base(rel)); id != "" {
+			c.ContainerID = id[:12]
+		}
+
+		if usage, ok := readKeyed(filepath.Join(path, "cpu.stat"))["usage_usec"]; ok {
+			cpu[rel] = usage
+			if prev, ok := t.cpu[rel]; ok && elapsed > 0 && usage >= prev {
+				c.CPUPercent = float64(usage-prev) / 1e6 / elapsed * 100
+			}
+		}
+		if fields := readFields(filepath.Join(path, "cpu.max")); len(fields) == 2 && fields[0] != "max" {
+			quota, _ := strconv.ParseFloat(fields[0], 64)
+			period, _ := strconv.ParseFloat(fields[1], 64)
+			if period > 0 {
+				c.CPULimit = quota / period
+			}
+		}
+
+		c.MemoryBytes = readUint(filepath.Join(path, "memory.current"))
+		c.MemoryLimit = readUint(filepath.Join(path, "memory.max"))
+		c.Pids = int32(readUint(filepath.Join(path, "pids.current")))
+		c.PidsLimit = int32(readUint(filepath.Join(path, "pids.max")))
+
+		io[rel] = readIOStat(filepath.Join(path, "io.stat"))
+
+		cgroups = append(cgroups, c)
+		return nil
+	})
+	t.at, t.cpu = now, cpu
+
+	rates := t.io.update(now, io)
+	for i := range cgroups {
+		rate := rates[cgroups[i].Path]
+		cgroups[i].IOReadKBps, cgroups[i].IOWriteKBps = rate[0], rate[1]
+	}
+
+	sort.Slice(cgroups, func(i, j int) bool { return cgroups[i].MemoryBytes > cgroups[j].MemoryBytes })
+	return cgroups[:min(topCgroups, len(cgroups))]
+}
+
+// readUint reads a single-value cgroup file; "max" and missing files are 0.
+func readUint(path string) uint64 {
+	fields := readFields(path)
+	if len(fields) == 0 {
+		return 0
+	}
+	v, _ := strconv.ParseUint(fields[0], 10, 64)
+	return v
+}
+
+func readFields(path string) []string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	return strings.Fields(string(data))
+}
+
+// readKeyed reads a "key value" per line file like cpu.stat.
+func readKeyed(path string) map[string]uint64 {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	values := map[string]uint64{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		if v, err := strconv.ParseUint(fields[1], 10, 64); err == nil {
+			values[fields[0]] = v
+		}
+	}
+	return values
+}
+
+// readIOStat sums rbytes and wbytes over the devices of an io.stat file:
+//
+//	8:0 rbytes=90430464 wbytes=299008000 rios=8950 wios=11069 dbytes=0 dios=0
+func readIOStat(path string) [2]uint64 {
+	var total [2]uint64
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return total
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		for _, field := range strings.Fields(line) {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				continue
+			}
+			v, _ := strconv.ParseUint(value, 10, 64)
+			switch key {
+			case "rbytes":
+				total[0] += v
+			case "wbytes":
+				total[1] += v
+			}
+		}
+	}
+	return total
+}
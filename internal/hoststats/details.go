@@ -0,0 +1,135 @@
+package hoststats
+
+import (
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/disk"
+	"github.com/shirou/gopsutil/v3/net"
+)
+
+// DiskStats, usage and IO of one mounted filesystem
+type DiskStats struct {
+	Mountpoint string
+	Device     string
+	Fstype     string
+
+	Used    uint64
+	Total   uint64
+	Percent float64
+
+	ReadKBps  float64
+	WriteKBps float64
+}
+
+// InterfaceStats, throughput of one network interface
+type InterfaceStats struct {
+	Name   string
+	RxKBps float64
+	TxKBps float64
+}
+
+// counterRates turns ever-growing byte counters into KB/s since the previous
+// reading. Keys missing from the previous reading (and counters that went
+// backwards) get a zero rate.
+type counterRates struct {
+	at   time.Time
+	prev map[string][2]uint64
+}
+
+func (r *counterRates) update(now time.Time, counters map[string][2]uint64) map[string][2]float64 {
+	rates := make(map[string][2]float64, len(counters))
+	elapsed := now.Sub(r.at).Seconds()
+	for key, cur := range counters {
+		prev, ok := r.prev[key]
+		if !ok || r.at.IsZero() || elapsed <= 0 {
+			rates[key] = [2]float64{}
+			continue
+		}
+		var rate [2]float64
+		for i := range cur {
+			if cur[i] >= prev[i] {
+				rate[i] = float64(cur[i]-prev[i]) / elapsed / 1024
+			}
+		}
+		rates[key] = rate
+	}
+	r.at, r.prev = now, counters
+	return rates
+}
+
+// readDisks lists the physical filesystems with their usage and IO rates.
+// Mountpoints come from the host's mount table (HOST_PROC), so usage is read
+// through rootFS when the host's root is mounted somewhere else.
+func (sm *Sampler) readDisks() []DiskStats {
+	partitions, err := disk.Partitions(false)
+	if err != nil {
+		return nil
+	}
+
+	// same device mounted several times (bind mounts, subvolumes): keep the
+	// shortest mountpoint
+	sort.Slice(partitions, func(i, j int) bool {
+		return len(partitions[i].Mountpoint) < len(partitions[j].Mountpoint)
+	})
+
+	io := map[string][2]uint64{}
+	if counters, err := disk.IOCounters(); err == nil {
+		for name, ioc := range counters {
+			io[name] = [2]uint64{ioc.ReadBytes, ioc.WriteBytes}
+		}
+	}
+	rates := sm.diskIO.update(time.Now(), io)
+
+	seen := map[string]bool{}
+	disks := make([]DiskStats, 0, len(partitions))
+	for _, p := range partitions {
+		if seen[p.Device] {
+			continue
+		}
+		seen[p.Device] = true
+
+		u, err := disk.Usage(filepath.Join(sm.rootFS, p.Mountpoint))
+		if err != nil || u.Total == 0 {
+			continue
+		}
+		rate := rates[filepath.Base(p.Device)]
+		disks = append(disks, DiskStats{
+			Mountpoint: p.Mountpoint,
+			Device:     p.Device,
+			Fstype:     p.Fstype,
+			Used:       u.Used,
+			Total:      u.Total,
+			Percent:    u.UsedPercent,
+			ReadKBps:   rate[0],
+			WriteKBps:  rate[1],
+		})
+	}
+	sort.Slice(disks, func(i, j int) bool { return disks[i].Mountpoint < disks[j].Mountpoint })
+	return disks
+}
+
+// readInterfaces returns rx/tx rates per network interface, loopback excluded.
+func (sm *Sampler) readInterfaces() []InterfaceStats {
+	counters, err := net.IOCounters(true)
+	if err != nil {
+		return nil
+	}
+
+	bytes := make(map[string][2]uint64, len(counters))
+	for _, ioc := range counters {
+		if ioc.Name == "lo" {
+			continue
+		}
+		bytes[ioc.Name] = [2]uint64{ioc.BytesRecv, ioc.BytesSent}
+	}
+	rates := sm.netIO.update(time.Now(), bytes)
+
+	ifaces := make([]InterfaceStats, 0, len(rates))
+	for name, rate := range rates {
+		ifaces = append(ifaces, InterfaceStats{Name: name, RxKBps: rate[0], TxKBps: rate[1]})
+	}
+	sort.Slice(ifaces, func(i, j int) bool { return ifaces[i].Name < ifaces[j].Name })
+	return ifaces
+}
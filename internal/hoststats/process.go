@@ -0,0 +1,133 @@
+package hoststats
+
+import (
+	"runtime"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/process"
+)
+
+// ProcessStates, process counts by state
+type ProcessStates struct {
+	Total    int32
+	Running  int32
+	Sleeping int32
+	Zombie   int32
+}
+
+// ProcessInfo, one row of the top process report
+type ProcessInfo struct {
+	PID     int32
+	Name    string
+	User    string
+	State   string
+	Threads int32
+
+	CPUPercent    float64 // since the previous reading, 100 = one full core
+	RSS           uint64
+	MemoryPercent float64
+}
+
+// processTable keeps each process's CPU time from the previous reading, to
+// turn it into a percentage.
+type processTable struct {
+	at  time.Time
+	cpu map[int32]float64 // user+system seconds
+}
+
+type processReading struct {
+	p     *process.Process
+	state string
+	cpu   float64
+	rss   uint64
+}
+
+// read counts the host's processes by state and returns the n heaviest by CPU
+// and by RSS. If the process list can't be read it falls back to this
+// program's goroutines.
+func (t *processTable) read(n int, memTotal uint64) (ProcessStates, []ProcessInfo, []ProcessInfo) {
+	procs, err := process.Processes()
+	if err != nil {
+		g := int32(runtime.NumGoroutine())
+		return ProcessStates{Total: g, Running: g}, nil, nil
+	}
+
+	now := time.Now()
+	elapsed := now.Sub(t.at).Seconds()
+	cpu := make(map[int32]float64, len(procs))
+
+	states := ProcessStates{Total: int32(len(procs))}
+	readings := make([]processReading, 0, len(procs))
+	for _, p := range procs {
+		status, err := p.Status() // []string
+		if err != nil || len(status) == 0 {
+			continue
+		}
+
+		switch status[0] {
+		case process.Running:
+			states.Running++
+		case process.Zombie, process.Stop, process.Lock:
+			states.Zombie++
+		default: // sleep, idle, wait
+			states.Sleeping++
+		}
+
+		r := processReading{p: p, state: status[0]}
+		if times, err := p.Times(); err == nil {
+			total := times.User + times.System
+			cpu[p.Pid] = total
+			if prev, ok := t.cpu[p.Pid]; ok && elapsed > 0 && total >= prev {
+				r.cpu = (total - prev) / elapsed * 100
+			}
+		}
+		if mem, err := p.MemoryInfo(); err == nil {
+			r.rss = mem.RSS
+		}
+		readings = append(readings, r)
+	}
+	t.at, t.cpu = now, cpu
+
+	// name, user and threads cost a few more reads: only for the ones shown
+	infos := map[int32]ProcessInfo{}
+	top := func(less func(a, b processReading) bool) []ProcessInfo {
+		sort.Slice(readings, func(i, j int) bool { return less(readings[i], readings[j]) })
+		list := make([]ProcessInfo, 0, n)
+		for _, r := range readings[:min(n, len(readings))] {
+			info, ok := infos[r.p.Pid]
+			if !ok {
+				info = r.info(memTotal)
+				infos[r.p.Pid] = info
+			}
+			list = append(list, info)
+		}
+		return list
+	}
+	topCPU := top(func(a, b processReading) bool { return a.cpu > b.cpu })
+	topMemory := top(func(a, b processReading) bool { return a.rss > b.rss })
+	return states, topCPU, topMemory
+}
+
+func (r processReading) info(memTotal uint64) ProcessInfo {
+	info := ProcessInfo{
+		PID:        r.p.Pid,
+		State:      r.state,
+		CPUPercent: r.cpu,
+		RSS:        r.rss,
+	}
+	if memTotal > 0 {
+		info.MemoryPercent = float64(r.rss) / float64(memTotal) * 100
+	}
+	info.Name, _ = r.p.Name()
+	info.Threads, _ = r.p.NumThreads()
+
+	// the user may not exist in our /etc/passwd (containers): show the uid
+	if user, err := r.p.Username(); err == nil {
+		info.User = user
+	} else if uids, err := r.p.Uids(); err == nil && len(uids) > 0 {
+		info.User = strconv.Itoa(int(uids[0]))
+	}
+	return info
+}
@@ -0,0 +1,222 @@
+package hoststats
+
+import (
+	"sort"
+	"time"
+
+	pulsarv1 "github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1"
+	"github.com/shirou/gopsutil/v3/host"
+	"github.com/shirou/gopsutil/v3/load"
+)
+
+// Raw converts the sample, unrounded, to what agents push.
+func (s Sample) Raw() *pulsarv1.SystemSample {
+	raw := &pulsarv1.SystemSample{
+		Time:          s.Time.UnixMilli(),
+		CpuPercent:    s.CPUPercent,
+		CpuCores:      s.CPUCores,
+		Load:          &pulsarv1.LoadAverage{Load1: s.Load.Load1, Load5: s.Load.Load5, Load15: s.Load.Load15},
+		MemoryPercent: s.MemoryPercent,
+		MemoryUsed:    s.MemoryUsed,
+		MemoryTotal:   s.MemoryTotal,
+		DiskPercent:   s.DiskPercent,
+		DiskUsed:      s.DiskUsed,
+		DiskTotal:     s.DiskTotal,
+		NetworkKbs:    s.NetworkKBps,
+		Processes: &pulsarv1.ThreadUsage{
+			Total:    s.Processes.Total,
+			Running:  s.Processes.Running,
+			Sleeping: s.Processes.Sleeping,
+			Zombie:   s.Processes.Zombie,
+		},
+	}
+	for _, d := range s.Disks {
+		raw.Disks = append(raw.Disks, &pulsarv1.DiskSample{
+			Mountpoint: d.Mountpoint,
+			Device:     d.Device,
+			Fstype:     d.Fstype,
+			Used:       d.Used,
+			Total:      d.Total,
+			Percent:    d.Percent,
+			ReadKbs:    d.ReadKBps,
+			WriteKbs:   d.WriteKBps,
+		})
+	}
+	for _, i := range s.Interfaces {
+		raw.Interfaces = append(raw.Interfaces, &pulsarv1.InterfaceUsage{Name: i.Name, RxKbs: i.RxKBps, TxKbs: i.TxKBps})
+	}
+	for _, c := range s.Cgroups {
+		raw.Cgroups = append(raw.Cgroups, c.Raw())
+	}
+	for _, p := range s.TopCPU {
+		raw.TopCpu = append(raw.TopCpu, p.Raw())
+	}
+	for _, p := range s.TopMemory {
+		raw.TopMemory = append(raw.TopMemory, p.Raw())
+	}
+	if s.Host != nil {
+		raw.Info = &pulsarv1.SystemInfo{
+			Hostname:        s.Host.Hostname,
+			Os:              s.Host.OS,
+			UptimeSeconds:   s.Host.Uptime,
+			Platform:        s.Host.Platform,
+			PlatformVersion: s.Host.PlatformVersion,
+		}
+	}
+	return raw
+}
+
+// FromRaw is the reverse of Raw. A sample without a time is stamped now.
+func FromRaw(raw *pulsarv1.SystemSample) Sample {
+	s := Sample{
+		Time:          time.UnixMilli(raw.GetTime()),
+		CPUPercent:    raw.GetCpuPercent(),
+		CPUCores:      raw.GetCpuCores(),
+		MemoryPercent: raw.GetMemoryPercent(),
+		MemoryUsed:    raw.GetMemoryUsed(),
+		MemoryTotal:   raw.GetMemoryTotal(),
+		DiskPercent:   raw.GetDiskPercent(),
+		DiskUsed:      raw.GetDiskUsed(),
+		DiskTotal:     raw.GetDiskTotal(),
+		NetworkKBps:   raw.GetNetworkKbs(),
+		Load: load.AvgStat{
+			Load1:  raw.GetLoad().GetLoad1(),
+			Load5:  raw.GetLoad().GetLoad5(),
+			Load15: raw.GetLoad().GetLoad15(),
+		},
+		Processes: ProcessStates{
+			Total:    raw.GetProcesses().GetTotal(),
+			Running:  raw.GetProcesses().GetRunning(),
+			Sleeping: raw.GetProcesses().GetSleeping(),
+			Zombie:   raw.GetProcesses().GetZombie(),
+		},
+	}
+	if raw.GetTime() == 0 {
+		s.Time = time.Now()
+	}
+	for _, d := range raw.GetDisks() {
+		s.Disks = append(s.Disks, DiskStats{
+			Mountpoint: d.Mountpoint,
+			Device:     d.Device,
+			Fstype:     d.Fstype,
+			Used:       d.Used,
+			Total:      d.Total,
+			Percent:    d.Percent,
+			ReadKBps:   d.ReadKbs,
+			WriteKBps:  d.WriteKbs,
+		})
+	}
+	for _, i := range raw.GetInterfaces() {
+		s.Interfaces = append(s.Interfaces, InterfaceStats{Name: i.Name, RxKBps: i.RxKbs, TxKBps: i.TxKbs})
+	}
+	for _, c := range raw.GetCgroups() {
+		s.Cgroups = append(s.Cgroups, cgroupFromRaw(c))
+	}
+	for _, p := range raw.GetTopCpu() {
+		s.TopCPU = append(s.TopCPU, processFromRaw(p))
+	}
+	for _, p := range raw.GetTopMemory() {
+		s.TopMemory = append(s.TopMemory, processFromRaw(p))
+	}
+	if info := raw.GetInfo(); info != nil {
+		s.Host = &host.InfoStat{
+			Hostname:        info.Hostname,
+			OS:              info.Os,
+			Uptime:          info.UptimeSeconds,
+			Platform:        info.Platform,
+			PlatformVersion: info.PlatformVersion,
+		}
+	}
+	return s
+}
+
+// caps on what an agent may push, well above any real host
+const (
+	maxDisks      = 64
+	maxInterfaces = 64
+	maxCores      = 1024
+)
+
+// Limit trims a pushed sample to what a local one holds: the top cgroups
+// and processes, the fullest disks and the busiest interfaces.
+func (s *Sample) Limit() {
+	sort.SliceStable(s.Cgroups, func(i, j int) bool { return s.Cgroups[i].MemoryBytes > s.Cgroups[j].MemoryBytes })
+	s.Cgroups = s.Cgroups[:min(topCgroups, len(s.Cgroups))]
+
+	sort.SliceStable(s.TopCPU, func(i, j int) bool { return s.TopCPU[i].CPUPercent > s.TopCPU[j].CPUPercent })
+	s.TopCPU = s.TopCPU[:min(topProcesses, len(s.TopCPU))]
+	sort.SliceStable(s.TopMemory, func(i, j int) bool { return s.TopMemory[i].RSS > s.TopMemory[j].RSS })
+	s.TopMemory = s.TopMemory[:min(topProcesses, len(s.TopMemory))]
+
+	if len(s.Disks) > maxDisks {
+		sort.SliceStable(s.Disks, func(i, j int) bool { return s.Disks[i].Percent > s.Disks[j].Percent })
+		s.Disks = s.Disks[:maxDisks]
+		sort.Slice(s.Disks, func(i, j int) bool { return s.Disks[i].Mountpoint < s.Disks[j].Mountpoint })
+	}
+	if len(s.Interfaces) > maxInterfaces {
+		sort.SliceStable(s.Interfaces, func(i, j int) bool {
+			return s.Interfaces[i].RxKBps+s.Interfaces[i].TxKBps > s.Interfaces[j].RxKBps+s.Interfaces[j].TxKBps
+		})
+		s.Interfaces = s.Interfaces[:maxInterfaces]
+		sort.Slice(s.Interfaces, func(i, j int) bool { return s.Interfaces[i].Name < s.Interfaces[j].Name })
+	}
+	s.CPUCores = s.CPUCores[:min(maxCores, len(s.CPUCores))]
+}
+
+// Raw converts the process, unrounded.
+func (p ProcessInfo) Raw() *pulsarv1.ProcessInfo {
+	return &pulsarv1.ProcessInfo{
+		Pid:           p.PID,
+		Name:          p.Name,
+		User:          p.User,
+		State:         p.State,
+		Threads:       p.Threads,
+		CpuPercent:    p.CPUPercent,
+		RssBytes:      p.RSS,
+		MemoryPercent: p.MemoryPercent,
+	}
+}
+
+func processFromRaw(p *pulsarv1.ProcessInfo) ProcessInfo {
+	return ProcessInfo{
+		PID:           p.Pid,
+		Name:          p.Name,
+		User:          p.User,
+		State:         p.State,
+		Threads:       p.Threads,
+		CPUPercent:    p.CpuPercent,
+		RSS:           p.RssBytes,
+		MemoryPercent: p.MemoryPercent,
+	}
+}
+
+// Raw converts the cgroup, unrounded.
+func (c CgroupStats) Raw() *pulsarv1.CgroupUsage {
+	return &pulsarv1.CgroupUsage{
+		Path:        c.Path,
+		ContainerId: c.ContainerID,
+		CpuPercent:  c.CPUPercent,
+		CpuLimit:    c.CPULimit,
+		MemoryBytes: c.MemoryBytes,
+		MemoryLimit: c.MemoryLimit,
+		IoReadKbs:   c.IOReadKBps,
+		IoWriteKbs:  c.IOWriteKBps,
+		Pids:        c.Pids,
+		PidsLimit:   c.PidsLimit,
+	}
+}
+
+func cgroupFromRaw(c *pulsarv1.CgroupUsage) CgroupStats {
+	return CgroupStats{
+		Path:        c.Path,
+		ContainerID: c.ContainerId,
+		CPUPercent:  c.CpuPercent,
+		CPULimit:    c.CpuLimit,
+		MemoryBytes: c.MemoryBytes,
+		MemoryLimit: c.MemoryLimit,
+		IOReadKBps:  c.IoReadKbs,
+		IOWriteKBps: c.IoWriteKbs,
+		Pids:        c.Pids,
+		PidsLimit:   c.PidsLimit,
+	}
+}
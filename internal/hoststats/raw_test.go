@@ -0,0 +1,53 @@
+package hoststats
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestLimit(t *testing.T) {
+	var s Sample
+	for i := 0; i < 100; i++ {
+		s.Cgroups = append(s.Cgroups, CgroupStats{Path: strconv.Itoa(i), MemoryBytes: uint64(i)})
+		s.TopCPU = append(s.TopCPU, ProcessInfo{PID: int32(i), CPUPercent: float64(i)})
+		s.TopMemory = append(s.TopMemory, ProcessInfo{PID: int32(i), RSS: uint64(i)})
+		s.Disks = append(s.Disks, DiskStats{Mountpoint: "/mnt/" + strconv.Itoa(100+i), Percent: float64(i)})
+		s.Interfaces = append(s.Interfaces, InterfaceStats{Name: "eth" + strconv.Itoa(100+i), RxKBps: float64(i)})
+	}
+	s.CPUCores = make([]float64, 5000)
+	s.Limit()
+
+	if len(s.Cgroups) != topCgroups || s.Cgroups[0].MemoryBytes != 99 {
+		t.Errorf("cgroups: %d, heaviest %d", len(s.Cgroups), s.Cgroups[0].MemoryBytes)
+	}
+	if len(s.TopCPU) != topProcesses || s.TopCPU[0].CPUPercent != 99 {
+		t.Errorf("top cpu: %d, first %v", len(s.TopCPU), s.TopCPU[0].CPUPercent)
+	}
+	if len(s.TopMemory) != topProcesses || s.TopMemory[0].RSS != 99 {
+		t.Errorf("top memory: %d, first %d", len(s.TopMemory), s.TopMemory[0].RSS)
+	}
+	// the fullest disks, back in mountpoint order
+	if len(s.Disks) != maxDisks || s.Disks[0].Mountpoint != "/mnt/136" || s.Disks[maxDisks-1].Mountpoint != "/mnt/199" {
+		t.Errorf("disks: %d, %s..%s", len(s.Disks), s.Disks[0].Mountpoint, s.Disks[len(s.Disks)-1].Mountpoint)
+	}
+	if len(s.Interfaces) != maxInterfaces || s.Interfaces[0].Name != "eth136" {
+		t.Errorf("interfaces: %d, first %s", len(s.Interfaces), s.Interfaces[0].Name)
+	}
+	if len(s.CPUCores) != maxCores {
+		t.Errorf("cores: %d", len(s.CPUCores))
+	}
+}
+
+func TestLimitSmall(t *testing.T) {
+	s := Sample{
+		Disks:  []DiskStats{{Mountpoint: "/"}, {Mountpoint: "/boot", Percent: 50}},
+		TopCPU: []ProcessInfo{{PID: 1, CPUPercent: 1}, {PID: 2, CPUPercent: 2}},
+	}
+	s.Limit()
+	if s.Disks[0].Mountpoint != "/" || len(s.Disks) != 2 {
+		t.Errorf("disks reordered: %+v", s.Disks)
+	}
+	if s.TopCPU[0].PID != 2 {
+		t.Errorf("top cpu not by cpu: %+v", s.TopCPU)
+	}
+}
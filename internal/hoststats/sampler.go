@@ -0,0 +1,124 @@
+// Package hoststats reads a host: CPU, memory, disks, interfaces, cgroups and
+// processes. It is all the agent needs, so it stays free of the database and
+// Redis; recording samples is systemstats' job.
+package hoststats
+
+import (
+	"os"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/disk"
+	"github.com/shirou/gopsutil/v3/host"
+	"github.com/shirou/gopsutil/v3/load"
+	"github.com/shirou/gopsutil/v3/mem"
+	"github.com/shirou/gopsutil/v3/net"
+)
+
+// topProcesses, how many processes (by CPU and by RSS) a sample keeps
+const topProcesses = 10
+
+// Sample, one reading of the host
+type Sample struct {
+	Time time.Time
+
+	CPUPercent float64
+	CPUCores   []float64
+	Load       load.AvgStat
+
+	MemoryPercent float64
+	MemoryUsed    uint64
+	MemoryTotal   uint64
+
+	DiskPercent float64
+	DiskUsed    uint64
+	DiskTotal   uint64
+
+	NetworkKBps float64
+
+	Disks      []DiskStats
+	Interfaces []InterfaceStats
+	Cgroups    []CgroupStats // by memory, empty without cgroup v2
+
+	Processes ProcessStates
+	TopCPU    []ProcessInfo
+	TopMemory []ProcessInfo // by RSS
+	Host      *host.InfoStat
+}
+
+// Sampler reads the host. It keeps the previous counters, to turn them into
+// rates, so each host needs one Sampler.
+type Sampler struct {
+	rootFS     string // where the host's / is mounted, "" when it's ours
+	diskPath   string
+	cgroupRoot string
+
+	// previous network counters, for the throughput
+	prevNetTime  time.Time
+	prevNetBytes uint64
+
+	// previous per-device counters
+	diskIO counterRates
+	netIO  counterRates
+	procs  processTable
+	groups cgroupTable
+}
+
+func NewSampler() *Sampler {
+	rootFS := os.Getenv("ROOT_FS")
+	diskPath := rootFS
+	if diskPath == "" {
+		diskPath = "/"
+	}
+	sm := &Sampler{rootFS: rootFS, diskPath: diskPath, cgroupRoot: cgroupRoot()}
+	sm.Sample() // first reading only sets the rate baselines
+	return sm
+}
+
+// Sample reads the host once. Readings that fail are left at zero.
+func (sm *Sampler) Sample() Sample {
+	s := Sample{Time: time.Now()}
+
+	if percents, err := cpu.Percent(0, false); err == nil && len(percents) > 0 {
+		s.CPUPercent = percents[0]
+	}
+	if percents, err := cpu.Percent(0, true); err == nil {
+		s.CPUCores = percents
+	}
+	if avg, err := load.Avg(); err == nil {
+		s.Load = *avg
+	}
+	if v, err := mem.VirtualMemory(); err == nil {
+		s.MemoryPercent, s.MemoryUsed, s.MemoryTotal = v.UsedPercent, v.Used, v.Total
+	}
+	if d, err := disk.Usage(sm.diskPath); err == nil {
+		s.DiskPercent, s.DiskUsed, s.DiskTotal = d.UsedPercent, d.Used, d.Total
+	}
+	s.NetworkKBps = sm.networkKBps()
+	s.Disks = sm.readDisks()
+	s.Interfaces = sm.readInterfaces()
+	s.Cgroups = sm.groups.read(sm.cgroupRoot)
+	s.Processes, s.TopCPU, s.TopMemory = sm.procs.read(topProcesses, s.MemoryTotal)
+	s.Host, _ = host.Info()
+	return s
+}
+
+// networkKBps, combined rx+tx throughput since the previous call
+func (sm *Sampler) networkKBps() float64 {
+	counters, err := net.IOCounters(false)
+	if err != nil || len(counters) == 0 {
+		return 0
+	}
+	now := time.Now()
+	total := counters[0].BytesRecv + counters[0].BytesSent
+
+	kbps := 0.0
+	if !sm.prevNetTime.IsZero() && total >= sm.prevNetBytes {
+		if elapsed := now.Sub(sm.prevNetTime).Seconds(); elapsed > 0 {
+			// (Byte / Sec) / 1024 => KB/s
+			kbps = float64(total-sm.prevNetBytes) / elapsed / 1024
+		}
+	}
+	sm.prevNetTime, sm.prevNetBytes = now, total
+	return kbps
+}
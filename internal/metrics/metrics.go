@@ -0,0 +1,394 @@
+package metrics
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "pulsar"
+
+// --- MONITOR METRICS ---
+var (
+	MonitorUp = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "monitor_up",
+		Help:      "1 if the last check of the monitor succeeded, 0 otherwise.",
+	}, []string{"monitor_id", "url"})
+
+	MonitorStatusCode = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "monitor_status_code",
+		Help:      "HTTP status code of the last check (0 on connection error).",
+	}, []string{"monitor_id", "url"})
+
+	MonitorChecks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "monitor_checks_total",
+		Help:      "Number of checks performed per monitor, by result.",
+	}, []string{"monitor_id", "result"})
+
+	MonitorLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "monitor_latency_seconds",
+		Help:      "Total duration of monitor checks.",
+		Buckets:   []float64{.025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+	}, []string{"monitor_id"})
+
+	MonitorPhase = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "monitor_phase_seconds",
+		Help:      "Duration of each request phase (dns, connect, tls, ttfb, download).",
+		Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+	}, []string{"monitor_id", "phase"})
+
+	MonitorPacketLoss = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "monitor_packet_loss_percent",
+		Help:      "Packet loss of the last check of an ICMP monitor.",
+	}, []string{"monitor_id"})
+
+	MonitorJitter = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "monitor_jitter_seconds",
+		Help:      "Round-trip time jitter of the last check of an ICMP monitor.",
+	}, []string{"monitor_id"})
+)
+
+// --- SYSTEM METRICS ---
+// Registered with RegisterSystemMetrics, only where the systemstats
+// collector runs; elsewhere they would be exported as zeros.
+var (
+	SystemCPUPercent = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "system",
+		Name:      "cpu_percent",
+		Help:      "Host CPU usage in percent.",
+	})
+
+	SystemMemoryPercent = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "system",
+		Name:      "memory_percent",
+		Help:      "Host memory usage in percent.",
+	})
+
+	SystemMemoryUsedBytes = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "system",
+		Name:      "memory_used_bytes",
+		Help:      "Host memory in use.",
+	})
+
+	SystemDiskPercent = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "system",
+		Name:      "disk_percent",
+		Help:      "Root filesystem usage in percent.",
+	})
+
+	SystemDiskUsedBytes = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "system",
+		Name:      "disk_used_bytes",
+		Help:      "Root filesystem bytes in use.",
+	})
+
+	SystemNetworkKBps = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "system",
+		Name:      "network_kb_per_second",
+		Help:      "Combined rx+tx network throughput in KB/s.",
+	})
+
+	SystemThreads = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "system",
+		Name:      "processes",
+		Help:      "Number of processes by state.",
+	}, []string{"state"})
+
+	SystemUptimeSeconds = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "system",
+		Name:      "uptime_seconds",
+		Help:      "Host uptime.",
+	})
+
+	SystemLoad = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "system",
+		Name:      "load",
+		Help:      "Host load average, by period (1m, 5m, 15m).",
+	}, []string{"period"})
+
+	SystemCoreCPUPercent = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "system",
+		Name:      "core_cpu_percent",
+		Help:      "CPU usage in percent, per core.",
+	}, []string{"core"})
+
+	SystemMountPercent = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "system",
+		Name:      "mount_percent",
+		Help:      "Filesystem usage in percent, per mountpoint.",
+	}, []string{"mountpoint", "device"})
+
+	SystemMountUsedBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "system",
+		Name:      "mount_used_bytes",
+		Help:      "Filesystem bytes in use, per mountpoint.",
+	}, []string{"mountpoint", "device"})
+
+	SystemDiskIOKBps = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "system",
+		Name:      "disk_io_kb_per_second",
+		Help:      "Disk IO throughput in KB/s, per device and direction (read, write).",
+	}, []string{"device", "direction"})
+
+	SystemInterfaceKBps = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "system",
+		Name:      "interface_kb_per_second",
+		Help:      "Network throughput in KB/s, per interface and direction (rx, tx).",
+	}, []string{"interface", "direction"})
+
+	SystemCgroupCPUPercent = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "system",
+		Name:      "cgroup_cpu_percent",
+		Help:      "CPU usage in percent (100 = one core), per cgroup.",
+	}, []string{"cgroup", "container_id"})
+
+	SystemCgroupMemoryBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "system",
+		Name:      "cgroup_memory_bytes",
+		Help:      "Memory in use, per cgroup.",
+	}, []string{"cgroup", "container_id"})
+
+	SystemCgroupPids = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "system",
+		Name:      "cgroup_pids",
+		Help:      "Number of tasks, per cgroup.",
+	}, []string{"cgroup", "container_id"})
+)
+
+// --- INTERNAL METRICS ---
+var (
+	TasksProcessed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tasks_processed_total",
+		Help:      "Number of asynq tasks processed by the worker, by type and result.",
+	}, []string{"type", "result"})
+
+	TaskDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "task_duration_seconds",
+		Help:      "Time spent processing asynq tasks.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"type"})
+
+	TasksEnqueued = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tasks_enqueued_total",
+		Help:      "Number of tasks enqueued by the scheduler, by result.",
+	}, []string{"result"})
+
+	HubClients = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "ws",
+		Name:      "clients",
+		Help:      "Number of connected WebSocket clients.",
+	})
+
+	HubDropped = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "ws",
+		Name:      "clients_dropped_total",
+		Help:      "Number of WebSocket clients disconnected for being too slow.",
+	})
+
+	HubMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "ws",
+		Name:      "messages_broadcast_total",
+		Help:      "Number of messages broadcast to WebSocket clients.",
+	})
+)
+
+// RegisterSystemMetrics adds the host metrics to the default registry.
+func RegisterSystemMetrics() {
+	prometheus.MustRegister(
+		SystemCPUPercent,
+		SystemMemoryPercent,
+		SystemMemoryUsedBytes,
+		SystemDiskPercent,
+		SystemDiskUsedBytes,
+		SystemNetworkKBps,
+		SystemThreads,
+		SystemUptimeSeconds,
+		SystemLoad,
+		SystemCoreCPUPercent,
+		SystemMountPercent,
+		SystemMountUsedBytes,
+		SystemDiskIOKBps,
+		SystemInterfaceKBps,
+		SystemCgroupCPUPercent,
+		SystemCgroupMemoryBytes,
+		SystemCgroupPids,
+	)
+}
+
+// Handler exposes the default registry in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// TaskMiddleware counts and times every task handled by the asynq server.
+func TaskMiddleware(next asynq.Handler) asynq.Handler {
+	return asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) error {
+		start := time.Now()
+		err := next.ProcessTask(ctx, t)
+		TaskDuration.WithLabelValues(t.Type()).Observe(time.Since(start).Seconds())
+
+		result := "success"
+		if err != nil {
+			result = "error"
+		}
+		TasksProcessed.WithLabelValues(t.Type(), result).Inc()
+		return err
+	})
+}
+
+// monitors with series in this process, by id: the url of their
+// monitor_up/monitor_status_code series
+var (
+	observedMu sync.Mutex
+	observed   = map[string]string{}
+)
+
+// ObserveCheck records the outcome of a single monitor check.
+func ObserveCheck(monitorID, url string, statusCode int, up bool, total time.Duration, phases map[string]time.Duration) {
+	upVal, result := 0.0, "down"
+	if up {
+		upVal, result = 1.0, "up"
+	}
+
+	// the url changed: the old series would stay at their last value
+	observedMu.Lock()
+	if old, ok := observed[monitorID]; ok && old != url {
+		MonitorUp.DeleteLabelValues(monitorID, old)
+		MonitorStatusCode.DeleteLabelValues(monitorID, old)
+	}
+	observed[monitorID] = url
+	observedMu.Unlock()
+
+	MonitorUp.WithLabelValues(monitorID, url).Set(upVal)
+	MonitorStatusCode.WithLabelValues(monitorID, url).Set(float64(statusCode))
+	MonitorChecks.WithLabelValues(monitorID, result).Inc()
+	MonitorLatency.WithLabelValues(monitorID).Observe(total.Seconds())
+
+	for phase, d := range phases {
+		MonitorPhase.WithLabelValues(monitorID, phase).Observe(d.Seconds())
+	}
+}
+
+// ObservePing records the packet stats of an ICMP check, next to ObserveCheck.
+func ObservePing(monitorID string, loss float64, jitter time.Duration) {
+	MonitorPacketLoss.WithLabelValues(monitorID).Set(loss)
+	MonitorJitter.WithLabelValues(monitorID).Set(jitter.Seconds())
+}
+
+// ForgetMonitor deletes every series of the monitor, once it is deleted.
+func ForgetMonitor(monitorID string) {
+	observedMu.Lock()
+	delete(observed, monitorID)
+	observedMu.Unlock()
+
+	labels := prometheus.Labels{"monitor_id": monitorID}
+	MonitorUp.DeletePartialMatch(labels)
+	MonitorStatusCode.DeletePartialMatch(labels)
+	MonitorChecks.DeletePartialMatch(labels)
+	MonitorLatency.DeletePartialMatch(labels)
+	MonitorPhase.DeletePartialMatch(labels)
+	MonitorPacketLoss.DeletePartialMatch(labels)
+	MonitorJitter.DeletePartialMatch(labels)
+}
+
+// ForgetMonitorsExcept deletes the series of the monitors not in keep. Every
+// process has its own series, so the ones of a monitor deleted through
+// another process are only found this way.
+func ForgetMonitorsExcept(keep map[string]bool) int {
+	observedMu.Lock()
+	var gone []string
+	for id := range observed {
+		if !keep[id] {
+			gone = append(gone, id)
+		}
+	}
+	observedMu.Unlock()
+
+	for _, id := range gone {
+		ForgetMonitor(id)
+	}
+	return len(gone)
+}
+
+// queueCollector reports asynq queue depth at scrape time.
+type queueCollector struct {
+	inspector *asynq.Inspector
+	depth     *prometheus.Desc
+}
+
+// RegisterQueueCollector registers a collector reading queue sizes from asynq.
+func RegisterQueueCollector(inspector *asynq.Inspector) {
+	prometheus.MustRegister(&queueCollector{
+		inspector: inspector,
+		depth: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "queue", "tasks"),
+			"Number of tasks in each asynq queue, by state.",
+			[]string{"queue", "state"}, nil,
+		),
+	})
+}
+
+func (c *queueCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.depth
+}
+
+func (c *queueCollector) Collect(ch chan<- prometheus.Metric) {
+	queues, err := c.inspector.Queues()
+	if err != nil {
+		log.Printf("⚠️ Queue metrics error: %v", err)
+		return
+	}
+	for _, q := range queues {
+		info, err := c.inspector.GetQueueInfo(q)
+		if err != nil {
+			continue
+		}
+		states := map[string]int{
+			"pending":   info.Pending,
+			"active":    info.Active,
+			"scheduled": info.Scheduled,
+			"retry":     info.Retry,
+			"archived":  info.Archived,
+		}
+		for state, n := range states {
+			ch <- prometheus.MustNewConstMetric(c.depth, prometheus.GaugeValue, float64(n), q, state)
+		}
+	}
+}
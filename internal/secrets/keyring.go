@@ -0,0 +1,170 @@
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Keyring holds the master keys. Values are sealed with a fresh data key
+// each, the data key with the current master key; the previous ones are
+// only there to open what was sealed before a rotation.
+type Keyring struct {
+	current string // key id
+	keys    map[string][]byte
+}
+
+// LoadKeyring reads the master keys, base64 encoded 32 bytes:
+// SECRETS_KEY_FILE (one key per line, the first is current) or SECRETS_KEY
+// and SECRETS_PREVIOUS_KEYS (comma separated). Nil without a key.
+func LoadKeyring() (*Keyring, error) {
+	var encoded []string
+	if path := os.Getenv("SECRETS_KEY_FILE"); path != "" {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("SECRETS_KEY_FILE okunamadı: %w", err)
+		}
+		for _, line := range strings.Split(string(raw), "\n") {
+			if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+				encoded = append(encoded, line)
+			}
+		}
+	} else if key := strings.TrimSpace(os.Getenv("SECRETS_KEY")); key != "" {
+		encoded = append(encoded, key)
+		for _, k := range strings.Split(os.Getenv("SECRETS_PREVIOUS_KEYS"), ",") {
+			if k = strings.TrimSpace(k); k != "" {
+				encoded = append(encoded, k)
+			}
+		}
+	}
+	if len(encoded) == 0 {
+		return nil, nil
+	}
+
+	keys := make([][]byte, 0, len(encoded))
+	for i, e := range encoded {
+		key, err := base64.StdEncoding.DecodeString(e)
+		if err != nil || len(key) != 32 {
+			return nil, fmt.Errorf("master key %d: base64 kodlu 32 byte olmalı", i+1)
+		}
+		keys = append(keys, key)
+	}
+	return NewKeyring(keys[0], keys[1:]...), nil
+}
+
+func NewKeyring(current []byte, previous ...[]byte) *Keyring {
+	k := &Keyring{current: keyID(current), keys: map[string][]byte{}}
+	k.keys[k.current] = current
+	for _, key := range previous {
+		k.keys[keyID(key)] = key
+	}
+	return k
+}
+
+// CurrentID, the fingerprint of the key new secrets are sealed with
+func (k *Keyring) CurrentID() string {
+	return k.current
+}
+
+// keyID, the first 8 bytes of the key's sha256: tells the keys apart
+// without giving anything away.
+func keyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:8])
+}
+
+// sealed, a value as stored in the secrets table
+type sealed struct {
+	Ciphertext []byte // nonce || AES-256-GCM(data key, value)
+	WrappedKey []byte // nonce || AES-256-GCM(master key, data key)
+	KeyID      string
+}
+
+// seal encrypts value under a new data key. aad (the secret's id) ties the
+// ciphertext to its row, so it can't be copied onto another secret.
+func (k *Keyring) seal(value, aad []byte) (sealed, error) {
+	dataKey := make([]byte, 32)
+	if _, err := rand.Read(dataKey); err != nil {
+		return sealed{}, err
+	}
+	ciphertext, err := encrypt(dataKey, value, aad)
+	if err != nil {
+		return sealed{}, err
+	}
+	wrapped, err := encrypt(k.keys[k.current], dataKey, aad)
+	if err != nil {
+		return sealed{}, err
+	}
+	return sealed{Ciphertext: ciphertext, WrappedKey: wrapped, KeyID: k.current}, nil
+}
+
+func (k *Keyring) open(s sealed, aad []byte) ([]byte, error) {
+	dataKey, err := k.unwrap(s, aad)
+	if err != nil {
+		return nil, err
+	}
+	return decrypt(dataKey, s.Ciphertext, aad)
+}
+
+func (k *Keyring) unwrap(s sealed, aad []byte) ([]byte, error) {
+	master, ok := k.keys[s.KeyID]
+	if !ok {
+		return nil, fmt.Errorf("master key %s yok (SECRETS_PREVIOUS_KEYS?)", s.KeyID)
+	}
+	return decrypt(master, s.WrappedKey, aad)
+}
+
+// rewrap wraps the data key of s with the current master key; the value
+// itself is left as it is.
+func (k *Keyring) rewrap(s sealed, aad []byte) (sealed, error) {
+	dataKey, err := k.unwrap(s, aad)
+	if err != nil {
+		return sealed{}, err
+	}
+	wrapped, err := encrypt(k.keys[k.current], dataKey, aad)
+	if err != nil {
+		return sealed{}, err
+	}
+	return sealed{Ciphertext: s.Ciphertext, WrappedKey: wrapped, KeyID: k.current}, nil
+}
+
+func encrypt(key, plaintext, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize(), gcm.NonceSize()+len(plaintext)+gcm.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func decrypt(key, data, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, fmt.Errorf("bozuk şifreli veri")
+	}
+	plaintext, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], aad)
+	if err != nil {
+		return nil, fmt.Errorf("çözülemedi: %w", err)
+	}
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,147 @@
+package secrets
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newKey(t *testing.T) []byte {
+	t.Helper()
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestSealOpen(t *testing.T) {
+	k := NewKeyring(newKey(t))
+	aad := []byte("secret-1")
+
+	s, err := k.seal([]byte("hunter2"), aad)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.KeyID != k.CurrentID() || bytes.Contains(s.Ciphertext, []byte("hunter2")) {
+		t.Fatalf("sealed %+v", s)
+	}
+	value, err := k.open(s, aad)
+	if err != nil || string(value) != "hunter2" {
+		t.Fatalf("open = %q, %v", value, err)
+	}
+
+	// each value gets its own data key
+	again, _ := k.seal([]byte("hunter2"), aad)
+	if bytes.Equal(again.Ciphertext, s.Ciphertext) || bytes.Equal(again.WrappedKey, s.WrappedKey) {
+		t.Error("same value sealed twice to the same bytes")
+	}
+
+	if _, err := k.open(s, []byte("secret-2")); err == nil {
+		t.Error("opened with another secret's id")
+	}
+	tampered := s
+	tampered.Ciphertext = append([]byte(nil), s.Ciphertext...)
+	tampered.Ciphertext[len(tampered.Ciphertext)-1] ^= 1
+	if _, err := k.open(tampered, aad); err == nil {
+		t.Error("opened a tampered ciphertext")
+	}
+	if _, err := k.open(sealed{Ciphertext: []byte{1}, WrappedKey: []byte{1}, KeyID: s.KeyID}, aad); err == nil {
+		t.Error("opened a truncated value")
+	}
+}
+
+func TestRotation(t *testing.T) {
+	oldKey, newKeyBytes := newKey(t), newKey(t)
+	aad := []byte("secret-1")
+
+	before := NewKeyring(oldKey)
+	s, err := before.seal([]byte("v"), aad)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rotated := NewKeyring(newKeyBytes, oldKey)
+	if rotated.CurrentID() == before.CurrentID() {
+		t.Fatal("same id for different keys")
+	}
+	if value, err := rotated.open(s, aad); err != nil || string(value) != "v" {
+		t.Fatalf("previous key: open = %q, %v", value, err)
+	}
+
+	rewrapped, err := rotated.rewrap(s, aad)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rewrapped.KeyID != rotated.CurrentID() || !bytes.Equal(rewrapped.Ciphertext, s.Ciphertext) {
+		t.Errorf("rewrap should only replace the wrapped key: %+v", rewrapped)
+	}
+
+	// once everything is rewrapped the old key can go
+	onlyNew := NewKeyring(newKeyBytes)
+	if value, err := onlyNew.open(rewrapped, aad); err != nil || string(value) != "v" {
+		t.Errorf("rewrapped: open = %q, %v", value, err)
+	}
+	_, err = onlyNew.open(s, aad)
+	if err == nil || !strings.Contains(err.Error(), s.KeyID) {
+		t.Errorf("not rewrapped: err = %v, want the missing key id", err)
+	}
+	if _, err := onlyNew.rewrap(s, aad); err == nil {
+		t.Error("rewrapped without the key it was wrapped with")
+	}
+}
+
+func TestLoadKeyring(t *testing.T) {
+	current, previous := newKey(t), newKey(t)
+	enc := base64.StdEncoding.EncodeToString
+
+	t.Run("none", func(t *testing.T) {
+		t.Setenv("SECRETS_KEY_FILE", "")
+		t.Setenv("SECRETS_KEY", "")
+		if k, err := LoadKeyring(); k != nil || err != nil {
+			t.Errorf("LoadKeyring() = %v, %v", k, err)
+		}
+	})
+
+	t.Run("env", func(t *testing.T) {
+		t.Setenv("SECRETS_KEY_FILE", "")
+		t.Setenv("SECRETS_KEY", enc(current))
+		t.Setenv("SECRETS_PREVIOUS_KEYS", " "+enc(previous)+", ")
+		k, err := LoadKeyring()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if k.CurrentID() != keyID(current) || len(k.keys) != 2 {
+			t.Errorf("keyring %s with %d keys", k.CurrentID(), len(k.keys))
+		}
+	})
+
+	t.Run("file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "keys")
+		content := "# rotated\n" + enc(current) + "\n\n" + enc(previous) + "\n"
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		t.Setenv("SECRETS_KEY_FILE", path)
+		t.Setenv("SECRETS_KEY", enc(previous)) // the file wins
+		k, err := LoadKeyring()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if k.CurrentID() != keyID(current) || len(k.keys) != 2 {
+			t.Errorf("keyring %s with %d keys", k.CurrentID(), len(k.keys))
+		}
+	})
+
+	t.Run("bad key", func(t *testing.T) {
+		t.Setenv("SECRETS_KEY_FILE", "")
+		t.Setenv("SECRETS_KEY", enc(current[:16]))
+		if _, err := LoadKeyring(); err == nil {
+			t.Error("accepted a 16 byte key")
+		}
+	})
+}
@@ -0,0 +1,138 @@
+package secrets
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+const redacted = "[redacted]"
+
+// SensitiveName tells the header, query parameter and body field names that
+// carry credentials.
+func SensitiveName(name string) bool {
+	name = strings.ToLower(name)
+	switch name {
+	case "authorization", "proxy-authorization", "cookie":
+		return true
+	}
+	for _, word := range []string{"token", "secret", "password", "passwd", "api-key", "api_key", "apikey"} {
+		if strings.Contains(name, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// literal: a value given as it is, not a {{secret:<id>}} or a script variable
+func literal(v string) bool {
+	return v != "" && !strings.Contains(v, "{{")
+}
+
+// RedactURL replaces the literal credentials in raw, the userinfo password
+// and the sensitive query parameters; references are kept. found tells
+// whether there were any. Works on the text, so references that url.Parse
+// would reject are fine.
+func RedactURL(raw string) (out string, found bool) {
+	scheme, rest, ok := strings.Cut(raw, "://")
+	if !ok {
+		scheme, rest = "", raw
+	}
+	authority, path := rest, ""
+	if i := strings.IndexAny(rest, "/?#"); i >= 0 {
+		authority, path = rest[:i], rest[i:]
+	}
+	if at := strings.LastIndex(authority, "@"); at >= 0 {
+		if user, password, ok := strings.Cut(authority[:at], ":"); ok && literal(password) {
+			authority = user + ":" + redacted + authority[at:]
+			found = true
+		}
+	}
+	if i := strings.Index(path, "?"); i >= 0 {
+		query, fragment := path[i+1:], ""
+		if j := strings.Index(query, "#"); j >= 0 {
+			query, fragment = query[:j], query[j:]
+		}
+		query, inQuery := redactParams(query)
+		path = path[:i+1] + query + fragment
+		found = found || inQuery
+	}
+	if scheme != "" {
+		scheme += "://"
+	}
+	return scheme + authority + path, found
+}
+
+// RedactBody replaces the literal values of sensitive fields in a JSON or
+// form encoded body. Bodies without any come back as they are.
+func RedactBody(body string) (string, bool) {
+	trimmed := strings.TrimSpace(body)
+	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		var v interface{}
+		if err := json.Unmarshal([]byte(trimmed), &v); err != nil {
+			return body, false
+		}
+		if !redactJSON(v) {
+			return body, false
+		}
+		out, err := json.Marshal(v)
+		if err != nil {
+			return body, false
+		}
+		return string(out), true
+	}
+	if strings.ContainsAny(trimmed, " \n\t<") {
+		return body, false // not a form
+	}
+	return redactParams(body)
+}
+
+func redactJSON(v interface{}) bool {
+	found := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, field := range v {
+			switch field := field.(type) {
+			case map[string]interface{}, []interface{}:
+				found = redactJSON(field) || found
+			case string:
+				if SensitiveName(k) && literal(field) {
+					v[k] = redacted
+					found = true
+				}
+			case nil:
+			default:
+				if SensitiveName(k) {
+					v[k] = redacted
+					found = true
+				}
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			found = redactJSON(item) || found
+		}
+	}
+	return found
+}
+
+// redactParams redacts a=b&c=d pairs, keeping their order and encoding.
+func redactParams(s string) (string, bool) {
+	found := false
+	params := strings.Split(s, "&")
+	for i, param := range params {
+		key, value, ok := strings.Cut(param, "=")
+		if !ok {
+			continue
+		}
+		name, err := url.QueryUnescape(key)
+		if err != nil {
+			name = key
+		}
+		if SensitiveName(name) && literal(value) {
+			params[i] = key + "=" + redacted
+			found = true
+		}
+	}
+	return strings.Join(params, "&"), found
+}
@@ -0,0 +1,251 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/barkinrl/pulsar/internal/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	// ErrNoKey: no master key configured, secrets can't be sealed or opened
+	ErrNoKey    = errors.New("secrets için SECRETS_KEY ya da SECRETS_KEY_FILE gerekli")
+	ErrNotFound = errors.New("secret bulunamadı")
+	// ErrUnreadable: the secret can't be opened with the keys at hand
+	ErrUnreadable = errors.New("secret çözülemedi")
+)
+
+// refPattern, a reference to a secret in monitor configs and credentials
+var refPattern = regexp.MustCompile(`\{\{\s*secret:([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})\s*\}\}`)
+
+// Ref, how configs refer to the secret
+func Ref(id pgtype.UUID) string {
+	return "{{secret:" + pgUUIDToString(id) + "}}"
+}
+
+// IsRef tells whether s is nothing but a reference.
+func IsRef(s string) bool {
+	loc := refPattern.FindStringIndex(s)
+	return loc != nil && loc[0] == 0 && loc[1] == len(s)
+}
+
+// HasRefs tells whether s refers to any secret.
+func HasRefs(s string) bool {
+	return refPattern.MatchString(s)
+}
+
+// Refs lists the secrets s refers to.
+func Refs(s string) []pgtype.UUID {
+	var ids []pgtype.UUID
+	for _, m := range refPattern.FindAllStringSubmatch(s, -1) {
+		var id pgtype.UUID
+		if id.Scan(m[1]) == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// MaskRefs replaces the references in s with mask.
+func MaskRefs(s, mask string) string {
+	return refPattern.ReplaceAllLiteralString(s, mask)
+}
+
+// Store keeps secrets envelope encrypted in the secrets table. Without a
+// keyring it still works for text without references.
+type Store struct {
+	queries *db.Queries
+	keys    *Keyring
+}
+
+func NewStore(queries *db.Queries, keys *Keyring) *Store {
+	return &Store{
+		queries: queries,
+		keys:    keys,
+	}
+}
+
+func (s *Store) Enabled() bool {
+	return s.keys != nil
+}
+
+// Create seals value as a new secret; owner is the monitor it belongs to,
+// if any.
+func (s *Store) Create(ctx context.Context, name, value string, owner pgtype.UUID) (db.Secret, error) {
+	if s.keys == nil {
+		return db.Secret{}, ErrNoKey
+	}
+	var id pgtype.UUID
+	id.Scan(uuid.New().String())
+	sealed, err := s.keys.seal([]byte(value), id.Bytes[:])
+	if err != nil {
+		return db.Secret{}, err
+	}
+	return s.queries.CreateSecret(ctx, db.CreateSecretParams{
+		ID:         id,
+		Name:       name,
+		MonitorID:  owner,
+		Ciphertext: sealed.Ciphertext,
+		WrappedKey: sealed.WrappedKey,
+		KeyID:      sealed.KeyID,
+	})
+}
+
+// Update replaces the value, under a new data key.
+func (s *Store) Update(ctx context.Context, id pgtype.UUID, value string) (db.Secret, error) {
+	if s.keys == nil {
+		return db.Secret{}, ErrNoKey
+	}
+	sealed, err := s.keys.seal([]byte(value), id.Bytes[:])
+	if err != nil {
+		return db.Secret{}, err
+	}
+	secret, err := s.queries.UpdateSecret(ctx, db.UpdateSecretParams{
+		ID:         id,
+		Ciphertext: sealed.Ciphertext,
+		WrappedKey: sealed.WrappedKey,
+		KeyID:      sealed.KeyID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return secret, ErrNotFound
+	}
+	return secret, err
+}
+
+// Put creates or updates the secret called name.
+func (s *Store) Put(ctx context.Context, name, value string, owner pgtype.UUID) (db.Secret, error) {
+	existing, err := s.queries.GetSecretByName(ctx, name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return s.Create(ctx, name, value, owner)
+	}
+	if err != nil {
+		return existing, err
+	}
+	return s.Update(ctx, existing.ID, value)
+}
+
+// Value opens the secret.
+func (s *Store) Value(ctx context.Context, id pgtype.UUID) (string, error) {
+	if s.keys == nil {
+		return "", ErrNoKey
+	}
+	secret, err := s.queries.GetSecret(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", fmt.Errorf("%w: %s", ErrNotFound, pgUUIDToString(id))
+	}
+	if err != nil {
+		return "", err
+	}
+	value, err := s.keys.open(sealed{
+		Ciphertext: secret.Ciphertext,
+		WrappedKey: secret.WrappedKey,
+		KeyID:      secret.KeyID,
+	}, id.Bytes[:])
+	if err != nil {
+		return "", fmt.Errorf("%w: %s: %v", ErrUnreadable, secret.Name, err)
+	}
+	return string(value), nil
+}
+
+// Resolver replaces references with the secret values for one check. It
+// remembers the values it handed out, to be redacted from what the check
+// reports.
+type Resolver struct {
+	store  *Store
+	values map[string]string // by id
+}
+
+func (s *Store) Resolver() *Resolver {
+	return &Resolver{store: s, values: map[string]string{}}
+}
+
+// Expand replaces the references in text.
+func (r *Resolver) Expand(ctx context.Context, text string) (string, error) {
+	if !HasRefs(text) {
+		return text, nil
+	}
+	var firstErr error
+	out := refPattern.ReplaceAllStringFunc(text, func(m string) string {
+		ref := strings.ToLower(refPattern.FindStringSubmatch(m)[1])
+		if v, ok := r.values[ref]; ok {
+			return v
+		}
+		var id pgtype.UUID
+		id.Scan(ref)
+		v, err := r.store.Value(ctx, id)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			return ""
+		}
+		r.values[ref] = v
+		return v
+	})
+	if firstErr != nil {
+		return "", firstErr
+	}
+	return out, nil
+}
+
+// Redact cuts the values handed out so far from s.
+func (r *Resolver) Redact(s string) string {
+	for _, v := range r.values {
+		if v != "" {
+			s = strings.ReplaceAll(s, v, "[secret]")
+		}
+	}
+	return s
+}
+
+// Rewrap moves the secrets sealed under a previous master key to the
+// current one; called at startup, so a rotation is: new key in front, the
+// old one in SECRETS_PREVIOUS_KEYS, restart, drop the old key once every
+// secret is moved.
+func (s *Store) Rewrap(ctx context.Context) (int, error) {
+	if s.keys == nil {
+		return 0, nil
+	}
+	stale, err := s.queries.ListSecretsToRewrap(ctx, s.keys.CurrentID())
+	if err != nil {
+		return 0, err
+	}
+	moved := 0
+	for _, secret := range stale {
+		next, err := s.keys.rewrap(sealed{
+			Ciphertext: secret.Ciphertext,
+			WrappedKey: secret.WrappedKey,
+			KeyID:      secret.KeyID,
+		}, secret.ID.Bytes[:])
+		if err != nil {
+			log.Printf("⚠️ Secret %s yeni anahtara taşınamadı: %v", secret.Name, err)
+			continue
+		}
+		err = s.queries.RewrapSecret(ctx, db.RewrapSecretParams{
+			ID:         secret.ID,
+			WrappedKey: next.WrappedKey,
+			KeyID:      next.KeyID,
+			OldKeyID:   secret.KeyID,
+		})
+		if err != nil {
+			return moved, err
+		}
+		moved++
+	}
+	return moved, nil
+}
+
+func pgUUIDToString(uuid pgtype.UUID) string {
+	if !uuid.Valid {
+		return ""
+	}
+	src := uuid.Bytes
+	return fmt.Sprintf("%x-%x-%x-%x-%x", src[0:4], src[4:6], src[6:8], src[8:10], src[10:16])
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	pulsarv1 "github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1"
+
+	"github.com/barkinrl/pulsar/internal/db"
+	"github.com/barkinrl/pulsar/internal/hoststats"
+	"github.com/barkinrl/pulsar/internal/systemstats"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var agentHostPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// RegisterAgent creates an agent for host and returns its token. Only the
+// token's hash is stored, so it can't be shown again.
+func (s *MonitorServer) RegisterAgent(
+	ctx context.Context,
+	req *connect.Request[pulsarv1.RegisterAgentRequest],
+) (*connect.Response[pulsarv1.RegisterAgentResponse], error) {
+	host := req.Msg.Host
+	if !agentHostPattern.MatchString(host) || host == systemstats.LocalHost {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("geçersiz host adı: %q", host))
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	token := hex.EncodeToString(secret)
+
+	agent, err := s.queries.CreateAgent(ctx, db.CreateAgentParams{
+		Host:      host,
+		TokenHash: hashToken(token),
+	})
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("%s için zaten bir agent var", host))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pulsarv1.RegisterAgentResponse{
+		Agent: agentProto(agent),
+		Token: token,
+	}), nil
+}
+
+// ListAgents...
+func (s *MonitorServer) ListAgents(
+	ctx context.Context,
+	req *connect.Request[pulsarv1.ListAgentsRequest],
+) (*connect.Response[pulsarv1.ListAgentsResponse], error) {
+	agents, err := s.queries.ListAgents(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	var protoAgents []*pulsarv1.Agent
+	for _, a := range agents {
+		protoAgents = append(protoAgents, agentProto(a))
+	}
+	return connect.NewResponse(&pulsarv1.ListAgentsResponse{
+		Agents: protoAgents,
+	}), nil
+}
+
+// DeleteAgent revokes the agent's token; its history stays.
+func (s *MonitorServer) DeleteAgent(
+	ctx context.Context,
+	req *connect.Request[pulsarv1.DeleteAgentRequest],
+) (*connect.Response[pulsarv1.DeleteAgentResponse], error) {
+	var agentID pgtype.UUID
+	if err := agentID.Scan(req.Msg.AgentId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("geçersiz ID formatı"))
+	}
+	if err := s.queries.DeleteAgent(ctx, agentID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pulsarv1.DeleteAgentResponse{
+		Success: true,
+	}), nil
+}
+
+// IngestSystemStats records a sample pushed by an agent, under the host its
+// token was registered for.
+func (s *MonitorServer) IngestSystemStats(
+	ctx context.Context,
+	req *connect.Request[pulsarv1.IngestSystemStatsRequest],
+) (*connect.Response[pulsarv1.IngestSystemStatsResponse], error) {
+	token, ok := strings.CutPrefix(req.Header().Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("agent token gerekli"))
+	}
+	agent, err := s.queries.GetAgentByTokenHash(ctx, hashToken(token))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("geçersiz agent token"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if req.Msg.Sample == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("sample gerekli"))
+	}
+
+	sample := hoststats.FromRaw(req.Msg.Sample)
+	sample.Limit()
+	if err := s.recorder.Record(ctx, agent.Host, sample); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err := s.queries.TouchAgent(ctx, agent.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pulsarv1.IngestSystemStatsResponse{}), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func agentProto(a db.Agent) *pulsarv1.Agent {
+	agent := &pulsarv1.Agent{
+		Id:   pgUUIDToString(a.ID),
+		Host: a.Host,
+	}
+	if a.LastSeenAt.Valid {
+		agent.LastSeen = a.LastSeenAt.Time.Format(time.RFC3339)
+	}
+	return agent
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	pulsarv1 "github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1"
+
+	"github.com/barkinrl/pulsar/internal/alerts"
+	"github.com/barkinrl/pulsar/internal/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ListAlertRules...
+func (s *MonitorServer) ListAlertRules(
+	ctx context.Context,
+	req *connect.Request[pulsarv1.ListAlertRulesRequest],
+) (*connect.Response[pulsarv1.ListAlertRulesResponse], error) {
+	rules, err := s.queries.ListAlertRules(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	var protoRules []*pulsarv1.AlertRule
+	for _, r := range rules {
+		protoRules = append(protoRules, alertRuleProto(r))
+	}
+	return connect.NewResponse(&pulsarv1.ListAlertRulesResponse{
+		Rules: protoRules,
+	}), nil
+}
+
+// CreateAlertRule...
+func (s *MonitorServer) CreateAlertRule(
+	ctx context.Context,
+	req *connect.Request[pulsarv1.CreateAlertRuleRequest],
+) (*connect.Response[pulsarv1.CreateAlertRuleResponse], error) {
+	rule := req.Msg.GetRule()
+	if err := validateAlertRule(rule); err != nil {
+		return nil, err
+	}
+	created, err := s.queries.CreateAlertRule(ctx, db.CreateAlertRuleParams{
+		Name:            rule.Name,
+		Metric:          rule.Metric,
+		Comparison:      rule.Comparison,
+		Threshold:       rule.Threshold,
+		DurationSeconds: rule.DurationSeconds,
+		IsActive:        rule.IsActive,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pulsarv1.CreateAlertRuleResponse{
+		Rule: alertRuleProto(created),
+	}), nil
+}
+
+// UpdateAlertRule...
+func (s *MonitorServer) UpdateAlertRule(
+	ctx context.Context,
+	req *connect.Request[pulsarv1.UpdateAlertRuleRequest],
+) (*connect.Response[pulsarv1.UpdateAlertRuleResponse], error) {
+	rule := req.Msg.GetRule()
+	if err := validateAlertRule(rule); err != nil {
+		return nil, err
+	}
+	var ruleID pgtype.UUID
+	if err := ruleID.Scan(rule.Id); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("geçersiz ID formatı"))
+	}
+	updated, err := s.queries.UpdateAlertRule(ctx, db.UpdateAlertRuleParams{
+		ID:              ruleID,
+		Name:            rule.Name,
+		Metric:          rule.Metric,
+		Comparison:      rule.Comparison,
+		Threshold:       rule.Threshold,
+		DurationSeconds: rule.DurationSeconds,
+		IsActive:        rule.IsActive,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("kural bulunamadı"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pulsarv1.UpdateAlertRuleResponse{
+		Rule: alertRuleProto(updated),
+	}), nil
+}
+
+// DeleteAlertRule...
+func (s *MonitorServer) DeleteAlertRule(
+	ctx context.Context,
+	req *connect.Request[pulsarv1.DeleteAlertRuleRequest],
+) (*connect.Response[pulsarv1.DeleteAlertRuleResponse], error) {
+	var ruleID pgtype.UUID
+	if err := ruleID.Scan(req.Msg.RuleId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("geçersiz ID formatı"))
+	}
+	if err := s.queries.DeleteAlertRule(ctx, ruleID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pulsarv1.DeleteAlertRuleResponse{
+		Success: true,
+	}), nil
+}
+
+// ListIncidents returns the last 100 incidents, newest first.
+func (s *MonitorServer) ListIncidents(
+	ctx context.Context,
+	req *connect.Request[pulsarv1.ListIncidentsRequest],
+) (*connect.Response[pulsarv1.ListIncidentsResponse], error) {
+	rows, err := s.queries.ListIncidents(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	var incidents []*pulsarv1.Incident
+	for _, r := range rows {
+		inc := &pulsarv1.Incident{
+			Id:         pgUUIDToString(r.ID),
+			RuleId:     pgUUIDToString(r.RuleID),
+			RuleName:   r.RuleName,
+			Metric:     r.Metric,
+			Comparison: r.Comparison,
+			Threshold:  r.Threshold,
+			Value:      r.Value,
+			StartedAt:  r.StartedAt.Time.Format(time.RFC3339),
+		}
+		if r.ResolvedAt.Valid {
+			inc.ResolvedAt = r.ResolvedAt.Time.Format(time.RFC3339)
+		}
+		incidents = append(incidents, inc)
+	}
+	return connect.NewResponse(&pulsarv1.ListIncidentsResponse{
+		Incidents: incidents,
+	}), nil
+}
+
+func validateAlertRule(rule *pulsarv1.AlertRule) error {
+	if rule == nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("rule gerekli"))
+	}
+	if rule.Name == "" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("rule name gerekli"))
+	}
+	if rule.DurationSeconds < 0 {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("duration_seconds negatif olamaz"))
+	}
+	if err := alerts.Validate(rule.Metric, rule.Comparison); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return nil
+}
+
+func alertRuleProto(r db.AlertRule) *pulsarv1.AlertRule {
+	return &pulsarv1.AlertRule{
+		Id:              pgUUIDToString(r.ID),
+		Name:            r.Name,
+		Metric:          r.Metric,
+		Comparison:      r.Comparison,
+		Threshold:       r.Threshold,
+		DurationSeconds: r.DurationSeconds,
+		IsActive:        r.IsActive,
+	}
+}
@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"math"
 	"os"
-	"runtime"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"connectrpc.com/connect"
@@ -14,25 +16,43 @@ import (
 	"github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1/v1connect"
 
 	"github.com/barkinrl/pulsar/internal/db"
+	"github.com/barkinrl/pulsar/internal/events"
+	"github.com/barkinrl/pulsar/internal/metrics"
+	"github.com/barkinrl/pulsar/internal/secrets"
+	"github.com/barkinrl/pulsar/internal/systemstats"
+	"github.com/barkinrl/pulsar/internal/worker"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/shirou/gopsutil/v3/cpu"
-	"github.com/shirou/gopsutil/v3/disk"
-	"github.com/shirou/gopsutil/v3/host"
-	"github.com/shirou/gopsutil/v3/mem"
-	"github.com/shirou/gopsutil/v3/net"
-	"github.com/shirou/gopsutil/v3/process"
-	"google.golang.org/protobuf/types/known/emptypb"
+	"github.com/redis/go-redis/v9"
 )
 
 // MonitorServer, Protobuf implementation for Monitor Service
 type MonitorServer struct {
-	queries *db.Queries
+	queries   *db.Queries
+	rdb       *redis.Client
+	recorder  *systemstats.Recorder // samples pushed by agents
+	secrets   *secrets.Store
+	publicURL string // prefix of push monitor URLs, PUBLIC_URL
+	done      chan struct{}
+	closeOnce sync.Once
 	v1connect.UnimplementedMonitorServiceHandler
 }
 
 // NewMonitorServer...
-func NewMonitorServer(queries *db.Queries) *MonitorServer {
-	return &MonitorServer{queries: queries}
+func NewMonitorServer(queries *db.Queries, rdb *redis.Client, store *secrets.Store) *MonitorServer {
+	return &MonitorServer{
+		queries:   queries,
+		rdb:       rdb,
+		secrets:   store,
+		recorder:  systemstats.NewRecorder(queries, rdb),
+		publicURL: strings.TrimSuffix(os.Getenv("PUBLIC_URL"), "/"),
+		done:      make(chan struct{}),
+	}
+}
+
+// Close ends the open streams so the HTTP server can drain.
+func (s *MonitorServer) Close() {
+	s.closeOnce.Do(func() { close(s.done) })
 }
 
 // CreateMonitor... 
@@ -40,21 +60,47 @@ func (s *MonitorServer) CreateMonitor(
 	ctx context.Context,
 	req *connect.Request[pulsarv1.CreateMonitorRequest],
 ) (*connect.Response[pulsarv1.CreateMonitorResponse], error) {
+	monitorType, config, err := monitorConfig(req.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if _, err := s.secrets.Resolver().Expand(ctx, req.Msg.Url+string(config)); err != nil {
+		return nil, secretError(err)
+	}
+	creds, err := probeCredentials(monitorType, req.Msg.Credentials)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := s.checkCredentials(ctx, creds); err != nil {
+		return nil, err
+	}
+	var pushToken pgtype.Text
+	if monitorType == worker.MonitorTypePush {
+		token, err := newPushToken()
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		pushToken = pgtype.Text{String: token, Valid: true}
+	}
 	createdMonitor, err := s.queries.CreateMonitor(ctx, db.CreateMonitorParams{
 		Url:             req.Msg.Url,
 		IntervalSeconds: req.Msg.IntervalSeconds,
+		Type:            monitorType,
+		Config:          config,
+		PushToken:       pushToken,
 	})
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	responseMonitor := &pulsarv1.Monitor{
-		Id:              pgUUIDToString(createdMonitor.ID),
-		Url:             createdMonitor.Url,
-		IntervalSeconds: createdMonitor.IntervalSeconds,
-		IsActive:        createdMonitor.IsActive,
+	if !creds.IsZero() {
+		if creds, err = s.setCredentials(ctx, createdMonitor.ID, creds); err != nil {
+			// no half-configured monitor left behind
+			s.queries.DeleteMonitor(ctx, createdMonitor.ID)
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
 	}
 	return connect.NewResponse(&pulsarv1.CreateMonitorResponse{
-		Monitor: responseMonitor,
+		Monitor: s.monitorProto(createdMonitor, creds),
 	}), nil
 }
 
@@ -67,14 +113,17 @@ func (s *MonitorServer) ListMonitors(
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	rows, err := s.queries.ListMonitorCredentials(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	creds := make(map[pgtype.UUID]worker.ProbeCredentials, len(rows))
+	for _, row := range rows {
+		creds[row.MonitorID] = worker.CredentialsFromDB(row)
+	}
 	var protoMonitors []*pulsarv1.Monitor
 	for _, m := range monitors {
-		protoMonitors = append(protoMonitors, &pulsarv1.Monitor{
-			Id:              pgUUIDToString(m.ID),
-			Url:             m.Url,
-			IntervalSeconds: m.IntervalSeconds,
-			IsActive:        m.IsActive,
-		})
+		protoMonitors = append(protoMonitors, s.monitorProto(m, creds[m.ID]))
 	}
 	return connect.NewResponse(&pulsarv1.ListMonitorsResponse{
 		Monitors: protoMonitors,
@@ -94,9 +143,56 @@ func (s *MonitorServer) GetMonitorStats(
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	// script steps and redirect chains of all results in one go
+	resultIDs := make([]pgtype.UUID, 0, len(results))
+	for _, r := range results {
+		resultIDs = append(resultIDs, r.ID)
+	}
+	steps := map[pgtype.UUID][]*pulsarv1.StepResult{}
+	rows, err := s.queries.GetMonitorResultSteps(ctx, resultIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	for _, st := range rows {
+		steps[st.ResultID] = append(steps[st.ResultID], &pulsarv1.StepResult{
+			Name:    st.Name,
+			Method:  st.Method,
+			Url:     st.Url,
+			Code:    st.StatusCode,
+			Latency: st.Latency,
+			Timing: &pulsarv1.MonitorTiming{
+				Dns:      st.TimingDns,
+				Tcp:      st.TimingTcp,
+				Tls:      st.TimingTls,
+				Ttfb:     st.TimingTtfb,
+				Download: st.TimingDownload,
+			},
+			Error: st.Error,
+		})
+	}
+
+	redirects := map[pgtype.UUID][]*pulsarv1.RedirectHop{}
+	hops, err := s.queries.GetMonitorResultRedirects(ctx, resultIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	for _, h := range hops {
+		redirects[h.ResultID] = append(redirects[h.ResultID], &pulsarv1.RedirectHop{
+			Url:     h.Url,
+			Code:    h.StatusCode,
+			Latency: h.Latency,
+			Timing: &pulsarv1.MonitorTiming{
+				Dns:  h.TimingDns,
+				Tcp:  h.TimingTcp,
+				Tls:  h.TimingTls,
+				Ttfb: h.TimingTtfb,
+			},
+		})
+	}
+
 	var stats []*pulsarv1.MonitorStat
 	for _, r := range results {
-		stats = append(stats, &pulsarv1.MonitorStat{
+		stat := &pulsarv1.MonitorStat{
 			Latency: r.Latency,
 			Code:    r.StatusCode,
 			Status:  r.Status,
@@ -108,7 +204,19 @@ func (s *MonitorServer) GetMonitorStats(
 				Ttfb:     r.TimingTtfb,
 				Download: r.TimingDownload,
 			},
-		})
+		}
+		if r.PacketLoss.Valid {
+			stat.Icmp = &pulsarv1.IcmpStats{
+				RttMin:     r.RttMin.Float64,
+				RttAvg:     r.RttAvg.Float64,
+				RttMax:     r.RttMax.Float64,
+				Jitter:     r.Jitter.Float64,
+				PacketLoss: r.PacketLoss.Float64,
+			}
+		}
+		stat.Steps = steps[r.ID]
+		stat.Redirects = redirects[r.ID]
+		stats = append(stats, stat)
 	}
 	return connect.NewResponse(&pulsarv1.GetMonitorStatsResponse{
 		Stats: stats,
@@ -128,21 +236,59 @@ func (s *MonitorServer) DeleteMonitor(
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	// series of this process; the others prune theirs (ResultRecorder.PruneMetrics)
+	metrics.ForgetMonitor(pgUUIDToString(monitorID))
 	return connect.NewResponse(&pulsarv1.DeleteMonitorResponse{
 		Success: true,
 	}), nil
 }
 
-// GetSystemStats... 
+// SetMonitorCredentials... replaces the proxy & client certificate of a
+// monitor; the worker picks them up at the next check.
+func (s *MonitorServer) SetMonitorCredentials(
+	ctx context.Context,
+	req *connect.Request[pulsarv1.SetMonitorCredentialsRequest],
+) (*connect.Response[pulsarv1.SetMonitorCredentialsResponse], error) {
+	var monitorID pgtype.UUID
+	if err := monitorID.Scan(req.Msg.MonitorId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("geçersiz ID formatı"))
+	}
+	m, err := s.queries.GetMonitor(ctx, monitorID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("monitör bulunamadı"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	creds, err := probeCredentials(m.Type, req.Msg.Credentials)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := s.checkCredentials(ctx, creds); err != nil {
+		return nil, err
+	}
+	if creds, err = s.setCredentials(ctx, m.ID, creds); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pulsarv1.SetMonitorCredentialsResponse{
+		Monitor: s.monitorProto(m, creds),
+	}), nil
+}
+
+// GetSystemStats... history first, then the samples published for the host
+// (by the worker's collector or the host's agent; nothing is sampled here).
 func (s *MonitorServer) GetSystemStats(
 	ctx context.Context,
-	req *connect.Request[emptypb.Empty],
+	req *connect.Request[pulsarv1.GetSystemStatsRequest],
 	stream *connect.ServerStream[pulsarv1.SystemStatsResponse],
 ) error {
-	hostInfo, _ := host.Info()
+	host := req.Msg.Host
+	if host == "" {
+		host = systemstats.LocalHost
+	}
 
 	// --- 1. FETCH HISTORY AND SEND ---
-	history, err := s.queries.GetSystemStatHistory(ctx)
+	history, err := s.queries.GetSystemStatHistory(ctx, host)
 	if err == nil && len(history) > 0 {
 		var cpuHist, memHist, diskHist, netHist []float64
 		var threadHist []*pulsarv1.ThreadHistory
@@ -168,130 +314,262 @@ func (s *MonitorServer) GetSystemStats(
 			Disk:    &pulsarv1.ResourceUsage{History: diskHist},
 			Network: &pulsarv1.ResourceUsage{History: netHist},
 			Threads: &pulsarv1.ThreadUsage{History: threadHist},
-			Info: &pulsarv1.SystemInfo{
-				Hostname:        hostInfo.Hostname,
-				Os:              hostInfo.OS,
-				Platform:        hostInfo.Platform,
-				PlatformVersion: hostInfo.PlatformVersion,
-				UptimeSeconds:   hostInfo.Uptime,
-			},
+			Host:    host,
+		}
+
+		// per-mountpoint history, over the same window
+		disks, err := s.queries.GetDiskStatHistory(ctx, db.GetDiskStatHistoryParams{
+			Host:      host,
+			CreatedAt: history[len(history)-1].CreatedAt,
+		})
+		if err == nil {
+			for _, d := range disks {
+				n := len(initialResp.Disks)
+				if n == 0 || initialResp.Disks[n-1].Mountpoint != d.Mountpoint {
+					initialResp.Disks = append(initialResp.Disks, &pulsarv1.DiskUsage{Mountpoint: d.Mountpoint})
+					n++
+				}
+				initialResp.Disks[n-1].History = append(initialResp.Disks[n-1].History, d.UsedPercent)
+			}
+		}
+
+		if err := stream.Send(initialResp); err != nil {
+			return err
 		}
-		stream.Send(initialResp)
 	}
 
 	// --- 2. LIVE STREAM ---
-	ticker := time.NewTicker(15 * time.Second)
-	defer ticker.Stop()
+	ctx, cancel := s.untilClosed(ctx)
+	defer cancel()
 
-	var prevNetStat *net.IOCountersStat
-
-	for {
-		select {
-		case <-ctx.Done():
+	err = events.Follow(ctx, s.rdb, func() error { return stream.Send(nil) }, func(ev *pulsarv1.Event) error {
+		stats := ev.GetSystem()
+		if stats == nil {
 			return nil
-		case <-ticker.C:
-			// --- SOURCES ---
-			cpuPercents, err := cpu.Percent(0, false)
-			cpuUsage := 0.0
-			if err == nil && len(cpuPercents) > 0 {
-				cpuUsage = cpuPercents[0]
-			}
+		}
+		// events from before hosts existed are all local
+		if stats.Host == host || (stats.Host == "" && host == systemstats.LocalHost) {
+			return stream.Send(stats)
+		}
+		return nil
+	})
+	if err != nil && ctx.Err() == nil {
+		return connect.NewError(connect.CodeUnavailable, err)
+	}
+	return nil
+}
 
-			memStat, _ := mem.VirtualMemory()
+const (
+	defaultHistoryPoints = 300
+	maxHistoryPoints     = 2000
+)
 
-			diskPath := os.Getenv("ROOT_FS")
-			if diskPath == "" {
-				diskPath = "/"
-			}
-			diskStat, _ := disk.Usage(diskPath)
-
-			// --- NETWORK ---
-			netStats, _ := net.IOCounters(false)
-			netSpeed := 0.0
-			if len(netStats) > 0 {
-				currentNet := netStats[0]
-				if prevNetStat != nil {
-					diff := (currentNet.BytesRecv + currentNet.BytesSent) - (prevNetStat.BytesRecv + prevNetStat.BytesSent)
-					netSpeed = float64(diff) / 15.0 / 1024.0 // KB/s
-				}
-				prevNetStat = &currentNet
-			}
+// GetSystemStatsHistory returns a host's stats over any range, cut into
+// about `points` buckets with min/avg/max each (computed by Postgres).
+func (s *MonitorServer) GetSystemStatsHistory(
+	ctx context.Context,
+	req *connect.Request[pulsarv1.GetSystemStatsHistoryRequest],
+) (*connect.Response[pulsarv1.GetSystemStatsHistoryResponse], error) {
+	to := time.Now()
+	if req.Msg.To > 0 {
+		to = time.Unix(req.Msg.To, 0)
+	}
+	from := to.Add(-24 * time.Hour)
+	if req.Msg.From > 0 {
+		from = time.Unix(req.Msg.From, 0)
+	}
+	if !from.Before(to) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("from, to'dan önce olmalı"))
+	}
+	points := int64(req.Msg.Points)
+	if points <= 0 {
+		points = defaultHistoryPoints
+	}
+	if points > maxHistoryPoints {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("en fazla %d nokta istenebilir", maxHistoryPoints))
+	}
+	host := req.Msg.Host
+	if host == "" {
+		host = systemstats.LocalHost
+	}
 
-			// --- THREAD ANALYZE ---
-			tTotal, tRun, tSleep, tZombie := getServiceProcessStates()
-			const THREAD_ALARM_THRESHOLD = 3000
-
-			response := &pulsarv1.SystemStatsResponse{
-				Cpu: &pulsarv1.ResourceUsage{
-					Used:      toFixed(cpuUsage, 1),
-					Total:     100,
-					Percent:   toFixed(cpuUsage, 1),
-					Unit:      "%",
-					IsWarning: cpuUsage > 80,
-				},
-				Memory: &pulsarv1.ResourceUsage{
-					Used:      bytesToGB(memStat.Used),
-					Total:     bytesToGB(memStat.Total),
-					Percent:   toFixed(memStat.UsedPercent, 1),
-					Unit:      "GB",
-					IsWarning: memStat.UsedPercent > 90,
-				},
-				Disk: &pulsarv1.ResourceUsage{
-					Used:      bytesToGB(diskStat.Used),
-					Total:     bytesToGB(diskStat.Total),
-					Percent:   toFixed(diskStat.UsedPercent, 1),
-					Unit:      "GB",
-					IsWarning: diskStat.UsedPercent > 95,
-				},
-				Network: &pulsarv1.ResourceUsage{
-					Used:      toFixed(netSpeed, 1),
-					Total:     0,
-					Percent:   0,
-					Unit:      "KB/s",
-					IsWarning: false,
-				},
-				Threads: &pulsarv1.ThreadUsage{
-					Total:     tTotal,
-					Running:   tRun,
-					Sleeping:  tSleep,
-					Zombie:    tZombie,
-					IsWarning: tTotal > THREAD_ALARM_THRESHOLD,
-				},
-			}
+	// whole seconds, rounded up so there are never more than points buckets
+	rangeSeconds := int64(to.Sub(from).Seconds())
+	bucketSeconds := max(1, (rangeSeconds+points-1)/points)
 
-			if err := stream.Send(response); err != nil {
-				return err
-			}
+	rows, err := s.queries.GetSystemStatBuckets(ctx, db.GetSystemStatBucketsParams{
+		Bucket:  pgtype.Interval{Microseconds: bucketSeconds * int64(time.Second/time.Microsecond), Valid: true},
+		StartAt: pgtype.Timestamptz{Time: from, Valid: true},
+		Host:    host,
+		EndAt:   pgtype.Timestamptz{Time: to, Valid: true},
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &pulsarv1.GetSystemStatsHistoryResponse{
+		BucketSeconds: bucketSeconds,
+		Cpu:           &pulsarv1.StatSeries{},
+		Memory:        &pulsarv1.StatSeries{},
+		Disk:          &pulsarv1.StatSeries{},
+		Network:       &pulsarv1.StatSeries{},
+		Threads:       &pulsarv1.StatSeries{},
+		Load1:         &pulsarv1.StatSeries{},
+	}
+	add := func(series *pulsarv1.StatSeries, lo, avg, hi float64) {
+		series.Min = append(series.Min, lo)
+		series.Avg = append(series.Avg, avg)
+		series.Max = append(series.Max, hi)
+	}
+	for _, r := range rows {
+		resp.Times = append(resp.Times, r.BucketStart.Time.Format(time.RFC3339))
+		add(resp.Cpu, r.CpuMin, r.CpuAvg, r.CpuMax)
+		add(resp.Memory, r.MemoryMin, r.MemoryAvg, r.MemoryMax)
+		add(resp.Disk, r.DiskMin, r.DiskAvg, r.DiskMax)
+		add(resp.Network, r.NetMin, r.NetAvg, r.NetMax)
+		add(resp.Threads, r.ThreadsMin, r.ThreadsAvg, r.ThreadsMax)
+		add(resp.Load1, r.Load1Min, r.Load1Avg, r.Load1Max)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// WatchMonitors streams the live check results published by the worker.
+func (s *MonitorServer) WatchMonitors(
+	ctx context.Context,
+	req *connect.Request[pulsarv1.WatchMonitorsRequest],
+	stream *connect.ServerStream[pulsarv1.MonitorUpdate],
+) error {
+	wanted := make(map[string]bool, len(req.Msg.MonitorIds))
+	for _, id := range req.Msg.MonitorIds {
+		wanted[id] = true
+	}
+
+	ctx, cancel := s.untilClosed(ctx)
+	defer cancel()
+
+	// headers go out once the start position is fixed, so the client knows
+	// it won't miss anything from there on
+	err := events.Follow(ctx, s.rdb, func() error { return stream.Send(nil) }, func(ev *pulsarv1.Event) error {
+		update := ev.GetMonitorUpdate()
+		if update == nil || (len(wanted) > 0 && !wanted[update.MonitorId]) {
+			return nil
 		}
+		return stream.Send(update)
+	})
+	if err != nil && ctx.Err() == nil {
+		return connect.NewError(connect.CodeUnavailable, err)
 	}
+	return nil
 }
 
+// GetProcessSnapshot returns the top processes as they were at (or just
+// before) the given time.
+func (s *MonitorServer) GetProcessSnapshot(
+	ctx context.Context,
+	req *connect.Request[pulsarv1.GetProcessSnapshotRequest],
+) (*connect.Response[pulsarv1.GetProcessSnapshotResponse], error) {
+	at := time.Now()
+	if req.Msg.At > 0 {
+		at = time.Unix(req.Msg.At, 0)
+	}
+	host := req.Msg.Host
+	if host == "" {
+		host = systemstats.LocalHost
+	}
+	rows, err := s.queries.GetProcessSnapshot(ctx, db.GetProcessSnapshotParams{
+		Host:      host,
+		CreatedAt: pgtype.Timestamptz{Time: at, Valid: true},
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if len(rows) == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("bu zamandan önce process kaydı yok"))
+	}
+
+	resp := &pulsarv1.GetProcessSnapshotResponse{
+		Time: rows[0].CreatedAt.Time.Format(time.RFC3339),
+	}
+	for _, r := range rows {
+		resp.Processes = append(resp.Processes, &pulsarv1.ProcessInfo{
+			Pid:           r.Pid,
+			Name:          r.Name,
+			User:          r.Username,
+			State:         r.State,
+			Threads:       r.Threads,
+			CpuPercent:    r.CpuPercent,
+			RssBytes:      uint64(r.RssBytes),
+			MemoryPercent: r.MemoryPercent,
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
 
-func getServiceProcessStates() (total, running, sleeping, zombie int32) {
-	procs, err := process.Processes()
+// GetCgroupStats returns the containers' (and other cgroups') latest usage
+// with their history since the given time.
+func (s *MonitorServer) GetCgroupStats(
+	ctx context.Context,
+	req *connect.Request[pulsarv1.GetCgroupStatsRequest],
+) (*connect.Response[pulsarv1.GetCgroupStatsResponse], error) {
+	since := time.Now().Add(-time.Hour)
+	if req.Msg.Since > 0 {
+		since = time.Unix(req.Msg.Since, 0)
+	}
+	host := req.Msg.Host
+	if host == "" {
+		host = systemstats.LocalHost
+	}
+	rows, err := s.queries.GetCgroupStatHistory(ctx, db.GetCgroupStatHistoryParams{
+		Host:      host,
+		CreatedAt: pgtype.Timestamptz{Time: since, Valid: true},
+	})
 	if err != nil {
-		return int32(runtime.NumGoroutine()), int32(runtime.NumGoroutine()), 0, 0
+		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	total = int32(len(procs))
-	for _, p := range procs {
-		status, err := p.Status()
-		if err != nil || len(status) == 0 {
-			continue
-		}
-		
-		switch status[0] {
-		case "R":
-			running++
-		case "S", "I":
-			sleeping++
-		case "Z", "T", "L":
-			zombie++
-		default:
-			sleeping++
+	// rows come by path, oldest first: the last row of a path is its latest
+	resp := &pulsarv1.GetCgroupStatsResponse{}
+	var cur *pulsarv1.CgroupUsage
+	for _, r := range rows {
+		if cur == nil || cur.Path != r.Path {
+			cur = &pulsarv1.CgroupUsage{Path: r.Path}
+			resp.Cgroups = append(resp.Cgroups, cur)
 		}
+		cur.ContainerId = r.ContainerID
+		cur.CpuPercent = r.CpuPercent
+		cur.CpuLimit = r.CpuLimit
+		cur.MemoryBytes = uint64(r.MemoryBytes)
+		cur.MemoryLimit = uint64(r.MemoryLimit)
+		cur.IoReadKbs = r.IoReadKbS
+		cur.IoWriteKbs = r.IoWriteKbS
+		cur.Pids = r.Pids
+		cur.PidsLimit = r.PidsLimit
+		cur.History = append(cur.History, &pulsarv1.CgroupPoint{
+			Time:        r.CreatedAt.Time.Format(time.RFC3339),
+			CpuPercent:  r.CpuPercent,
+			MemoryBytes: uint64(r.MemoryBytes),
+			IoReadKbs:   r.IoReadKbS,
+			IoWriteKbs:  r.IoWriteKbS,
+			Pids:        r.Pids,
+		})
 	}
-	return
+	sort.Slice(resp.Cgroups, func(i, j int) bool {
+		return resp.Cgroups[i].MemoryBytes > resp.Cgroups[j].MemoryBytes
+	})
+	return connect.NewResponse(resp), nil
+}
+
+// untilClosed returns a context that is also cancelled by Close, for streams.
+func (s *MonitorServer) untilClosed(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-s.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
 }
 
 func pgUUIDToString(uuid pgtype.UUID) string {
@@ -301,12 +579,3 @@ func pgUUIDToString(uuid pgtype.UUID) string {
 	src := uuid.Bytes
 	return fmt.Sprintf("%x-%x-%x-%x-%x", src[0:4], src[4:6], src[6:8], src[8:10], src[10:16])
 }
-
-func toFixed(num float64, precision int) float64 {
-	output := math.Pow(10, float64(precision))
-	return math.Round(num*output) / output
-}
-
-func bytesToGB(bytes uint64) float64 {
-	return toFixed(float64(bytes)/1024/1024/1024, 1)
-}
\ No newline at end of file
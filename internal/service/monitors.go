@@ -0,0 +1,274 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+
+	pulsarv1 "github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1"
+
+	"github.com/barkinrl/pulsar/internal/db"
+	"github.com/barkinrl/pulsar/internal/secrets"
+	"github.com/barkinrl/pulsar/internal/worker"
+)
+
+// monitorConfig checks the type-specific part of a new monitor and returns
+// its type and monitors.config.
+func monitorConfig(req *pulsarv1.CreateMonitorRequest) (string, []byte, error) {
+	switch req.Type {
+	case "", worker.MonitorTypeHTTP:
+		if _, found := secrets.RedactURL(req.Url); found {
+			return "", nil, fmt.Errorf("url içindeki şifre ve token'lar için {{secret:<id>}} kullanın")
+		}
+		content, redirects := req.GetContent(), req.GetRedirects()
+		cfg := worker.HTTPConfig{
+			Content: worker.ContentConfig{
+				Enabled:         content.GetEnabled(),
+				MaxBytes:        int(content.GetMaxBytes()),
+				IgnoreSelectors: content.GetIgnoreSelectors(),
+				IgnorePatterns:  content.GetIgnorePatterns(),
+			},
+			Redirects: worker.RedirectPolicy{
+				Mode:         redirects.GetMode(),
+				MaxHops:      int(redirects.GetMaxHops()),
+				RequireHTTPS: redirects.GetRequireHttps(),
+				SameHost:     redirects.GetSameHost(),
+			},
+		}.WithDefaults()
+		if err := cfg.Validate(); err != nil {
+			return "", nil, err
+		}
+		config, err := json.Marshal(cfg)
+		return worker.MonitorTypeHTTP, config, err
+
+	case worker.MonitorTypeICMP:
+		// icmp targets are bare hosts
+		if req.Url == "" || strings.Contains(req.Url, "/") {
+			return "", nil, fmt.Errorf("icmp monitörü için host adı ya da IP gerekli: %q", req.Url)
+		}
+		if strings.Contains(req.Url, ":") && net.ParseIP(req.Url) == nil {
+			return "", nil, fmt.Errorf("icmp monitörü port almaz: %q", req.Url)
+		}
+		cfg := worker.ICMPConfig{
+			Count:        int(req.GetIcmp().GetCount()),
+			TimeoutMs:    int(req.GetIcmp().GetTimeoutMs()),
+			DegradedLoss: req.GetIcmp().GetDegradedLoss(),
+			DownLoss:     req.GetIcmp().GetDownLoss(),
+		}.WithDefaults()
+		if err := cfg.Validate(); err != nil {
+			return "", nil, err
+		}
+		config, err := json.Marshal(cfg)
+		return worker.MonitorTypeICMP, config, err
+
+	case worker.MonitorTypePush:
+		// nothing to reach: url is just the name of the job
+		if req.Url == "" {
+			return "", nil, fmt.Errorf("push monitörü için bir isim (url) gerekli")
+		}
+		cfg := worker.PushConfig{
+			GraceSeconds: int(req.GetPush().GetGraceSeconds()),
+		}.WithDefaults()
+		if err := cfg.Validate(); err != nil {
+			return "", nil, err
+		}
+		config, err := json.Marshal(cfg)
+		return worker.MonitorTypePush, config, err
+
+	case worker.MonitorTypeGRPC:
+		if _, port, err := net.SplitHostPort(req.Url); err != nil || port == "" {
+			return "", nil, fmt.Errorf("grpc monitörü için host:port gerekli: %q", req.Url)
+		}
+		cfg := worker.GRPCConfig{
+			Service:            req.GetGrpc().GetService(),
+			TLS:                req.GetGrpc().GetTls(),
+			InsecureSkipVerify: req.GetGrpc().GetInsecureSkipVerify(),
+			TimeoutMs:          int(req.GetGrpc().GetTimeoutMs()),
+		}.WithDefaults()
+		if err := cfg.Validate(); err != nil {
+			return "", nil, err
+		}
+		config, err := json.Marshal(cfg)
+		return worker.MonitorTypeGRPC, config, err
+
+	case worker.MonitorTypeScript:
+		// the steps have their own URLs: url is the name of the flow
+		if req.Url == "" {
+			return "", nil, fmt.Errorf("script monitörü için bir isim (url) gerekli")
+		}
+		cfg := scriptConfig(req.GetScript()).WithDefaults()
+		if err := cfg.Validate(); err != nil {
+			return "", nil, err
+		}
+		if err := checkScriptSecrets(cfg); err != nil {
+			return "", nil, err
+		}
+		config, err := json.Marshal(cfg)
+		return worker.MonitorTypeScript, config, err
+	}
+	return "", nil, fmt.Errorf("bilinmeyen monitör tipi: %q", req.Type)
+}
+
+func scriptConfig(p *pulsarv1.ScriptConfig) worker.ScriptConfig {
+	cfg := worker.ScriptConfig{TimeoutMs: int(p.GetTimeoutMs())}
+	for _, s := range p.GetSteps() {
+		step := worker.ScriptStep{
+			Name:    s.Name,
+			Method:  s.Method,
+			URL:     s.Url,
+			Headers: s.Headers,
+			Body:    s.Body,
+		}
+		for _, e := range s.Extract {
+			step.Extract = append(step.Extract, worker.ScriptExtract{Var: e.Var, From: e.From, Path: e.Path})
+		}
+		for _, a := range s.Assert {
+			step.Assert = append(step.Assert, worker.ScriptAssert{Source: a.Source, Path: a.Path, Op: a.Op, Value: a.Value})
+		}
+		cfg.Steps = append(cfg.Steps, step)
+	}
+	return cfg
+}
+
+func scriptConfigProto(cfg worker.ScriptConfig) *pulsarv1.ScriptConfig {
+	p := &pulsarv1.ScriptConfig{TimeoutMs: int32(cfg.TimeoutMs)}
+	for _, s := range cfg.Steps {
+		step := &pulsarv1.ScriptStep{
+			Name:    s.Name,
+			Method:  s.Method,
+			Headers: make(map[string]string, len(s.Headers)),
+		}
+		// scripts from before the secrets store may still have them inline
+		step.Url, _ = secrets.RedactURL(s.URL)
+		step.Body, _ = secrets.RedactBody(s.Body)
+		for k, v := range s.Headers {
+			if secrets.SensitiveName(k) && !strings.Contains(v, "{{") {
+				v = "[redacted]"
+			}
+			step.Headers[k] = v
+		}
+		for _, e := range s.Extract {
+			step.Extract = append(step.Extract, &pulsarv1.ScriptExtract{Var: e.Var, From: e.From, Path: e.Path})
+		}
+		for _, a := range s.Assert {
+			step.Assert = append(step.Assert, &pulsarv1.ScriptAssert{Source: a.Source, Path: a.Path, Op: a.Op, Value: a.Value})
+		}
+		p.Steps = append(p.Steps, step)
+	}
+	return p
+}
+
+// checkScriptSecrets keeps credentials out of the stored script: headers,
+// URLs and body fields that carry them take a {{secret:<id>}} or a variable
+// extracted by an earlier step (the session token of a login step), not an
+// inline value.
+func checkScriptSecrets(cfg worker.ScriptConfig) error {
+	for _, step := range cfg.Steps {
+		for k, v := range step.Headers {
+			if secrets.SensitiveName(k) && !strings.Contains(v, "{{") {
+				return fmt.Errorf("%s adımı: %s başlığı için {{secret:<id>}} kullanın", step.Name, k)
+			}
+		}
+		if _, found := secrets.RedactURL(step.URL); found {
+			return fmt.Errorf("%s adımı: url içindeki şifre ve token'lar için {{secret:<id>}} kullanın", step.Name)
+		}
+		if _, found := secrets.RedactBody(step.Body); found {
+			return fmt.Errorf("%s adımı: body içindeki şifre ve token'lar için {{secret:<id>}} kullanın", step.Name)
+		}
+	}
+	return nil
+}
+
+// newPushToken, the secret part of a push monitor's URL
+func newPushToken() (string, error) {
+	secret := make([]byte, 16)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+// probeCredentials reads the credentials of a monitor; only the probes that
+// speak HTTP can use them. checkCredentials validates them.
+func probeCredentials(monitorType string, p *pulsarv1.ProbeCredentials) (worker.ProbeCredentials, error) {
+	creds := worker.ProbeCredentials{
+		ProxyURL:   strings.TrimSpace(p.GetProxyUrl()),
+		ClientCert: p.GetClientCert(),
+		ClientKey:  p.GetClientKey(),
+		CABundle:   p.GetCaBundle(),
+	}
+	if creds.IsZero() {
+		return creds, nil
+	}
+	switch monitorType {
+	case worker.MonitorTypeHTTP, worker.MonitorTypeScript, worker.MonitorTypeGRPC:
+	default:
+		return creds, fmt.Errorf("%s monitörü proxy ya da client sertifikası kullanmaz", monitorType)
+	}
+	return creds, nil
+}
+
+func (s *MonitorServer) monitorProto(m db.Monitor, creds worker.ProbeCredentials) *pulsarv1.Monitor {
+	monitor := &pulsarv1.Monitor{
+		Id:              pgUUIDToString(m.ID),
+		IntervalSeconds: m.IntervalSeconds,
+		IsActive:        m.IsActive,
+		Type:            m.Type,
+		Credentials:     creds.Info(),
+	}
+	// sealed at startup, unless there is no master key
+	monitor.Url, _ = secrets.RedactURL(m.Url)
+	if m.Type == worker.MonitorTypeHTTP {
+		if cfg, err := worker.ParseHTTPConfig(m.Config); err == nil {
+			if cfg.Content.Enabled {
+				monitor.Content = &pulsarv1.ContentCheck{
+					Enabled:         true,
+					MaxBytes:        int32(cfg.Content.MaxBytes),
+					IgnoreSelectors: cfg.Content.IgnoreSelectors,
+					IgnorePatterns:  cfg.Content.IgnorePatterns,
+				}
+			}
+			monitor.Redirects = &pulsarv1.RedirectPolicy{
+				Mode:         cfg.Redirects.Mode,
+				MaxHops:      int32(cfg.Redirects.MaxHops),
+				RequireHttps: cfg.Redirects.RequireHTTPS,
+				SameHost:     cfg.Redirects.SameHost,
+			}
+		}
+	}
+	if m.Type == worker.MonitorTypeICMP {
+		if cfg, err := worker.ParseICMPConfig(m.Config); err == nil {
+			monitor.Icmp = &pulsarv1.IcmpConfig{
+				Count:        int32(cfg.Count),
+				TimeoutMs:    int32(cfg.TimeoutMs),
+				DegradedLoss: cfg.DegradedLoss,
+				DownLoss:     cfg.DownLoss,
+			}
+		}
+	}
+	if m.Type == worker.MonitorTypePush {
+		monitor.PushUrl = s.publicURL + "/push/" + m.PushToken.String
+		if cfg, err := worker.ParsePushConfig(m.Config); err == nil {
+			monitor.Push = &pulsarv1.PushConfig{GraceSeconds: int32(cfg.GraceSeconds)}
+		}
+	}
+	if m.Type == worker.MonitorTypeGRPC {
+		if cfg, err := worker.ParseGRPCConfig(m.Config); err == nil {
+			monitor.Grpc = &pulsarv1.GrpcConfig{
+				Service:            cfg.Service,
+				Tls:                cfg.TLS,
+				InsecureSkipVerify: cfg.InsecureSkipVerify,
+				TimeoutMs:          int32(cfg.TimeoutMs),
+			}
+		}
+	}
+	if m.Type == worker.MonitorTypeScript {
+		if cfg, err := worker.ParseScriptConfig(m.Config); err == nil {
+			monitor.Script = scriptConfigProto(cfg)
+		}
+	}
+	return monitor
+}
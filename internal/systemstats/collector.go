@@ -0,0 +1,235 @@
+package systemstats
+
+import (
+	"context"
+	"log"
+	"math"
+	"strconv"
+	"time"
+
+	pulsarv1 "github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1"
+	"github.com/barkinrl/pulsar/internal/alerts"
+	"github.com/barkinrl/pulsar/internal/db"
+	"github.com/barkinrl/pulsar/internal/hoststats"
+	"github.com/barkinrl/pulsar/internal/metrics"
+	"github.com/redis/go-redis/v9"
+)
+
+// how often the top processes of a host are stored
+const processSnapshotEvery = time.Minute
+
+// Warner reports whether a metric's value deserves the is_warning flag.
+type Warner func(metric string, value float64) bool
+
+// Collector samples the worker's own host on an interval and records each
+// sample as LocalHost. Readers (API, dashboards) consume the published events.
+type Collector struct {
+	sampler  *hoststats.Sampler
+	recorder *Recorder
+	interval time.Duration
+}
+
+func NewCollector(queries *db.Queries, rdb *redis.Client, interval time.Duration) *Collector {
+	return &Collector{
+		sampler:  hoststats.NewSampler(),
+		recorder: NewRecorder(queries, rdb),
+		interval: interval,
+	}
+}
+
+// Run samples until ctx is cancelled.
+func (c *Collector) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("System monitor durduruluyor...")
+			return
+		case <-ticker.C:
+		}
+
+		s := c.sampler.Sample()
+		observe(s)
+		c.recorder.Record(ctx, LocalHost, s)
+	}
+}
+
+func observe(s hoststats.Sample) {
+	metrics.SystemCPUPercent.Set(s.CPUPercent)
+	metrics.SystemMemoryPercent.Set(s.MemoryPercent)
+	metrics.SystemMemoryUsedBytes.Set(float64(s.MemoryUsed))
+	metrics.SystemDiskPercent.Set(s.DiskPercent)
+	metrics.SystemDiskUsedBytes.Set(float64(s.DiskUsed))
+	metrics.SystemNetworkKBps.Set(s.NetworkKBps)
+	metrics.SystemThreads.WithLabelValues("total").Set(float64(s.Processes.Total))
+	metrics.SystemThreads.WithLabelValues("running").Set(float64(s.Processes.Running))
+	metrics.SystemThreads.WithLabelValues("sleeping").Set(float64(s.Processes.Sleeping))
+	metrics.SystemThreads.WithLabelValues("zombie").Set(float64(s.Processes.Zombie))
+	if s.Host != nil {
+		metrics.SystemUptimeSeconds.Set(float64(s.Host.Uptime))
+	}
+
+	metrics.SystemLoad.WithLabelValues("1m").Set(s.Load.Load1)
+	metrics.SystemLoad.WithLabelValues("5m").Set(s.Load.Load5)
+	metrics.SystemLoad.WithLabelValues("15m").Set(s.Load.Load15)
+	for core, percent := range s.CPUCores {
+		metrics.SystemCoreCPUPercent.WithLabelValues(strconv.Itoa(core)).Set(percent)
+	}
+	for _, d := range s.Disks {
+		metrics.SystemMountPercent.WithLabelValues(d.Mountpoint, d.Device).Set(d.Percent)
+		metrics.SystemMountUsedBytes.WithLabelValues(d.Mountpoint, d.Device).Set(float64(d.Used))
+		metrics.SystemDiskIOKBps.WithLabelValues(d.Device, "read").Set(d.ReadKBps)
+		metrics.SystemDiskIOKBps.WithLabelValues(d.Device, "write").Set(d.WriteKBps)
+	}
+	for _, i := range s.Interfaces {
+		metrics.SystemInterfaceKBps.WithLabelValues(i.Name, "rx").Set(i.RxKBps)
+		metrics.SystemInterfaceKBps.WithLabelValues(i.Name, "tx").Set(i.TxKBps)
+	}
+
+	// containers come and go: drop the series of the ones that are gone
+	metrics.SystemCgroupCPUPercent.Reset()
+	metrics.SystemCgroupMemoryBytes.Reset()
+	metrics.SystemCgroupPids.Reset()
+	for _, c := range s.Cgroups {
+		metrics.SystemCgroupCPUPercent.WithLabelValues(c.Path, c.ContainerID).Set(c.CPUPercent)
+		metrics.SystemCgroupMemoryBytes.WithLabelValues(c.Path, c.ContainerID).Set(float64(c.MemoryBytes))
+		metrics.SystemCgroupPids.WithLabelValues(c.Path, c.ContainerID).Set(float64(c.Pids))
+	}
+}
+
+// values returns the sample's metrics by the names alert rules use.
+func values(s hoststats.Sample) map[string]float64 {
+	return map[string]float64{
+		alerts.MetricCPU:           s.CPUPercent,
+		alerts.MetricMemory:        s.MemoryPercent,
+		alerts.MetricDisk:          s.DiskPercent,
+		alerts.MetricNetwork:       s.NetworkKBps,
+		alerts.MetricThreadsTotal:  float64(s.Processes.Total),
+		alerts.MetricThreadsZombie: float64(s.Processes.Zombie),
+		alerts.MetricLoad1:         s.Load.Load1,
+		alerts.MetricLoad5:         s.Load.Load5,
+		alerts.MetricLoad15:        s.Load.Load15,
+	}
+}
+
+// sampleProto converts the sample to the message streamed to clients; warn
+// sets the is_warning flags.
+func sampleProto(s hoststats.Sample, warn Warner) *pulsarv1.SystemStatsResponse {
+	resp := &pulsarv1.SystemStatsResponse{
+		Cpu: &pulsarv1.ResourceUsage{
+			Used:      toFixed(s.CPUPercent, 1),
+			Total:     100,
+			Percent:   toFixed(s.CPUPercent, 1),
+			Unit:      "%",
+			IsWarning: warn(alerts.MetricCPU, s.CPUPercent),
+		},
+		Memory: &pulsarv1.ResourceUsage{
+			Used:      bytesToGB(s.MemoryUsed),
+			Total:     bytesToGB(s.MemoryTotal),
+			Percent:   toFixed(s.MemoryPercent, 1),
+			Unit:      "GB",
+			IsWarning: warn(alerts.MetricMemory, s.MemoryPercent),
+		},
+		Disk: &pulsarv1.ResourceUsage{
+			Used:      bytesToGB(s.DiskUsed),
+			Total:     bytesToGB(s.DiskTotal),
+			Percent:   toFixed(s.DiskPercent, 1),
+			Unit:      "GB",
+			IsWarning: warn(alerts.MetricDisk, s.DiskPercent),
+		},
+		Network: &pulsarv1.ResourceUsage{
+			Used:      toFixed(s.NetworkKBps, 1),
+			Unit:      "KB/s",
+			IsWarning: warn(alerts.MetricNetwork, s.NetworkKBps),
+		},
+		Threads: &pulsarv1.ThreadUsage{
+			Total:     s.Processes.Total,
+			Running:   s.Processes.Running,
+			Sleeping:  s.Processes.Sleeping,
+			Zombie:    s.Processes.Zombie,
+			IsWarning: warn(alerts.MetricThreadsTotal, float64(s.Processes.Total)),
+		},
+	}
+	for _, d := range s.Disks {
+		resp.Disks = append(resp.Disks, &pulsarv1.DiskUsage{
+			Mountpoint: d.Mountpoint,
+			Device:     d.Device,
+			Fstype:     d.Fstype,
+			Used:       bytesToGB(d.Used),
+			Total:      bytesToGB(d.Total),
+			Percent:    toFixed(d.Percent, 1),
+			ReadKbs:    toFixed(d.ReadKBps, 1),
+			WriteKbs:   toFixed(d.WriteKBps, 1),
+			IsWarning:  warn(alerts.MetricDisk, d.Percent),
+		})
+	}
+	for _, i := range s.Interfaces {
+		resp.Interfaces = append(resp.Interfaces, &pulsarv1.InterfaceUsage{
+			Name:  i.Name,
+			RxKbs: toFixed(i.RxKBps, 1),
+			TxKbs: toFixed(i.TxKBps, 1),
+		})
+	}
+	for _, c := range s.Cgroups {
+		resp.Cgroups = append(resp.Cgroups, cgroupProto(c))
+	}
+	for _, percent := range s.CPUCores {
+		resp.CpuCores = append(resp.CpuCores, toFixed(percent, 1))
+	}
+	for _, info := range s.TopCPU {
+		resp.TopCpu = append(resp.TopCpu, processProto(info))
+	}
+	for _, info := range s.TopMemory {
+		resp.TopMemory = append(resp.TopMemory, processProto(info))
+	}
+	resp.Load = &pulsarv1.LoadAverage{
+		Load1:  toFixed(s.Load.Load1, 2),
+		Load5:  toFixed(s.Load.Load5, 2),
+		Load15: toFixed(s.Load.Load15, 2),
+	}
+	if s.Host != nil {
+		resp.Info = &pulsarv1.SystemInfo{
+			Hostname:        s.Host.Hostname,
+			Os:              s.Host.OS,
+			UptimeSeconds:   s.Host.Uptime,
+			Platform:        s.Host.Platform,
+			PlatformVersion: s.Host.PlatformVersion,
+		}
+	}
+	return resp
+}
+
+// processProto converts the process to its report row.
+func processProto(p hoststats.ProcessInfo) *pulsarv1.ProcessInfo {
+	return &pulsarv1.ProcessInfo{
+		Pid:           p.PID,
+		Name:          p.Name,
+		User:          p.User,
+		State:         p.State,
+		Threads:       p.Threads,
+		CpuPercent:    toFixed(p.CPUPercent, 1),
+		RssBytes:      p.RSS,
+		MemoryPercent: toFixed(p.MemoryPercent, 1),
+	}
+}
+
+// cgroupProto converts the cgroup to its report row.
+func cgroupProto(c hoststats.CgroupStats) *pulsarv1.CgroupUsage {
+	usage := c.Raw()
+	usage.CpuPercent = toFixed(c.CPUPercent, 1)
+	usage.CpuLimit = toFixed(c.CPULimit, 2)
+	usage.IoReadKbs = toFixed(c.IOReadKBps, 1)
+	usage.IoWriteKbs = toFixed(c.IOWriteKBps, 1)
+	return usage
+}
+
+func toFixed(num float64, precision int) float64 {
+	output := math.Pow(10, float64(precision))
+	return math.Round(num*output) / output
+}
+
+func bytesToGB(bytes uint64) float64 {
+	return toFixed(float64(bytes)/1024/1024/1024, 1)
+}
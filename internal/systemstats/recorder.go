@@ -0,0 +1,183 @@
+package systemstats
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	pulsarv1 "github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1"
+	"github.com/barkinrl/pulsar/internal/alerts"
+	"github.com/barkinrl/pulsar/internal/db"
+	"github.com/barkinrl/pulsar/internal/events"
+	"github.com/barkinrl/pulsar/internal/hoststats"
+	"github.com/redis/go-redis/v9"
+)
+
+// LocalHost, the host key of the worker's own samples; agents report under
+// their own name.
+const LocalHost = "local"
+
+// Recorder stores samples, checks them against the alert rules and publishes
+// them on the event stream, for any host. Safe for concurrent use: the API
+// records the samples agents push.
+type Recorder struct {
+	queries *db.Queries
+	rdb     *redis.Client
+	alerts  *alerts.Evaluator
+
+	mu           sync.Mutex
+	lastSnapshot map[string]time.Time // per host
+}
+
+func NewRecorder(queries *db.Queries, rdb *redis.Client) *Recorder {
+	return &Recorder{
+		queries:      queries,
+		rdb:          rdb,
+		alerts:       alerts.NewEvaluator(queries, rdb),
+		lastSnapshot: map[string]time.Time{},
+	}
+}
+
+// Record handles one sample of host. It only fails if the sample could not
+// be stored.
+func (r *Recorder) Record(ctx context.Context, host string, s hoststats.Sample) error {
+	// 1. DB storage
+	err := r.store(ctx, host, s)
+	if err != nil && ctx.Err() == nil {
+		log.Printf("⚠️ System Stat DB Error (%s): %v", host, err)
+	}
+
+	// 2. Alert rules
+	r.alerts.Evaluate(ctx, host, values(s), s.Time)
+
+	// 3. Event stream
+	stats := sampleProto(s, r.alerts.Warn)
+	stats.Host = host
+	perr := events.Publish(ctx, r.rdb, &pulsarv1.Event{
+		Payload: &pulsarv1.Event_System{System: stats},
+	})
+	if perr != nil && ctx.Err() == nil {
+		log.Printf("⚠️ System Stat Publish Error (%s): %v", host, perr)
+	}
+	return err
+}
+
+// store saves the sample and its per-disk, per-interface, per-core and
+// per-cgroup rows.
+func (r *Recorder) store(ctx context.Context, host string, s hoststats.Sample) error {
+	stat, err := r.queries.CreateSystemStat(ctx, db.CreateSystemStatParams{
+		CpuPercent:      s.CPUPercent,
+		MemoryPercent:   s.MemoryPercent,
+		DiskPercent:     s.DiskPercent,
+		NetKbS:          s.NetworkKBps,
+		ThreadsTotal:    s.Processes.Total,
+		ThreadsRunning:  s.Processes.Running,
+		ThreadsSleeping: s.Processes.Sleeping,
+		ThreadsZombie:   s.Processes.Zombie,
+		Load1:           s.Load.Load1,
+		Load5:           s.Load.Load5,
+		Load15:          s.Load.Load15,
+		Host:            host,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(s.Disks) > 0 {
+		p := db.CreateSystemDiskStatsParams{SystemStatID: stat.ID}
+		for _, d := range s.Disks {
+			p.Mountpoints = append(p.Mountpoints, d.Mountpoint)
+			p.Devices = append(p.Devices, d.Device)
+			p.Fstypes = append(p.Fstypes, d.Fstype)
+			p.UsedBytes = append(p.UsedBytes, int64(d.Used))
+			p.TotalBytes = append(p.TotalBytes, int64(d.Total))
+			p.UsedPercents = append(p.UsedPercents, d.Percent)
+			p.ReadKbS = append(p.ReadKbS, d.ReadKBps)
+			p.WriteKbS = append(p.WriteKbS, d.WriteKBps)
+		}
+		if err := r.queries.CreateSystemDiskStats(ctx, p); err != nil {
+			return err
+		}
+	}
+
+	if len(s.Interfaces) > 0 {
+		p := db.CreateSystemNetStatsParams{SystemStatID: stat.ID}
+		for _, i := range s.Interfaces {
+			p.Interfaces = append(p.Interfaces, i.Name)
+			p.RxKbS = append(p.RxKbS, i.RxKBps)
+			p.TxKbS = append(p.TxKbS, i.TxKBps)
+		}
+		if err := r.queries.CreateSystemNetStats(ctx, p); err != nil {
+			return err
+		}
+	}
+
+	if len(s.CPUCores) > 0 {
+		p := db.CreateSystemCPUStatsParams{SystemStatID: stat.ID}
+		for core, percent := range s.CPUCores {
+			p.Cores = append(p.Cores, int32(core))
+			p.Percents = append(p.Percents, percent)
+		}
+		if err := r.queries.CreateSystemCPUStats(ctx, p); err != nil {
+			return err
+		}
+	}
+
+	if len(s.Cgroups) > 0 {
+		p := db.CreateCgroupStatsParams{SystemStatID: stat.ID}
+		for _, c := range s.Cgroups {
+			p.Paths = append(p.Paths, c.Path)
+			p.ContainerIds = append(p.ContainerIds, c.ContainerID)
+			p.CpuPercents = append(p.CpuPercents, c.CPUPercent)
+			p.CpuLimits = append(p.CpuLimits, c.CPULimit)
+			p.MemoryBytes = append(p.MemoryBytes, int64(c.MemoryBytes))
+			p.MemoryLimits = append(p.MemoryLimits, int64(c.MemoryLimit))
+			p.IoReadKbS = append(p.IoReadKbS, c.IOReadKBps)
+			p.IoWriteKbS = append(p.IoWriteKbS, c.IOWriteKBps)
+			p.Pids = append(p.Pids, c.Pids)
+			p.PidsLimits = append(p.PidsLimits, c.PidsLimit)
+		}
+		if err := r.queries.CreateCgroupStats(ctx, p); err != nil {
+			return err
+		}
+	}
+
+	// top processes, now and then
+	if len(s.TopMemory) > 0 && r.snapshotDue(host, s.Time) {
+		p := db.CreateSystemProcessStatsParams{SystemStatID: stat.ID}
+		seen := map[int32]bool{}
+		for _, list := range [][]hoststats.ProcessInfo{s.TopMemory, s.TopCPU} {
+			for _, info := range list {
+				if seen[info.PID] {
+					continue
+				}
+				seen[info.PID] = true
+				p.Pids = append(p.Pids, info.PID)
+				p.Names = append(p.Names, info.Name)
+				p.Usernames = append(p.Usernames, info.User)
+				p.States = append(p.States, info.State)
+				p.Threads = append(p.Threads, info.Threads)
+				p.CpuPercents = append(p.CpuPercents, info.CPUPercent)
+				p.RssBytes = append(p.RssBytes, int64(info.RSS))
+				p.MemoryPercents = append(p.MemoryPercents, info.MemoryPercent)
+			}
+		}
+		if err := r.queries.CreateSystemProcessStats(ctx, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// snapshotDue reports whether host's top processes should be kept this time;
+// once a minute is enough to look back at an incident.
+func (r *Recorder) snapshotDue(host string, at time.Time) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if at.Sub(r.lastSnapshot[host]) < processSnapshotEvery {
+		return false
+	}
+	r.lastSnapshot[host] = at
+	return true
+}
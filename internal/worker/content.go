@@ -0,0 +1,303 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	pulsarv1 "github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1"
+	"github.com/barkinrl/pulsar/internal/db"
+	"github.com/barkinrl/pulsar/internal/events"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"golang.org/x/net/html"
+)
+
+const (
+	maxContentBytes = 10 << 20
+
+	// snapshots kept per monitor, the older ones are deleted
+	keepContentSnapshots = 50
+)
+
+// HTTPConfig, the settings of an http monitor (monitors.config)
+type HTTPConfig struct {
+	Content   ContentConfig  `json:"content"`
+	Redirects RedirectPolicy `json:"redirects"`
+}
+
+// ContentConfig turns on content change detection: the worker reads the
+// whole body (up to MaxBytes), normalizes it and compares it with the last
+// snapshot.
+type ContentConfig struct {
+	Enabled         bool     `json:"enabled"`
+	MaxBytes        int      `json:"max_bytes"`
+	IgnoreSelectors []string `json:"ignore_selectors,omitempty"` // HTML elements left out, e.g. "#clock", "div.ad"
+	IgnorePatterns  []string `json:"ignore_patterns,omitempty"`  // regexps removed from the text
+}
+
+// WithDefaults fills the zero fields.
+func (c HTTPConfig) WithDefaults() HTTPConfig {
+	if c.Content.MaxBytes == 0 {
+		c.Content.MaxBytes = 1 << 20
+	}
+	c.Redirects = c.Redirects.WithDefaults()
+	return c
+}
+
+func (c HTTPConfig) Validate() error {
+	if c.Content.MaxBytes < 1024 || c.Content.MaxBytes > maxContentBytes {
+		return fmt.Errorf("max_bytes 1024 ile %d arasında olmalı", maxContentBytes)
+	}
+	for _, s := range c.Content.IgnoreSelectors {
+		if _, err := parseSelectors(s); err != nil {
+			return err
+		}
+	}
+	for _, p := range c.Content.IgnorePatterns {
+		if _, err := regexp.Compile(p); err != nil {
+			return fmt.Errorf("geçersiz ignore pattern %q: %w", p, err)
+		}
+	}
+	return c.Redirects.Validate()
+}
+
+// ParseHTTPConfig reads monitors.config of an http monitor.
+func ParseHTTPConfig(raw []byte) (HTTPConfig, error) {
+	var c HTTPConfig
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &c); err != nil {
+			return c, err
+		}
+	}
+	c = c.WithDefaults()
+	return c, c.Validate()
+}
+
+// checkContent stores a new snapshot of the page when its normalized content
+// differs from the last one and publishes the change with a unified diff.
+func (p *PingProcessor) checkContent(ctx context.Context, payload MonitorTaskPayload, cfg ContentConfig, contentType string, body []byte) {
+	text := normalizeContent(body, contentType, cfg)
+	sum := sha256.Sum256([]byte(text))
+	hash := hex.EncodeToString(sum[:])
+
+	var monID pgtype.UUID
+	monID.Scan(payload.MonitorID)
+
+	prev, err := p.queries.GetLatestContentSnapshot(ctx, monID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// first look at the page: nothing to compare with
+		if _, err := p.queries.CreateContentSnapshot(ctx, db.CreateContentSnapshotParams{
+			MonitorID: monID,
+			Hash:      hash,
+			Content:   text,
+		}); err != nil {
+			log.Printf("❌ Snapshot kaydedilemedi: %v", err)
+		}
+		return
+	}
+	if err != nil {
+		log.Printf("❌ Snapshot okunamadı: %v", err)
+		return
+	}
+	if prev.Hash == hash {
+		return
+	}
+
+	diff, added, removed := unifiedDiff(contentLines(prev.Content), contentLines(text), 3)
+	snapshot, err := p.queries.CreateContentSnapshot(ctx, db.CreateContentSnapshotParams{
+		MonitorID: monID,
+		Hash:      hash,
+		Content:   text,
+		Diff:      diff,
+	})
+	if err != nil {
+		log.Printf("❌ Snapshot kaydedilemedi: %v", err)
+		return
+	}
+	log.Printf("📝 İçerik değişti: %s (+%d -%d satır)", payload.URL, added, removed)
+	if err := p.queries.PruneContentSnapshots(ctx, db.PruneContentSnapshotsParams{
+		MonitorID: monID,
+		Keep:      keepContentSnapshots,
+	}); err != nil {
+		log.Printf("⚠️ Eski snapshot'lar silinemedi: %v", err)
+	}
+
+	pubErr := events.Publish(ctx, p.rdb, &pulsarv1.Event{
+		Payload: &pulsarv1.Event_ContentChange{ContentChange: &pulsarv1.ContentChange{
+			MonitorId: payload.MonitorID,
+			Url:       payload.URL,
+			OldHash:   prev.Hash,
+			NewHash:   hash,
+			Added:     int32(added),
+			Removed:   int32(removed),
+			Diff:      diff,
+			Time:      snapshot.CreatedAt.Time.Format(time.RFC3339),
+		}},
+	})
+	if pubErr != nil {
+		log.Printf("Redis Publish Error: %v", pubErr)
+	}
+}
+
+func contentLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(text, "\n")
+}
+
+// normalizeContent turns a body into the text that is compared: for HTML the
+// visible text, one line per text node, without scripts, styles and the
+// ignored elements; otherwise the body's non-empty lines. Whitespace is
+// collapsed and the ignore patterns are cut out.
+func normalizeContent(body []byte, contentType string, cfg ContentConfig) string {
+	var lines []string
+	if strings.Contains(contentType, "html") {
+		lines = htmlText(body, cfg.IgnoreSelectors)
+	} else {
+		lines = strings.Split(string(body), "\n")
+	}
+
+	// Postgres text takes neither invalid UTF-8 nor NUL
+	text := strings.ReplaceAll(strings.ToValidUTF8(strings.Join(lines, "\n"), "\uFFFD"), "\x00", "")
+	for _, p := range cfg.IgnorePatterns {
+		if re, err := regexp.Compile(p); err == nil {
+			text = re.ReplaceAllString(text, "")
+		}
+	}
+
+	var out []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.Join(strings.Fields(line), " "); line != "" {
+			out = append(out, line)
+		}
+	}
+	return strings.Join(out, "\n")
+}
+
+func htmlText(body []byte, ignore []string) []string {
+	doc, err := html.Parse(bytes.NewReader(body))
+	if err != nil {
+		return strings.Split(string(body), "\n")
+	}
+	var selectors []selector
+	for _, s := range ignore {
+		parsed, _ := parseSelectors(s)
+		selectors = append(selectors, parsed...)
+	}
+
+	var lines []string
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		switch n.Type {
+		case html.CommentNode:
+			return
+		case html.TextNode:
+			lines = append(lines, n.Data)
+			return
+		case html.ElementNode:
+			switch n.Data {
+			case "script", "style", "noscript", "template":
+				return
+			}
+			for _, s := range selectors {
+				if s.matches(n) {
+					return
+				}
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+	return lines
+}
+
+// selector, a simple CSS selector: tag, #id, .class and [attr] or
+// [attr=value], combined like div.ad[data-slot]. No combinators.
+type selector struct {
+	tag     string
+	id      string
+	classes []string
+	attrs   [][2]string // name, value ("" = any)
+}
+
+var selectorPart = regexp.MustCompile(`^(?:[a-zA-Z][\w-]*|\*)?(?:#[\w-]+|\.[\w-]+|\[[\w-]+(?:=(?:"[^"]*"|'[^']*'|[^\]]*))?\])*$`)
+var selectorToken = regexp.MustCompile(`#[\w-]+|\.[\w-]+|\[[\w-]+(?:=(?:"[^"]*"|'[^']*'|[^\]]*))?\]|^[a-zA-Z][\w-]*|^\*`)
+
+// parseSelectors reads a comma separated selector list.
+func parseSelectors(list string) ([]selector, error) {
+	var selectors []selector
+	for _, part := range strings.Split(list, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" || !selectorPart.MatchString(part) {
+			return nil, fmt.Errorf("desteklenmeyen selector %q (tag, #id, .class, [attr=value])", part)
+		}
+		var s selector
+		for _, tok := range selectorToken.FindAllString(part, -1) {
+			switch tok[0] {
+			case '#':
+				s.id = tok[1:]
+			case '.':
+				s.classes = append(s.classes, tok[1:])
+			case '[':
+				name, value, _ := strings.Cut(tok[1:len(tok)-1], "=")
+				s.attrs = append(s.attrs, [2]string{name, strings.Trim(value, `"'`)})
+			case '*':
+			default:
+				s.tag = strings.ToLower(tok)
+			}
+		}
+		selectors = append(selectors, s)
+	}
+	return selectors, nil
+}
+
+func (s selector) matches(n *html.Node) bool {
+	if s.tag != "" && n.Data != s.tag {
+		return false
+	}
+	attr := func(name string) (string, bool) {
+		for _, a := range n.Attr {
+			if a.Key == name {
+				return a.Val, true
+			}
+		}
+		return "", false
+	}
+	if s.id != "" {
+		if id, _ := attr("id"); id != s.id {
+			return false
+		}
+	}
+	if len(s.classes) > 0 {
+		class, _ := attr("class")
+		have := strings.Fields(class)
+		for _, c := range s.classes {
+			found := false
+			for _, h := range have {
+				found = found || h == c
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+	for _, a := range s.attrs {
+		v, ok := attr(a[0])
+		if !ok || (a[1] != "" && v != a[1]) {
+			return false
+		}
+	}
+	return true
+}
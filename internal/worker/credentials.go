@@ -0,0 +1,226 @@
+package worker
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+
+	pulsarv1 "github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1"
+	"github.com/barkinrl/pulsar/internal/db"
+	"github.com/barkinrl/pulsar/internal/secrets"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ProbeCredentials, how the http, script and grpc probes reach the target
+// (monitor_credentials). They stay out of monitors.config and the task
+// payloads: the worker loads them for every check.
+type ProbeCredentials struct {
+	ProxyURL   string // http, https or socks5
+	ClientCert string // PEM
+	ClientKey  string // PEM
+	CABundle   string // PEM, trusted next to the system roots
+}
+
+func (c ProbeCredentials) IsZero() bool {
+	return c == ProbeCredentials{}
+}
+
+func (c ProbeCredentials) Validate() error {
+	if _, err := c.proxy(); err != nil {
+		return err
+	}
+	if _, err := c.certificate(); err != nil {
+		return err
+	}
+	if c.CABundle != "" && !x509.NewCertPool().AppendCertsFromPEM([]byte(c.CABundle)) {
+		return fmt.Errorf("ca_bundle içinde PEM sertifika yok")
+	}
+	return nil
+}
+
+func (c ProbeCredentials) proxy() (*url.URL, error) {
+	if c.ProxyURL == "" {
+		return nil, nil
+	}
+	u, err := url.Parse(c.ProxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("geçersiz proxy_url: %w", err)
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5", "socks5h":
+	default:
+		return nil, fmt.Errorf("proxy_url http://, https:// veya socks5:// ile başlamalı")
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("proxy_url host içermeli")
+	}
+	return u, nil
+}
+
+func (c ProbeCredentials) certificate() (*tls.Certificate, error) {
+	if c.ClientCert == "" && c.ClientKey == "" {
+		return nil, nil
+	}
+	if c.ClientCert == "" || c.ClientKey == "" {
+		return nil, fmt.Errorf("client_cert ve client_key birlikte verilmeli")
+	}
+	cert, err := tls.X509KeyPair([]byte(c.ClientCert), []byte(c.ClientKey))
+	if err != nil {
+		return nil, fmt.Errorf("geçersiz client sertifikası: %w", err)
+	}
+	return &cert, nil
+}
+
+// Apply sets the proxy and the TLS material on t, keeping what its
+// TLSClientConfig already has (InsecureSkipVerify of grpc monitors).
+func (c ProbeCredentials) Apply(t *http.Transport) error {
+	if c.IsZero() {
+		return nil
+	}
+	proxy, err := c.proxy()
+	if err != nil {
+		return err
+	}
+	if proxy != nil {
+		t.Proxy = http.ProxyURL(proxy)
+	}
+
+	cert, err := c.certificate()
+	if err != nil {
+		return err
+	}
+	if cert == nil && c.CABundle == "" {
+		return nil
+	}
+	cfg := &tls.Config{}
+	if t.TLSClientConfig != nil {
+		cfg = t.TLSClientConfig.Clone()
+	}
+	if cert != nil {
+		cfg.Certificates = []tls.Certificate{*cert}
+	}
+	if c.CABundle != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		pool.AppendCertsFromPEM([]byte(c.CABundle))
+		cfg.RootCAs = pool
+	}
+	t.TLSClientConfig = cfg
+	return nil
+}
+
+// Expand replaces the {{secret:<id>}} references in the credentials.
+func (c ProbeCredentials) Expand(ctx context.Context, r *secrets.Resolver) (ProbeCredentials, error) {
+	var err error
+	for _, field := range []*string{&c.ProxyURL, &c.ClientCert, &c.ClientKey, &c.CABundle} {
+		if *field, err = r.Expand(ctx, *field); err != nil {
+			return c, err
+		}
+	}
+	return c, nil
+}
+
+// Info is what the API shows of the credentials: nothing that would let
+// someone else use them. Works on the stored form, references included.
+func (c ProbeCredentials) Info() *pulsarv1.CredentialsInfo {
+	if c.IsZero() {
+		return nil
+	}
+	info := &pulsarv1.CredentialsInfo{
+		ClientCert: c.ClientCert != "",
+		CaBundle:   c.CABundle != "",
+	}
+	masked := c
+	masked.ProxyURL = secrets.MaskRefs(c.ProxyURL, "secret")
+	if proxy, err := masked.proxy(); err == nil && proxy != nil {
+		info.ProxyUrl = proxy.Redacted()
+	}
+	if block, _ := pem.Decode([]byte(c.ClientCert)); block != nil {
+		if leaf, err := x509.ParseCertificate(block.Bytes); err == nil {
+			info.ClientCertSubject = leaf.Subject.String()
+			info.ClientCertExpires = leaf.NotAfter.Unix()
+		}
+	}
+	return info
+}
+
+// credentials loads the monitor's credentials with their secrets; none set
+// is the zero value.
+func (p *PingProcessor) credentials(ctx context.Context, monitorID string) (ProbeCredentials, error) {
+	var monID pgtype.UUID
+	if err := monID.Scan(monitorID); err != nil {
+		return ProbeCredentials{}, err
+	}
+	row, err := p.queries.GetMonitorCredentials(ctx, monID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ProbeCredentials{}, nil
+	}
+	if err != nil {
+		return ProbeCredentials{}, err
+	}
+	creds, err := CredentialsFromDB(row).Expand(ctx, p.secrets.Resolver())
+	return creds, unusableSecret(err)
+}
+
+// unusableSecret marks the secrets errors retrying won't fix as
+// credentialsError; database errors stay as they are.
+func unusableSecret(err error) error {
+	if errors.Is(err, secrets.ErrNoKey) || errors.Is(err, secrets.ErrNotFound) || errors.Is(err, secrets.ErrUnreadable) {
+		return &credentialsError{err}
+	}
+	return err
+}
+
+// credentialsError, credentials that can't be used as they are: no point
+// in retrying, the check fails until someone fixes them.
+type credentialsError struct{ err error }
+
+func (e *credentialsError) Error() string { return e.err.Error() }
+func (e *credentialsError) Unwrap() error { return e.err }
+
+// loadCredentials loads the credentials of the check and makes sure they
+// can be applied.
+func (p *PingProcessor) loadCredentials(ctx context.Context, monitorID string) (ProbeCredentials, error) {
+	creds, err := p.credentials(ctx, monitorID)
+	if err != nil {
+		return creds, err
+	}
+	if err := creds.Validate(); err != nil {
+		return creds, &credentialsError{err}
+	}
+	return creds, nil
+}
+
+// credentialsFailed records a DOWN result for a check that couldn't run
+// because of its credentials, so the monitor doesn't go silent. Database
+// errors go back to asynq for a retry instead.
+func (p *PingProcessor) credentialsFailed(ctx context.Context, payload MonitorTaskPayload, err error) error {
+	var credErr *credentialsError
+	if !errors.As(err, &credErr) {
+		return fmt.Errorf("credentials okunamadı: %w", err)
+	}
+	log.Printf("🚨 Credentials: %s | %v", payload.URL, err)
+	p.results.Record(ctx, payload.MonitorID, payload.URL, CheckResult{
+		Status: "DOWN: credentials: " + err.Error(),
+		Up:     false,
+	})
+	return nil
+}
+
+func CredentialsFromDB(row db.MonitorCredential) ProbeCredentials {
+	return ProbeCredentials{
+		ProxyURL:   row.ProxyUrl,
+		ClientCert: row.ClientCert,
+		ClientKey:  row.ClientKey,
+		CABundle:   row.CaBundle,
+	}
+}
@@ -0,0 +1,188 @@
+package worker
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxDiffBytes caps the diff kept with a snapshot and sent with the event
+const maxDiffBytes = 64 << 10
+
+type diffOp struct {
+	kind byte // ' ', '-', '+'
+	line string
+}
+
+// maxDiffEdits bounds the work of diffLines: pages that changed more than
+// this are shown as replaced as a whole.
+const maxDiffEdits = 2000
+
+// diffLines is Myers' O((N+M)D) diff over lines.
+func diffLines(a, b []string) []diffOp {
+	n, m := len(a), len(b)
+	offset := n + m + 1
+	v := make([]int, 2*offset+1)
+	// trace[d] keeps v[offset-d-1 : offset+d+2], all that backtracking reads
+	var trace [][]int
+
+	for d := 0; d <= min(n+m, maxDiffEdits); d++ {
+		trace = append(trace, append([]int(nil), v[offset-d-1:offset+d+2]...))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1] // down: insertion
+			} else {
+				x = v[offset+k-1] + 1 // right: deletion
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrack(trace, a, b, d)
+			}
+		}
+	}
+
+	ops := make([]diffOp, 0, n+m)
+	for _, line := range a {
+		ops = append(ops, diffOp{'-', line})
+	}
+	for _, line := range b {
+		ops = append(ops, diffOp{'+', line})
+	}
+	return ops
+}
+
+func backtrack(trace [][]int, a, b []string, d int) []diffOp {
+	var ops []diffOp
+	x, y := len(a), len(b)
+	for ; d > 0; d-- {
+		// v[offset+k] of round d sits at trace[d][k+d+1]
+		v := func(k int) int { return trace[d][k+d+1] }
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && v(k-1) < v(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v(prevK)
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			x--
+			y--
+			ops = append(ops, diffOp{' ', a[x]})
+		}
+		if x == prevX {
+			y--
+			ops = append(ops, diffOp{'+', b[y]})
+		} else {
+			x--
+			ops = append(ops, diffOp{'-', a[x]})
+		}
+	}
+	for x > 0 && y > 0 {
+		x--
+		y--
+		ops = append(ops, diffOp{' ', a[x]})
+	}
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops
+}
+
+// unifiedDiff renders the change from a to b like diff -u, with context
+// lines around each hunk. Long diffs are cut at maxDiffBytes.
+func unifiedDiff(a, b []string, context int) (diff string, added, removed int) {
+	ops := diffLines(a, b)
+
+	var sb strings.Builder
+	sb.WriteString("--- previous\n+++ current\n")
+	for i := 0; i < len(ops); {
+		if ops[i].kind == ' ' {
+			i++
+			continue
+		}
+		// hunk: back up for context, run until context unchanged lines
+		start := max(0, i-context)
+		end := i
+		for unchanged := 0; end < len(ops) && unchanged <= 2*context; end++ {
+			if ops[end].kind == ' ' {
+				unchanged++
+			} else {
+				unchanged = 0
+			}
+		}
+		// drop the trailing context beyond what a hunk shows
+		for end > i && ops[end-1].kind == ' ' && trailing(ops[:end]) > context {
+			end--
+		}
+
+		aStart, bStart := position(ops[:start])
+		aLen, bLen := 0, 0
+		for _, op := range ops[start:end] {
+			switch op.kind {
+			case ' ':
+				aLen++
+				bLen++
+			case '-':
+				aLen++
+				removed++
+			case '+':
+				bLen++
+				added++
+			}
+		}
+		fmt.Fprintf(&sb, "@@ -%d,%d +%d,%d @@\n", hunkStart(aStart, aLen), aLen, hunkStart(bStart, bLen), bLen)
+		for _, op := range ops[start:end] {
+			if sb.Len() < maxDiffBytes {
+				sb.WriteByte(op.kind)
+				sb.WriteString(op.line)
+				sb.WriteByte('\n')
+			}
+		}
+		i = end
+	}
+	diff = sb.String()
+	if len(diff) > maxDiffBytes {
+		diff = strings.ToValidUTF8(diff[:maxDiffBytes], "") + "\n... (truncated)\n"
+	}
+	return diff, added, removed
+}
+
+// hunkStart, the 1-based first line of a hunk side; an empty side names the
+// line before it, as diff -u does.
+func hunkStart(start, n int) int {
+	if n == 0 {
+		return start
+	}
+	return start + 1
+}
+
+// trailing counts the unchanged lines at the end of ops.
+func trailing(ops []diffOp) int {
+	n := 0
+	for i := len(ops) - 1; i >= 0 && ops[i].kind == ' '; i-- {
+		n++
+	}
+	return n
+}
+
+// position returns how many lines of a and b ops covers.
+func position(ops []diffOp) (int, int) {
+	a, b := 0, 0
+	for _, op := range ops {
+		if op.kind != '+' {
+			a++
+		}
+		if op.kind != '-' {
+			b++
+		}
+	}
+	return a, b
+}
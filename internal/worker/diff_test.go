@@ -0,0 +1,144 @@
+package worker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestDiffLinesRebuilds(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		edits int
+	}{
+		{"", "", 0},
+		{"a b c", "a b c", 0},
+		{"", "a b", 2},
+		{"a b", "", 2},
+		{"a b c a b b a", "c b a b a c", 5}, // Myers' paper example
+		{"a b c d", "a x c d", 2},
+		{"a b c", "c b a", 4},
+	}
+	for _, tt := range tests {
+		a, b := strings.Fields(tt.a), strings.Fields(tt.b)
+		ops := diffLines(a, b)
+		var gotA, gotB []string
+		edits := 0
+		for _, op := range ops {
+			if op.kind != '+' {
+				gotA = append(gotA, op.line)
+			}
+			if op.kind != '-' {
+				gotB = append(gotB, op.line)
+			}
+			if op.kind != ' ' {
+				edits++
+			}
+		}
+		if strings.Join(gotA, " ") != tt.a || strings.Join(gotB, " ") != tt.b {
+			t.Errorf("diffLines(%q, %q) rebuilds %q, %q", tt.a, tt.b, gotA, gotB)
+		}
+		if edits != tt.edits {
+			t.Errorf("diffLines(%q, %q): %d edits, want %d", tt.a, tt.b, edits, tt.edits)
+		}
+	}
+}
+
+func TestDiffLinesTooManyEdits(t *testing.T) {
+	var a, b []string
+	for i := 0; i < maxDiffEdits; i++ {
+		a = append(a, fmt.Sprint("a", i))
+		b = append(b, fmt.Sprint("b", i))
+	}
+	ops := diffLines(a, b)
+	if len(ops) != 2*maxDiffEdits || ops[0].kind != '-' || ops[len(ops)-1].kind != '+' {
+		t.Fatalf("want a replaced as a whole, got %d ops", len(ops))
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	lines := func(s string) []string { return strings.Split(s, "\n") }
+	tests := []struct {
+		name, a, b, want string
+		context          int
+		added, removed   int
+	}{
+		{
+			name:    "one change",
+			context: 1,
+			a:       "1\n2\n3\n4\n5",
+			b:       "1\n2\nthree\n4\n5",
+			want:    "@@ -2,3 +2,3 @@\n 2\n-3\n+three\n 4\n",
+			added:   1, removed: 1,
+		},
+		{
+			name:    "two hunks",
+			context: 1,
+			a:       "1\n2\n3\n4\n5\n6\n7\n8\n9",
+			b:       "one\n2\n3\n4\n5\n6\n7\n8\nnine",
+			want: "@@ -1,2 +1,2 @@\n-1\n+one\n 2\n" +
+				"@@ -8,2 +8,2 @@\n 8\n-9\n+nine\n",
+			added: 2, removed: 2,
+		},
+		{
+			name:    "close changes share a hunk",
+			context: 1,
+			a:       "1\n2\n3\n4\n5",
+			b:       "one\n2\n3\nfour\n5",
+			want:    "@@ -1,5 +1,5 @@\n-1\n+one\n 2\n 3\n-4\n+four\n 5\n",
+			added:   2, removed: 2,
+		},
+		{
+			name:    "appended",
+			context: 1,
+			a:       "1\n2",
+			b:       "1\n2\n3",
+			want:    "@@ -2,1 +2,2 @@\n 2\n+3\n",
+			added:   1,
+		},
+		{
+			name:  "inserted, no context",
+			a:     "1\n2",
+			b:     "1\nx\n2",
+			want:  "@@ -1,0 +2,1 @@\n+x\n",
+			added: 1,
+		},
+		{
+			name:    "deleted, no context",
+			a:       "1\nx\n2",
+			b:       "1\n2",
+			want:    "@@ -2,1 +1,0 @@\n-x\n",
+			removed: 1,
+		},
+		{
+			name:    "from nothing",
+			context: 1,
+			a:       "",
+			b:       "x",
+			want:    "@@ -1,1 +1,1 @@\n-\n+x\n",
+			added:   1, removed: 1,
+		},
+	}
+	for _, tt := range tests {
+		diff, added, removed := unifiedDiff(lines(tt.a), lines(tt.b), tt.context)
+		want := "--- previous\n+++ current\n" + tt.want
+		if diff != want || added != tt.added || removed != tt.removed {
+			t.Errorf("%s: got +%d -%d\n%s\nwant +%d -%d\n%s", tt.name, added, removed, diff, tt.added, tt.removed, want)
+		}
+	}
+}
+
+func TestUnifiedDiffTruncated(t *testing.T) {
+	var a, b []string
+	for i := 0; i < 1000; i++ {
+		a = append(a, strings.Repeat("a", 100))
+		b = append(b, strings.Repeat("b", 100))
+	}
+	diff, added, removed := unifiedDiff(a, b, 3)
+	if !strings.HasSuffix(diff, "\n... (truncated)\n") || len(diff) > maxDiffBytes+len("\n... (truncated)\n") {
+		t.Errorf("diff of %d bytes not truncated", len(diff))
+	}
+	if added != 1000 || removed != 1000 {
+		t.Errorf("got +%d -%d, want the full counts", added, removed)
+	}
+}
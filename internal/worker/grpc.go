@@ -0,0 +1,141 @@
+package worker
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptrace"
+	"time"
+
+	"connectrpc.com/connect"
+	pulsarv1 "github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1"
+	"github.com/hibiken/asynq"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const grpcHealthCheck = "/grpc.health.v1.Health/Check"
+
+// GRPCConfig, the settings of a grpc monitor (monitors.config)
+type GRPCConfig struct {
+	Service            string `json:"service"` // empty = the whole server
+	TLS                bool   `json:"tls"`
+	InsecureSkipVerify bool   `json:"insecure_skip_verify"` // self-signed certs
+	TimeoutMs          int    `json:"timeout_ms"`
+}
+
+// WithDefaults fills the zero fields.
+func (c GRPCConfig) WithDefaults() GRPCConfig {
+	if c.TimeoutMs == 0 {
+		c.TimeoutMs = 5000
+	}
+	return c
+}
+
+func (c GRPCConfig) Validate() error {
+	if c.TimeoutMs < 100 || c.TimeoutMs > 30000 {
+		return fmt.Errorf("timeout_ms 100 ile 30000 arasında olmalı")
+	}
+	return nil
+}
+
+// ParseGRPCConfig reads monitors.config of a grpc monitor.
+func ParseGRPCConfig(raw []byte) (GRPCConfig, error) {
+	var c GRPCConfig
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &c); err != nil {
+			return c, err
+		}
+	}
+	c = c.WithDefaults()
+	return c, c.Validate()
+}
+
+// HandleGRPCTask calls grpc.health.v1.Health/Check on the host:port in the
+// url. The result goes into the usual waterfall: dns, connect and tls are
+// the dial, ttfb the Check call. status_code holds the serving status
+// (1 = SERVING), 0 when the call failed.
+func (p *PingProcessor) HandleGRPCTask(ctx context.Context, t *asynq.Task) error {
+	var payload MonitorTaskPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return err
+	}
+	if payload.URL == "" {
+		return nil
+	}
+	cfg, err := ParseGRPCConfig(payload.Config)
+	if err != nil {
+		log.Printf("⚠️ gRPC config hatası (%s): %v", payload.MonitorID, err)
+		cfg = GRPCConfig{}.WithDefaults()
+	}
+
+	creds, err := p.loadCredentials(ctx, payload.MonitorID)
+	if err != nil {
+		return p.credentialsFailed(ctx, payload, err)
+	}
+
+	serving, timing, latency, err := checkGRPC(ctx, payload.URL, cfg, creds)
+	if err != nil {
+		log.Printf("Health check failed for %s: %v", payload.URL, err)
+	}
+	status := "DOWN"
+	if serving == healthpb.HealthCheckResponse_SERVING {
+		status = "UP"
+	}
+
+	p.results.Record(ctx, payload.MonitorID, payload.URL, CheckResult{
+		StatusCode: int(serving),
+		Status:     status,
+		Up:         status == "UP",
+		Latency:    latency,
+		Timing:     timing,
+	})
+
+	if err == nil {
+		log.Printf("✅ gRPC: %s | %s | %dms", payload.URL, serving, latency.Milliseconds())
+	}
+	return nil
+}
+
+// checkGRPC makes one Check call over a fresh HTTP/2 connection (h2c
+// without TLS), tracing the phases like the HTTP checks do.
+func checkGRPC(ctx context.Context, target string, cfg GRPCConfig, creds ProbeCredentials) (healthpb.HealthCheckResponse_ServingStatus, *pulsarv1.MonitorTiming, time.Duration, error) {
+	var trace phaseTrace
+
+	protocols := new(http.Protocols)
+	scheme := "http://"
+	if cfg.TLS {
+		protocols.SetHTTP2(true)
+		scheme = "https://"
+	} else {
+		protocols.SetUnencryptedHTTP2(true)
+	}
+	transport := &http.Transport{
+		Protocols:         protocols,
+		DisableKeepAlives: true,
+		TLSClientConfig:   &tls.Config{InsecureSkipVerify: cfg.InsecureSkipVerify},
+	}
+	if err := creds.Apply(transport); err != nil {
+		return 0, nil, 0, err
+	}
+	defer transport.CloseIdleConnections()
+
+	client := connect.NewClient[healthpb.HealthCheckRequest, healthpb.HealthCheckResponse](
+		&http.Client{Transport: transport},
+		scheme+target+grpcHealthCheck,
+		connect.WithGRPC(),
+	)
+
+	ctx, cancel := context.WithTimeout(httptrace.WithClientTrace(ctx, trace.hooks()), time.Duration(cfg.TimeoutMs)*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	resp, err := client.CallUnary(ctx, connect.NewRequest(&healthpb.HealthCheckRequest{Service: cfg.Service}))
+	end := time.Now()
+	if err != nil {
+		return 0, nil, end.Sub(start), err
+	}
+	return resp.Msg.Status, trace.timing(start, end), end.Sub(start), nil
+}
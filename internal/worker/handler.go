@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"fmt"
 	"io"
 	"log"
 	"net/http"
@@ -11,22 +12,28 @@ import (
 	"strings"
 	"time"
 
+	pulsarv1 "github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1"
 	"github.com/barkinrl/pulsar/internal/db"
-	"github.com/google/uuid"
+	"github.com/barkinrl/pulsar/internal/secrets"
 	"github.com/hibiken/asynq"
-	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/redis/go-redis/v9"
 )
 
 type PingProcessor struct {
 	queries *db.Queries
 	rdb     *redis.Client
+	results *ResultRecorder
+	secrets *secrets.Store
+	tasks   *asynq.Client // follow-up checks, see HandlePushTask
 }
 
-func NewPingProcessor(queries *db.Queries, rdb *redis.Client) *PingProcessor {
+func NewPingProcessor(queries *db.Queries, rdb *redis.Client, store *secrets.Store, tasks *asynq.Client) *PingProcessor {
 	return &PingProcessor{
 		queries: queries,
 		rdb:     rdb,
+		results: NewResultRecorder(queries, rdb),
+		secrets: store,
+		tasks:   tasks,
 	}
 }
 
@@ -41,6 +48,11 @@ func (p *PingProcessor) HandlePingTask(ctx context.Context, t *asynq.Task) error
 	if targetURL == "" {
 		return nil
 	}
+	cfg, err := ParseHTTPConfig(payload.Config)
+	if err != nil {
+		log.Printf("⚠️ HTTP config hatası (%s): %v", payload.MonitorID, err)
+		cfg = HTTPConfig{}.WithDefaults()
+	}
 	if !strings.HasPrefix(targetURL, "http://") && !strings.HasPrefix(targetURL, "https://") {
 		targetURL = "https://" + targetURL
 	}
@@ -72,7 +84,15 @@ func (p *PingProcessor) HandlePingTask(ctx context.Context, t *asynq.Task) error
 		},
 	}
 
-	req, err := http.NewRequest("GET", targetURL, nil)
+	// {{secret:<id>}} references in the URL (basic auth, tokens); targetURL
+	// keeps them for the logs
+	resolver := p.secrets.Resolver()
+	requestURL, err := resolver.Expand(ctx, targetURL)
+	if err != nil {
+		return p.credentialsFailed(ctx, payload, unusableSecret(err))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
 	if err != nil {
 		log.Printf("Request creation failed: %v", err)
 		return nil
@@ -89,10 +109,19 @@ func (p *PingProcessor) HandlePingTask(ctx context.Context, t *asynq.Task) error
 	transport := &http.Transport{
 		DisableKeepAlives: true,
 	}
+	creds, err := p.loadCredentials(ctx, payload.MonitorID)
+	if err != nil {
+		return p.credentialsFailed(ctx, payload, err)
+	}
+	creds.Apply(transport) // validated above
 
+	// every hop is timed; the policy decides how far the chain goes
+	hops := &hopRecorder{next: transport}
+	var violation string
 	client := http.Client{
-		Transport: transport,
-		Timeout:   10 * time.Second,
+		Transport:     hops,
+		Timeout:       10 * time.Second,
+		CheckRedirect: cfg.Redirects.checkRedirect(&violation),
 	}
 
 	// --- START ---
@@ -103,6 +132,9 @@ func (p *PingProcessor) HandlePingTask(ctx context.Context, t *asynq.Task) error
 
 	statusCode := 0
 	status := "DOWN"
+	var body []byte
+	var readErr error
+	contentType := ""
 
 	// time calculations
 	var dnsDuration, connDuration, tlsDuration, ttfbDuration, downloadDuration float64
@@ -110,13 +142,24 @@ func (p *PingProcessor) HandlePingTask(ctx context.Context, t *asynq.Task) error
 	if err == nil {
 		statusCode = resp.StatusCode
 		status = resp.Status
+		if violation == "" && cfg.Redirects.RequireHTTPS && resp.Request.URL.Scheme != "https" {
+			violation = fmt.Sprintf("ends on %s", resp.Request.URL)
+		}
 
 		// --- OPTIMIZATION ---
-		_, copyErr := io.CopyN(io.Discard, resp.Body, 1024)
+		// the whole body only when its content is watched
+		if cfg.Content.Enabled {
+			contentType = resp.Header.Get("Content-Type")
+			// one byte over tells a cut body from one of exactly MaxBytes
+			body, readErr = io.ReadAll(io.LimitReader(resp.Body, int64(cfg.Content.MaxBytes)+1))
+		} else {
+			_, readErr = io.CopyN(io.Discard, resp.Body, 1024)
+		}
 		resp.Body.Close()
 
 		// EOF error 
-		if copyErr != nil && copyErr != io.EOF {
+		if readErr == io.EOF {
+			readErr = nil
 		}
 
 		endTime := time.Now()
@@ -159,61 +202,55 @@ func (p *PingProcessor) HandlePingTask(ctx context.Context, t *asynq.Task) error
 	} else {
 		// Complete the duration even on error
 		totalDuration = time.Since(start)
-		log.Printf("Ping failed for %s: %v", targetURL, err)
+		log.Printf("Ping failed for %s: %s", targetURL, resolver.Redact(err.Error()))
 	}
 
-	// --- 1. POSTGRESQL ---
-
-	var monID pgtype.UUID
-	monID.Scan(payload.MonitorID)
-
-	var resID pgtype.UUID
-	resID.Scan(uuid.New().String())
-
-	_, dbErr := p.queries.CreateMonitorResult(ctx, db.CreateMonitorResultParams{
-		ID:             resID,
-		MonitorID:      monID,
-		StatusCode:     int32(statusCode),
-		Status:         status,
-		Latency:        int32(totalDuration.Milliseconds()),
-		TimingDns:      int32(dnsDuration),
-		TimingTcp:      int32(connDuration),
-		TimingTls:      int32(tlsDuration),
-		TimingTtfb:     int32(ttfbDuration),
-		TimingDownload: int32(downloadDuration),
-	})
-
-	if dbErr != nil {
-		log.Printf("❌ DB Save Error: %v", dbErr)
-	}
-
-	// --- 2. LIVE DATA ---
-	updateMsg := map[string]interface{}{
-		"type": "monitor_update",
-		"data": map[string]interface{}{
-			"monitor_id": payload.MonitorID,
-			"status":     status,
-			"code":       statusCode,
-			"latency":    totalDuration.Milliseconds(),
-			"timing": map[string]float64{
-				"dns":      dnsDuration,
-				"connect":  connDuration,
-				"tls":      tlsDuration,
-				"ttfb":     ttfbDuration,
-				"download": downloadDuration,
-			},
-		},
+	up := statusCode >= 200 && statusCode < 300
+	if cfg.Redirects.Mode == RedirectNone && statusCode >= 300 && statusCode < 400 {
+		up = true // not following was asked for
 	}
-
-	msgBytes, _ := json.Marshal(updateMsg)
-
-	if err := p.rdb.Publish(ctx, "pulsar:updates", msgBytes).Err(); err != nil {
-		log.Printf("Redis Publish Error: %v", err)
+	if violation != "" {
+		violation = resolver.Redact(violation)
+		statusCode, status, up = 0, "REDIRECT: "+violation, false
+		log.Printf("🚨 Redirect policy: %s | %s", targetURL, violation)
+	}
+	// single requests are the usual case, no need to store them twice
+	var chain []RedirectHop
+	if len(hops.hops) > 1 || violation != "" {
+		chain = hops.hops
+		for i := range chain {
+			chain[i].URL = resolver.Redact(chain[i].URL)
+		}
 	}
 
+	p.results.Record(ctx, payload.MonitorID, payload.URL, CheckResult{
+		StatusCode: statusCode,
+		Status:     status,
+		Up:         up,
+		Latency:    totalDuration,
+		Redirects:  chain,
+		Timing: &pulsarv1.MonitorTiming{
+			Dns:      int32(dnsDuration),
+			Tcp:      int32(connDuration),
+			Tls:      int32(tlsDuration),
+			Ttfb:     int32(ttfbDuration),
+			Download: int32(downloadDuration),
+		},
+	})
+
 	if err == nil {
 		log.Printf("✅ Trace: %s | Total: %dms | DL: %.0fms", targetURL, totalDuration.Milliseconds(), downloadDuration)
 	}
 
+	// error pages and cut off reads say nothing about the content
+	if cfg.Content.Enabled && err == nil && readErr == nil && statusCode >= 200 && statusCode < 300 {
+		if len(body) > cfg.Content.MaxBytes {
+			// a cut page would show up as a change
+			log.Printf("⚠️ İçerik max_bytes (%d) sınırını aşıyor, karşılaştırılmadı: %s", cfg.Content.MaxBytes, payload.URL)
+		} else {
+			p.checkContent(ctx, payload, cfg.Content, contentType, body)
+		}
+	}
+
 	return nil
-}
\ No newline at end of file
+}
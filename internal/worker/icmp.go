@@ -0,0 +1,260 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"net"
+	"time"
+
+	pulsarv1 "github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1"
+	"github.com/hibiken/asynq"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+)
+
+// pause between two echo requests of a check
+const icmpInterval = 200 * time.Millisecond
+
+// ICMPConfig, the settings of an icmp monitor (monitors.config)
+type ICMPConfig struct {
+	Count        int     `json:"count"`         // echo requests per check
+	TimeoutMs    int     `json:"timeout_ms"`    // per request
+	DegradedLoss float64 `json:"degraded_loss"` // percent, DEGRADED from here
+	DownLoss     float64 `json:"down_loss"`     // percent, DOWN from here
+}
+
+// WithDefaults fills the zero fields.
+func (c ICMPConfig) WithDefaults() ICMPConfig {
+	if c.Count == 0 {
+		c.Count = 5
+	}
+	if c.TimeoutMs == 0 {
+		c.TimeoutMs = 1000
+	}
+	if c.DegradedLoss == 0 {
+		c.DegradedLoss = 20
+	}
+	if c.DownLoss == 0 {
+		c.DownLoss = 100
+	}
+	return c
+}
+
+func (c ICMPConfig) Validate() error {
+	switch {
+	case c.Count < 1 || c.Count > 20:
+		return fmt.Errorf("count 1 ile 20 arasında olmalı")
+	case c.TimeoutMs < 100 || c.TimeoutMs > 10000:
+		return fmt.Errorf("timeout_ms 100 ile 10000 arasında olmalı")
+	case c.DegradedLoss <= 0 || c.DegradedLoss > c.DownLoss || c.DownLoss > 100:
+		return fmt.Errorf("0 < degraded_loss <= down_loss <= 100 olmalı")
+	}
+	return nil
+}
+
+// ParseICMPConfig reads monitors.config of an icmp monitor.
+func ParseICMPConfig(raw []byte) (ICMPConfig, error) {
+	var c ICMPConfig
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &c); err != nil {
+			return c, err
+		}
+	}
+	c = c.WithDefaults()
+	return c, c.Validate()
+}
+
+// status decides UP/DEGRADED/DOWN from the packet loss.
+func (c ICMPConfig) status(loss float64) string {
+	switch {
+	case loss >= c.DownLoss:
+		return "DOWN"
+	case loss >= c.DegradedLoss:
+		return "DEGRADED"
+	default:
+		return "UP"
+	}
+}
+
+// icmpStats, the replies of one check
+type icmpStats struct {
+	Sent     int
+	Received int
+
+	Min, Avg, Max time.Duration
+	Jitter        time.Duration // mean difference between consecutive RTTs
+	Loss          float64       // percent
+}
+
+func (s *icmpStats) Proto() *pulsarv1.IcmpStats {
+	return &pulsarv1.IcmpStats{
+		RttMin:     durationToMs(s.Min),
+		RttAvg:     durationToMs(s.Avg),
+		RttMax:     durationToMs(s.Max),
+		Jitter:     durationToMs(s.Jitter),
+		PacketLoss: s.Loss,
+	}
+}
+
+func (p *PingProcessor) HandleICMPTask(ctx context.Context, t *asynq.Task) error {
+	var payload MonitorTaskPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return err
+	}
+	if payload.URL == "" {
+		return nil
+	}
+	cfg, err := ParseICMPConfig(payload.Config)
+	if err != nil {
+		log.Printf("⚠️ ICMP config hatası (%s): %v", payload.MonitorID, err)
+		cfg = ICMPConfig{}.WithDefaults()
+	}
+
+	stats, err := pingHost(ctx, payload.URL, cfg)
+	if err != nil {
+		log.Printf("Ping failed for %s: %v", payload.URL, err)
+	}
+	status := cfg.status(stats.Loss)
+
+	p.results.Record(ctx, payload.MonitorID, payload.URL, CheckResult{
+		Status:  status,
+		Up:      status != "DOWN",
+		Latency: stats.Avg,
+		ICMP:    &stats,
+	})
+
+	if err == nil {
+		log.Printf("✅ ICMP: %s | %s | avg %.1fms | loss %.0f%%", payload.URL, status, durationToMs(stats.Avg), stats.Loss)
+	}
+	return nil
+}
+
+// pingHost sends cfg.Count echo requests to host, one at a time. It uses an
+// unprivileged datagram socket where the kernel allows it
+// (net.ipv4.ping_group_range) and a raw socket otherwise. Whatever stops the
+// check early counts as lost packets.
+func pingHost(ctx context.Context, host string, cfg ICMPConfig) (icmpStats, error) {
+	stats := icmpStats{Sent: cfg.Count, Loss: 100}
+
+	ips, err := net.DefaultResolver.LookupIP(ctx, "ip", host)
+	if err != nil {
+		return stats, err
+	}
+	ip := ips[0]
+	for _, candidate := range ips {
+		if candidate.To4() != nil {
+			ip = candidate
+			break
+		}
+	}
+
+	conn, raw, err := listenICMP(ip.To4() == nil)
+	if err != nil {
+		return stats, err
+	}
+	defer conn.Close()
+
+	echoType, replyType, proto := icmp.Type(ipv4.ICMPTypeEcho), icmp.Type(ipv4.ICMPTypeEchoReply), 1
+	if ip.To4() == nil {
+		echoType, replyType, proto = ipv6.ICMPTypeEchoRequest, ipv6.ICMPTypeEchoReply, 58
+	}
+	var dst net.Addr = &net.UDPAddr{IP: ip}
+	if raw {
+		dst = &net.IPAddr{IP: ip}
+	}
+
+	// raw sockets see every reply on the host: tell ours apart by id.
+	// Datagram sockets get their own id from the kernel.
+	id := rand.IntN(0xffff)
+	timeout := time.Duration(cfg.TimeoutMs) * time.Millisecond
+
+	var rtts []time.Duration
+	buf := make([]byte, 1500)
+	for seq := 0; seq < cfg.Count; seq++ {
+		if seq > 0 {
+			select {
+			case <-ctx.Done():
+				return summarize(stats, rtts), ctx.Err()
+			case <-time.After(icmpInterval):
+			}
+		}
+
+		msg := icmp.Message{Type: echoType, Body: &icmp.Echo{ID: id, Seq: seq, Data: []byte("pulsar")}}
+		b, err := msg.Marshal(nil)
+		if err != nil {
+			return summarize(stats, rtts), err
+		}
+		start := time.Now()
+		if _, err := conn.WriteTo(b, dst); err != nil {
+			return summarize(stats, rtts), err
+		}
+
+		conn.SetReadDeadline(start.Add(timeout))
+		for {
+			n, _, err := conn.ReadFrom(buf)
+			if err != nil {
+				var netErr net.Error
+				if errors.As(err, &netErr) && netErr.Timeout() {
+					break // lost
+				}
+				return summarize(stats, rtts), err
+			}
+			reply, err := icmp.ParseMessage(proto, buf[:n])
+			if err != nil || reply.Type != replyType {
+				continue
+			}
+			echo, ok := reply.Body.(*icmp.Echo)
+			if !ok || echo.Seq != seq || (raw && echo.ID != id) {
+				continue
+			}
+			rtts = append(rtts, time.Since(start))
+			break
+		}
+	}
+	return summarize(stats, rtts), nil
+}
+
+func listenICMP(v6 bool) (conn *icmp.PacketConn, raw bool, err error) {
+	dgram, rawNet, addr := "udp4", "ip4:icmp", "0.0.0.0"
+	if v6 {
+		dgram, rawNet, addr = "udp6", "ip6:ipv6-icmp", "::"
+	}
+	if conn, err := icmp.ListenPacket(dgram, addr); err == nil {
+		return conn, false, nil
+	}
+	conn, err = icmp.ListenPacket(rawNet, addr)
+	if err != nil {
+		return nil, true, fmt.Errorf("icmp socket açılamadı (CAP_NET_RAW veya ping_group_range gerekli): %w", err)
+	}
+	return conn, true, nil
+}
+
+func summarize(stats icmpStats, rtts []time.Duration) icmpStats {
+	stats.Received = len(rtts)
+	if stats.Sent > 0 {
+		stats.Loss = float64(stats.Sent-stats.Received) / float64(stats.Sent) * 100
+	}
+	if len(rtts) == 0 {
+		return stats
+	}
+
+	var sum, diffs time.Duration
+	stats.Min, stats.Max = rtts[0], rtts[0]
+	for i, rtt := range rtts {
+		sum += rtt
+		stats.Min, stats.Max = min(stats.Min, rtt), max(stats.Max, rtt)
+		if i > 0 {
+			diffs += (rtt - rtts[i-1]).Abs()
+		}
+	}
+	stats.Avg = sum / time.Duration(len(rtts))
+	if len(rtts) > 1 {
+		stats.Jitter = diffs / time.Duration(len(rtts)-1)
+	}
+	return stats
+}
@@ -0,0 +1,111 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// PushConfig, the settings of a push monitor (monitors.config)
+type PushConfig struct {
+	GraceSeconds int `json:"grace_seconds"` // slack after a missed heartbeat
+}
+
+// WithDefaults fills the zero fields.
+func (c PushConfig) WithDefaults() PushConfig {
+	if c.GraceSeconds == 0 {
+		c.GraceSeconds = 60
+	}
+	return c
+}
+
+func (c PushConfig) Validate() error {
+	if c.GraceSeconds < 0 || c.GraceSeconds > 86400 {
+		return fmt.Errorf("grace_seconds 0 ile 86400 arasında olmalı")
+	}
+	return nil
+}
+
+// ParsePushConfig reads monitors.config of a push monitor.
+func ParsePushConfig(raw []byte) (PushConfig, error) {
+	var c PushConfig
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &c); err != nil {
+			return c, err
+		}
+	}
+	c = c.WithDefaults()
+	return c, c.Validate()
+}
+
+// HandlePushTask checks that a push monitor heard from its job in time. The
+// heartbeats themselves are recorded by the API; this only records the DOWN
+// results of the missed ones.
+func (p *PingProcessor) HandlePushTask(ctx context.Context, t *asynq.Task) error {
+	var payload MonitorTaskPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return err
+	}
+
+	var id pgtype.UUID
+	if err := id.Scan(payload.MonitorID); err != nil {
+		return err
+	}
+	m, err := p.queries.GetMonitor(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil // deleted meanwhile
+	}
+	if err != nil {
+		return err
+	}
+	if !m.IsActive {
+		return nil // paused since the check was scheduled
+	}
+	cfg, err := ParsePushConfig(m.Config)
+	if err != nil {
+		log.Printf("⚠️ Push config hatası (%s): %v", payload.MonitorID, err)
+		cfg = PushConfig{}.WithDefaults()
+	}
+
+	// never heard of: the period starts with the monitor
+	last := m.CreatedAt.Time
+	if m.LastHeartbeat.Valid {
+		last = m.LastHeartbeat.Time
+	}
+	deadline := last.Add(time.Duration(m.IntervalSeconds)*time.Second + time.Duration(cfg.GraceSeconds)*time.Second)
+	if time.Now().Before(deadline) {
+		// checked again right at the deadline, not an interval later
+		p.checkPushAt(payload, deadline)
+		return nil
+	}
+
+	p.results.Record(ctx, payload.MonitorID, m.Url, CheckResult{
+		Status: "DOWN",
+		Up:     false,
+	})
+	log.Printf("🚨 Heartbeat gelmedi: %s | son: %s", m.Url, last.Format(time.RFC3339))
+	return nil
+}
+
+// checkPushAt schedules the push check of the monitor at deadline. The id
+// keeps it to one task per deadline; a heartbeat meanwhile moves the
+// deadline and that task schedules the next one.
+func (p *PingProcessor) checkPushAt(payload MonitorTaskPayload, deadline time.Time) {
+	task, err := NewPushTask(payload)
+	if err == nil {
+		_, err = p.tasks.Enqueue(task,
+			asynq.ProcessAt(deadline),
+			asynq.TaskID(fmt.Sprintf("push:%s:%d", payload.MonitorID, deadline.Unix())),
+		)
+	}
+	if err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+		log.Printf("⚠️ Push kontrolü planlanamadı (%s): %v", payload.MonitorID, err)
+	}
+}
@@ -0,0 +1,114 @@
+package worker
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptrace"
+	"time"
+
+	pulsarv1 "github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1"
+)
+
+// Redirect modes (RedirectPolicy.Mode)
+const (
+	RedirectFollow = "follow"
+	RedirectNone   = "none"
+)
+
+// RedirectPolicy, how an http monitor treats redirects (monitors.config)
+type RedirectPolicy struct {
+	Mode         string `json:"mode"`
+	MaxHops      int    `json:"max_hops"`
+	RequireHTTPS bool   `json:"require_https"` // no downgrade, and the chain ends on https
+	SameHost     bool   `json:"same_host"`
+}
+
+func (r RedirectPolicy) WithDefaults() RedirectPolicy {
+	if r.Mode == "" {
+		r.Mode = RedirectFollow
+	}
+	if r.MaxHops == 0 {
+		r.MaxHops = 10
+	}
+	return r
+}
+
+func (r RedirectPolicy) Validate() error {
+	switch {
+	case r.Mode != RedirectFollow && r.Mode != RedirectNone:
+		return fmt.Errorf("redirect mode follow ya da none olmalı: %q", r.Mode)
+	case r.MaxHops < 1 || r.MaxHops > 20:
+		return fmt.Errorf("max_hops 1 ile 20 arasında olmalı")
+	}
+	return nil
+}
+
+// checkRedirect stops at the first redirect that breaks the policy; the
+// 3xx response is then the result and *violation says why.
+func (r RedirectPolicy) checkRedirect(violation *string) func(*http.Request, []*http.Request) error {
+	return func(req *http.Request, via []*http.Request) error {
+		if r.Mode == RedirectNone {
+			return http.ErrUseLastResponse
+		}
+		prev := via[len(via)-1]
+		for _, v := range via {
+			if v.URL.String() == req.URL.String() {
+				*violation = fmt.Sprintf("loop back to %s", req.URL.Redacted())
+				return http.ErrUseLastResponse
+			}
+		}
+		switch {
+		case len(via) > r.MaxHops:
+			*violation = fmt.Sprintf("more than %d redirects", r.MaxHops)
+		case r.RequireHTTPS && prev.URL.Scheme == "https" && req.URL.Scheme != "https":
+			*violation = fmt.Sprintf("https downgrade to %s", req.URL.Redacted())
+		case r.SameHost && req.URL.Hostname() != via[0].URL.Hostname():
+			*violation = fmt.Sprintf("left the host for %s", req.URL.Host)
+		default:
+			return nil
+		}
+		return http.ErrUseLastResponse
+	}
+}
+
+// RedirectHop, one request of a redirect chain
+type RedirectHop struct {
+	URL        string
+	StatusCode int // 0 when the request failed
+	Latency    time.Duration
+	Timing     *pulsarv1.MonitorTiming
+}
+
+func (h RedirectHop) Proto() *pulsarv1.RedirectHop {
+	return &pulsarv1.RedirectHop{
+		Url:     h.URL,
+		Code:    int32(h.StatusCode),
+		Latency: int32(h.Latency.Milliseconds()),
+		Timing:  h.Timing,
+	}
+}
+
+// hopRecorder times every request the client makes, so each hop of a
+// redirect chain gets its own waterfall.
+type hopRecorder struct {
+	next http.RoundTripper
+	hops []RedirectHop
+}
+
+func (h *hopRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var trace phaseTrace
+	req = req.WithContext(httptrace.WithClientTrace(req.Context(), trace.hooks()))
+
+	start := time.Now()
+	resp, err := h.next.RoundTrip(req)
+	end := time.Now()
+
+	hop := RedirectHop{URL: req.URL.Redacted(), Latency: end.Sub(start)}
+	if err == nil {
+		hop.StatusCode = resp.StatusCode
+		hop.Timing = trace.timing(start, end)
+		hop.Timing.Download = 0 // the body is read later, by the caller
+	}
+	h.hops = append(h.hops, hop)
+	return resp, err
+}
@@ -0,0 +1,119 @@
+package worker
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func redirectRequests(t *testing.T, urls ...string) []*http.Request {
+	t.Helper()
+	reqs := make([]*http.Request, len(urls))
+	for i, u := range urls {
+		req, err := http.NewRequest(http.MethodGet, u, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reqs[i] = req
+	}
+	return reqs
+}
+
+func TestCheckRedirect(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    RedirectPolicy
+		chain     []string // via..., then the next request
+		stop      bool
+		violation string
+	}{
+		{"follow", RedirectPolicy{}, []string{"http://a/1", "http://a/2"}, false, ""},
+		{"none", RedirectPolicy{Mode: RedirectNone}, []string{"http://a/1", "http://a/2"}, true, ""},
+		{"loop", RedirectPolicy{}, []string{"http://a/1", "http://a/2", "http://a/1"}, true, "loop back to http://a/1"},
+		{"loop keeps the password out", RedirectPolicy{}, []string{"http://u:p@a/1", "http://u:p@a/1"}, true, "loop back to http://u:xxxxx@a/1"},
+		{"at max hops", RedirectPolicy{MaxHops: 2}, []string{"http://a/1", "http://a/2", "http://a/3"}, false, ""},
+		{"over max hops", RedirectPolicy{MaxHops: 2}, []string{"http://a/1", "http://a/2", "http://a/3", "http://a/4"}, true, "more than 2 redirects"},
+		{"downgrade", RedirectPolicy{RequireHTTPS: true}, []string{"https://a/", "http://a/"}, true, "https downgrade to http://a/"},
+		{"http to https", RedirectPolicy{RequireHTTPS: true}, []string{"http://a/", "https://a/"}, false, ""},
+		{"downgrade allowed", RedirectPolicy{}, []string{"https://a/", "http://a/"}, false, ""},
+		{"other host", RedirectPolicy{SameHost: true}, []string{"http://a/", "http://b:8080/"}, true, "left the host for b:8080"},
+		{"same host, other port", RedirectPolicy{SameHost: true}, []string{"http://a/", "https://a:8443/"}, false, ""},
+		{"other host from the first", RedirectPolicy{SameHost: true}, []string{"http://a/", "http://a/x", "http://b/"}, true, "left the host for b"},
+	}
+	for _, tt := range tests {
+		reqs := redirectRequests(t, tt.chain...)
+		var violation string
+		err := tt.policy.WithDefaults().checkRedirect(&violation)(reqs[len(reqs)-1], reqs[:len(reqs)-1])
+		if stop := err == http.ErrUseLastResponse; stop != tt.stop || (err != nil && !stop) {
+			t.Errorf("%s: err = %v, want stop %v", tt.name, err, tt.stop)
+		}
+		if violation != tt.violation {
+			t.Errorf("%s: violation %q, want %q", tt.name, violation, tt.violation)
+		}
+	}
+}
+
+// /hop/<n> redirects to /hop/<n-1>, /hop/0 answers
+func redirectServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hop/{n}", func(w http.ResponseWriter, r *http.Request) {
+		n, _ := strconv.Atoi(r.PathValue("n"))
+		if n == 0 {
+			fmt.Fprint(w, "ok")
+			return
+		}
+		http.Redirect(w, r, "/hop/"+strconv.Itoa(n-1), http.StatusFound)
+	})
+	mux.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/pong", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/pong", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/ping", http.StatusMovedPermanently)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestRedirectChain(t *testing.T) {
+	srv := redirectServer()
+	defer srv.Close()
+
+	tests := []struct {
+		path      string
+		maxHops   int
+		status    int
+		hops      int
+		violation string
+	}{
+		{"/hop/3", 3, http.StatusOK, 4, ""},
+		{"/hop/4", 3, http.StatusFound, 4, "more than 3 redirects"},
+		{"/ping", 10, http.StatusMovedPermanently, 2, "loop back to " + srv.URL + "/ping"},
+	}
+	for _, tt := range tests {
+		var violation string
+		recorder := &hopRecorder{next: http.DefaultTransport}
+		client := &http.Client{
+			Transport:     recorder,
+			CheckRedirect: RedirectPolicy{MaxHops: tt.maxHops}.WithDefaults().checkRedirect(&violation),
+		}
+		resp, err := client.Get(srv.URL + tt.path)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.path, err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != tt.status || violation != tt.violation {
+			t.Errorf("%s: %d %q, want %d %q", tt.path, resp.StatusCode, violation, tt.status, tt.violation)
+		}
+		if len(recorder.hops) != tt.hops {
+			t.Errorf("%s: %d hops, want %d", tt.path, len(recorder.hops), tt.hops)
+		}
+		for _, hop := range recorder.hops {
+			if hop.StatusCode == 0 || hop.Timing == nil || !strings.HasPrefix(hop.URL, srv.URL) {
+				t.Errorf("%s: hop %+v", tt.path, hop)
+			}
+		}
+	}
+}
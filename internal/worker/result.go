@@ -0,0 +1,208 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	pulsarv1 "github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1"
+	"github.com/barkinrl/pulsar/internal/db"
+	"github.com/barkinrl/pulsar/internal/events"
+	"github.com/barkinrl/pulsar/internal/metrics"
+	"github.com/barkinrl/pulsar/internal/secrets"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/redis/go-redis/v9"
+)
+
+// CheckResult, the outcome of one check, whatever the monitor type
+type CheckResult struct {
+	StatusCode int // HTTP only, 0 when the request failed
+	Status     string
+	Up         bool
+	Latency    time.Duration
+
+	Timing    *pulsarv1.MonitorTiming // HTTP only, ms
+	ICMP      *icmpStats
+	Steps     []StepResult  // script only
+	Redirects []RedirectHop // HTTP only, when redirected
+}
+
+// ResultRecorder stores check results, updates the metrics and publishes
+// them to the live feed. The API uses it too, for heartbeats.
+type ResultRecorder struct {
+	queries *db.Queries
+	rdb     *redis.Client
+}
+
+func NewResultRecorder(queries *db.Queries, rdb *redis.Client) *ResultRecorder {
+	return &ResultRecorder{
+		queries: queries,
+		rdb:     rdb,
+	}
+}
+
+// Record handles one result of the monitor.
+func (rec *ResultRecorder) Record(ctx context.Context, monitorID, url string, r CheckResult) {
+	now := time.Now()
+	// goes to metrics labels and the live feed
+	url, _ = secrets.RedactURL(url)
+
+	// --- 1. POSTGRESQL ---
+	var monID pgtype.UUID
+	monID.Scan(monitorID)
+
+	var resID pgtype.UUID
+	resID.Scan(uuid.New().String())
+
+	params := db.CreateMonitorResultParams{
+		ID:         resID,
+		MonitorID:  monID,
+		StatusCode: int32(r.StatusCode),
+		Status:     r.Status,
+		Latency:    int32(r.Latency.Milliseconds()),
+	}
+	if t := r.Timing; t != nil {
+		params.TimingDns, params.TimingTcp, params.TimingTls = t.Dns, t.Tcp, t.Tls
+		params.TimingTtfb, params.TimingDownload = t.Ttfb, t.Download
+	}
+	if s := r.ICMP; s != nil {
+		params.RttMin = pgtype.Float8{Float64: durationToMs(s.Min), Valid: true}
+		params.RttAvg = pgtype.Float8{Float64: durationToMs(s.Avg), Valid: true}
+		params.RttMax = pgtype.Float8{Float64: durationToMs(s.Max), Valid: true}
+		params.Jitter = pgtype.Float8{Float64: durationToMs(s.Jitter), Valid: true}
+		params.PacketLoss = pgtype.Float8{Float64: s.Loss, Valid: true}
+	}
+	if _, dbErr := rec.queries.CreateMonitorResult(ctx, params); dbErr != nil {
+		log.Printf("❌ DB Save Error: %v", dbErr)
+	} else {
+		if len(r.Steps) > 0 {
+			if dbErr := rec.queries.CreateMonitorResultSteps(ctx, stepParams(resID, r.Steps)); dbErr != nil {
+				log.Printf("❌ DB Save Error (steps): %v", dbErr)
+			}
+		}
+		if len(r.Redirects) > 0 {
+			if dbErr := rec.queries.CreateMonitorResultRedirects(ctx, redirectParams(resID, r.Redirects)); dbErr != nil {
+				log.Printf("❌ DB Save Error (redirects): %v", dbErr)
+			}
+		}
+	}
+
+	// --- 2. METRICS ---
+	var phases map[string]time.Duration
+	if t := r.Timing; t != nil && r.StatusCode != 0 {
+		phases = map[string]time.Duration{
+			"dns":      msToDuration(float64(t.Dns)),
+			"connect":  msToDuration(float64(t.Tcp)),
+			"tls":      msToDuration(float64(t.Tls)),
+			"ttfb":     msToDuration(float64(t.Ttfb)),
+			"download": msToDuration(float64(t.Download)),
+		}
+	}
+	metrics.ObserveCheck(monitorID, url, r.StatusCode, r.Up, r.Latency, phases)
+	if s := r.ICMP; s != nil {
+		metrics.ObservePing(monitorID, s.Loss, s.Jitter)
+	}
+
+	// --- 3. LIVE DATA ---
+	update := &pulsarv1.MonitorUpdate{
+		MonitorId: monitorID,
+		Url:       url,
+		Status:    r.Status,
+		Code:      int32(r.StatusCode),
+		Latency:   int32(r.Latency.Milliseconds()),
+		Time:      now.Format(time.RFC3339),
+		Timing:    r.Timing,
+	}
+	if s := r.ICMP; s != nil {
+		update.Icmp = s.Proto()
+	}
+	for _, s := range r.Steps {
+		update.Steps = append(update.Steps, s.Proto())
+	}
+	for _, h := range r.Redirects {
+		update.Redirects = append(update.Redirects, h.Proto())
+	}
+	pubErr := events.Publish(ctx, rec.rdb, &pulsarv1.Event{
+		Payload: &pulsarv1.Event_MonitorUpdate{MonitorUpdate: update},
+	})
+	if pubErr != nil {
+		log.Printf("Redis Publish Error: %v", pubErr)
+	}
+}
+
+// PruneMetrics deletes the metric series of deleted monitors every interval,
+// until ctx is done.
+func (rec *ResultRecorder) PruneMetrics(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			ids, err := rec.queries.ListMonitorIDs(ctx)
+			if err != nil {
+				log.Printf("⚠️ Monitör listesi okunamadı (metrics): %v", err)
+				continue
+			}
+			keep := make(map[string]bool, len(ids))
+			for _, id := range ids {
+				keep[pgUUIDToString(id)] = true
+			}
+			if n := metrics.ForgetMonitorsExcept(keep); n > 0 {
+				log.Printf("🧹 Silinen %d monitörün metrikleri kaldırıldı", n)
+			}
+		}
+	}
+}
+
+func stepParams(resultID pgtype.UUID, steps []StepResult) db.CreateMonitorResultStepsParams {
+	params := db.CreateMonitorResultStepsParams{ResultID: resultID}
+	for i, s := range steps {
+		t := s.Timing
+		if t == nil {
+			t = &pulsarv1.MonitorTiming{}
+		}
+		params.Positions = append(params.Positions, int32(i))
+		params.Names = append(params.Names, s.Name)
+		params.Methods = append(params.Methods, s.Method)
+		params.Urls = append(params.Urls, s.URL)
+		params.StatusCodes = append(params.StatusCodes, int32(s.StatusCode))
+		params.Latencies = append(params.Latencies, int32(s.Latency.Milliseconds()))
+		params.Errors = append(params.Errors, s.Error)
+		params.TimingDns = append(params.TimingDns, t.Dns)
+		params.TimingTcp = append(params.TimingTcp, t.Tcp)
+		params.TimingTls = append(params.TimingTls, t.Tls)
+		params.TimingTtfb = append(params.TimingTtfb, t.Ttfb)
+		params.TimingDownload = append(params.TimingDownload, t.Download)
+	}
+	return params
+}
+
+func redirectParams(resultID pgtype.UUID, hops []RedirectHop) db.CreateMonitorResultRedirectsParams {
+	params := db.CreateMonitorResultRedirectsParams{ResultID: resultID}
+	for i, h := range hops {
+		t := h.Timing
+		if t == nil {
+			t = &pulsarv1.MonitorTiming{}
+		}
+		params.Positions = append(params.Positions, int32(i))
+		params.Urls = append(params.Urls, h.URL)
+		params.StatusCodes = append(params.StatusCodes, int32(h.StatusCode))
+		params.Latencies = append(params.Latencies, int32(h.Latency.Milliseconds()))
+		params.TimingDns = append(params.TimingDns, t.Dns)
+		params.TimingTcp = append(params.TimingTcp, t.Tcp)
+		params.TimingTls = append(params.TimingTls, t.Tls)
+		params.TimingTtfb = append(params.TimingTtfb, t.Ttfb)
+	}
+	return params
+}
+
+func msToDuration(ms float64) time.Duration {
+	return time.Duration(ms * float64(time.Millisecond))
+}
+
+func durationToMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -2,11 +2,14 @@ package worker
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"sync/atomic"
 	"time"
 
 	"github.com/barkinrl/pulsar/internal/db"
+	"github.com/barkinrl/pulsar/internal/metrics"
 	"github.com/hibiken/asynq"
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -15,6 +18,10 @@ import (
 type Poller struct {
 	queries *db.Queries
 	client  *asynq.Client
+
+	// heartbeat (unix nano) and interval, read by health checks
+	lastTick atomic.Int64
+	interval atomic.Int64
 }
 
 func NewPoller(queries *db.Queries, redisOpt asynq.RedisClientOpt) *Poller {
@@ -25,9 +32,17 @@ func NewPoller(queries *db.Queries, redisOpt asynq.RedisClientOpt) *Poller {
 	}
 }
 
+// Close releases the asynq client. Call it after Start has returned.
+func (p *Poller) Close() error {
+	return p.client.Close()
+}
+
 // Start
 func (p *Poller) Start(ctx context.Context, interval time.Duration) {
 	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	p.interval.Store(int64(interval))
+	p.lastTick.Store(time.Now().UnixNano())
 	log.Println("⏱️  Scheduler (Poller) başlatıldı...")
 
 	for {
@@ -36,11 +51,25 @@ func (p *Poller) Start(ctx context.Context, interval time.Duration) {
 			log.Println("Scheduler durduruluyor...")
 			return
 		case <-ticker.C:
+			p.lastTick.Store(time.Now().UnixNano())
 			p.enqueueDueMonitors(ctx)
 		}
 	}
 }
 
+// Check fails if the poller is not running or missed three ticks in a row.
+func (p *Poller) Check(ctx context.Context) error {
+	last := p.lastTick.Load()
+	if last == 0 {
+		return errors.New("poller not started")
+	}
+	since := time.Since(time.Unix(0, last))
+	if since > 3*time.Duration(p.interval.Load()) {
+		return fmt.Errorf("poller stalled for %s", since.Round(time.Second))
+	}
+	return nil
+}
+
 func (p *Poller) enqueueDueMonitors(ctx context.Context) {
 	monitors, err := p.queries.GetMonitorsToPing(ctx)
 	if err != nil {
@@ -56,7 +85,7 @@ func (p *Poller) enqueueDueMonitors(ctx context.Context) {
 
 
 	for _, m := range monitors {
-		task, err := NewPingTask(pgUUIDToString(m.ID), m.Url)
+		task, err := NewMonitorTask(m)
 		if err != nil {
 			log.Printf("Task oluşturma hatası: %v", err)
 			continue
@@ -64,9 +93,19 @@ func (p *Poller) enqueueDueMonitors(ctx context.Context) {
 
 		info, err := p.client.Enqueue(task)
 		if err != nil {
+			metrics.TasksEnqueued.WithLabelValues("error").Inc()
 			log.Printf("Redis kuyruk hatası: %v", err)
 		} else {
+			metrics.TasksEnqueued.WithLabelValues("success").Inc()
 			log.Printf("Task kuyruğa atıldı: %s (URL: %s)", info.ID, m.Url)
+			// push monitors: not due again before interval_seconds, every
+			// poll would record another DOWN for the same missed heartbeat
+			if m.Type != MonitorTypePush {
+				continue
+			}
+			if err := p.queries.UpdateMonitorLastCheck(ctx, m.ID); err != nil {
+				log.Printf("⚠️ last_check güncellenemedi: %v", err)
+			}
 		}
 	}
 }
@@ -0,0 +1,455 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptrace"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	pulsarv1 "github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1"
+	"github.com/barkinrl/pulsar/internal/secrets"
+	"github.com/hibiken/asynq"
+)
+
+const (
+	maxScriptSteps = 20
+	maxScriptBody  = 1 << 20 // bytes of a response kept for assertions
+)
+
+var (
+	scriptVarPattern = regexp.MustCompile(`\{\{\s*(\w+)\s*\}\}`)
+	scriptVarName    = regexp.MustCompile(`^\w+$`)
+)
+
+// ScriptConfig, the steps of a script monitor (monitors.config). They run in
+// order with one cookie jar; the first failing step ends the check.
+type ScriptConfig struct {
+	Steps     []ScriptStep `json:"steps"`
+	TimeoutMs int          `json:"timeout_ms"` // whole script
+}
+
+// ScriptStep, one request. {{name}} in URL, Headers and Body is replaced
+// with a variable extracted by an earlier step.
+type ScriptStep struct {
+	Name    string            `json:"name"`
+	Method  string            `json:"method"`
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    string            `json:"body,omitempty"`
+	Extract []ScriptExtract   `json:"extract,omitempty"`
+	Assert  []ScriptAssert    `json:"assert,omitempty"` // none = status must be 2xx
+}
+
+// ScriptExtract saves a value of the response as a variable.
+type ScriptExtract struct {
+	Var  string `json:"var"`
+	From string `json:"from"` // json, header, cookie
+	Path string `json:"path"` // dotted JSON path (items.0.id), header or cookie name
+}
+
+// ScriptAssert, a condition on the response.
+type ScriptAssert struct {
+	Source string `json:"source"` // status, header, json, body
+	Path   string `json:"path"`   // header name or JSON path
+	Op     string `json:"op"`     // eq, ne, contains, exists, lt, gt
+	Value  string `json:"value"`
+}
+
+// StepResult, the outcome of one script step
+type StepResult struct {
+	Name       string
+	Method     string
+	URL        string
+	StatusCode int // 0 when the request failed
+	Latency    time.Duration
+	Timing     *pulsarv1.MonitorTiming
+	Error      string // empty = passed
+}
+
+func (s StepResult) Proto() *pulsarv1.StepResult {
+	return &pulsarv1.StepResult{
+		Name:    s.Name,
+		Method:  s.Method,
+		Url:     s.URL,
+		Code:    int32(s.StatusCode),
+		Latency: int32(s.Latency.Milliseconds()),
+		Timing:  s.Timing,
+		Error:   s.Error,
+	}
+}
+
+// WithDefaults fills the zero fields.
+func (c ScriptConfig) WithDefaults() ScriptConfig {
+	if c.TimeoutMs == 0 {
+		c.TimeoutMs = 30000
+	}
+	steps := make([]ScriptStep, len(c.Steps))
+	for i, step := range c.Steps {
+		if step.Name == "" {
+			step.Name = fmt.Sprintf("step %d", i+1)
+		}
+		step.Method = strings.ToUpper(step.Method)
+		if step.Method == "" {
+			step.Method = http.MethodGet
+		}
+		asserts := make([]ScriptAssert, len(step.Assert))
+		for j, a := range step.Assert {
+			if a.Op == "" {
+				a.Op = "eq"
+			}
+			asserts[j] = a
+		}
+		step.Assert = asserts
+		steps[i] = step
+	}
+	c.Steps = steps
+	return c
+}
+
+func (c ScriptConfig) Validate() error {
+	if len(c.Steps) == 0 || len(c.Steps) > maxScriptSteps {
+		return fmt.Errorf("script 1 ile %d arasında adım içermeli", maxScriptSteps)
+	}
+	if c.TimeoutMs < 1000 || c.TimeoutMs > 120000 {
+		return fmt.Errorf("timeout_ms 1000 ile 120000 arasında olmalı")
+	}
+	for i, step := range c.Steps {
+		if !strings.HasPrefix(step.URL, "http://") && !strings.HasPrefix(step.URL, "https://") && !strings.HasPrefix(step.URL, "{{") {
+			return fmt.Errorf("adım %d: url http:// ya da https:// ile başlamalı", i+1)
+		}
+		for _, e := range step.Extract {
+			if !scriptVarName.MatchString(e.Var) {
+				return fmt.Errorf("adım %d: geçersiz değişken adı %q", i+1, e.Var)
+			}
+			switch e.From {
+			case "json", "header", "cookie":
+			default:
+				return fmt.Errorf("adım %d: extract from json, header ya da cookie olmalı: %q", i+1, e.From)
+			}
+			if e.Path == "" {
+				return fmt.Errorf("adım %d: %s için path gerekli", i+1, e.Var)
+			}
+		}
+		for _, a := range step.Assert {
+			switch a.Source {
+			case "status", "body":
+			case "header", "json":
+				if a.Path == "" {
+					return fmt.Errorf("adım %d: %s assert'i için path gerekli", i+1, a.Source)
+				}
+			default:
+				return fmt.Errorf("adım %d: bilinmeyen assert source %q", i+1, a.Source)
+			}
+			switch a.Op {
+			case "eq", "ne", "contains", "exists":
+			case "lt", "gt":
+				if _, err := strconv.ParseFloat(a.Value, 64); err != nil {
+					return fmt.Errorf("adım %d: %s için sayı gerekli: %q", i+1, a.Op, a.Value)
+				}
+			default:
+				return fmt.Errorf("adım %d: bilinmeyen assert op %q", i+1, a.Op)
+			}
+		}
+	}
+	return nil
+}
+
+// ParseScriptConfig reads monitors.config of a script monitor.
+func ParseScriptConfig(raw []byte) (ScriptConfig, error) {
+	var c ScriptConfig
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &c); err != nil {
+			return c, err
+		}
+	}
+	c = c.WithDefaults()
+	return c, c.Validate()
+}
+
+func (p *PingProcessor) HandleScriptTask(ctx context.Context, t *asynq.Task) error {
+	var payload MonitorTaskPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return err
+	}
+	cfg, err := ParseScriptConfig(payload.Config)
+	if err != nil {
+		// nothing sensible to run
+		log.Printf("⚠️ Script config hatası (%s): %v", payload.MonitorID, err)
+		return nil
+	}
+
+	creds, err := p.loadCredentials(ctx, payload.MonitorID)
+	if err != nil {
+		return p.credentialsFailed(ctx, payload, err)
+	}
+
+	resolver := p.secrets.Resolver()
+	start := time.Now()
+	var steps []StepResult
+	if expanded, err := cfg.expandSecrets(ctx, resolver); err != nil {
+		steps = []StepResult{{Name: cfg.Steps[0].Name, Error: err.Error()}}
+	} else {
+		steps = runScript(ctx, expanded, creds)
+	}
+	latency := time.Since(start)
+	// URLs and errors may echo what went into the request
+	for i := range steps {
+		steps[i].URL = resolver.Redact(steps[i].URL)
+		steps[i].Error = resolver.Redact(steps[i].Error)
+	}
+
+	last := steps[len(steps)-1]
+	res := CheckResult{
+		Status:  "DOWN",
+		Latency: latency,
+		Steps:   steps,
+	}
+	if last.Error == "" {
+		res.StatusCode, res.Status, res.Up = last.StatusCode, "UP", true
+		log.Printf("✅ Script: %s | %d adım | %dms", payload.URL, len(steps), latency.Milliseconds())
+	} else {
+		log.Printf("Script failed for %s at step %d (%s): %s", payload.URL, len(steps), last.Name, last.Error)
+	}
+	p.results.Record(ctx, payload.MonitorID, payload.URL, res)
+	return nil
+}
+
+// expandSecrets returns a copy of the script with its {{secret:<id>}}
+// references replaced. The stored config and the task payload only ever
+// carry the references.
+func (c ScriptConfig) expandSecrets(ctx context.Context, r *secrets.Resolver) (ScriptConfig, error) {
+	out := c
+	out.Steps = make([]ScriptStep, len(c.Steps))
+	for i, step := range c.Steps {
+		var err error
+		if step.URL, err = r.Expand(ctx, step.URL); err != nil {
+			return c, err
+		}
+		if step.Body, err = r.Expand(ctx, step.Body); err != nil {
+			return c, err
+		}
+		headers := make(map[string]string, len(step.Headers))
+		for k, v := range step.Headers {
+			if headers[k], err = r.Expand(ctx, v); err != nil {
+				return c, err
+			}
+		}
+		step.Headers = headers
+		out.Steps[i] = step
+	}
+	return out, nil
+}
+
+// runScript runs the steps until one fails; it returns at least one result.
+func runScript(ctx context.Context, cfg ScriptConfig, creds ProbeCredentials) []StepResult {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(cfg.TimeoutMs)*time.Millisecond)
+	defer cancel()
+
+	jar, _ := cookiejar.New(nil)
+	transport := &http.Transport{}
+	if err := creds.Apply(transport); err != nil {
+		return []StepResult{{Name: cfg.Steps[0].Name, Error: err.Error()}}
+	}
+	defer transport.CloseIdleConnections()
+	client := &http.Client{Transport: transport, Jar: jar}
+
+	vars := map[string]string{}
+	results := make([]StepResult, 0, len(cfg.Steps))
+	for _, step := range cfg.Steps {
+		r := runStep(ctx, client, step, vars)
+		results = append(results, r)
+		if r.Error != "" {
+			break
+		}
+	}
+	return results
+}
+
+func runStep(ctx context.Context, client *http.Client, step ScriptStep, vars map[string]string) StepResult {
+	r := StepResult{Name: step.Name, Method: step.Method, URL: step.URL}
+
+	var missing []string
+	expand := func(s string) string {
+		return scriptVarPattern.ReplaceAllStringFunc(s, func(m string) string {
+			name := scriptVarPattern.FindStringSubmatch(m)[1]
+			v, ok := vars[name]
+			if !ok {
+				missing = append(missing, name)
+			}
+			return v
+		})
+	}
+	r.URL = expand(step.URL)
+	body := expand(step.Body)
+	headers := make(map[string]string, len(step.Headers))
+	for k, v := range step.Headers {
+		headers[k] = expand(v)
+	}
+	if len(missing) > 0 {
+		r.Error = fmt.Sprintf("undefined variable: %s", strings.Join(missing, ", "))
+		return r
+	}
+
+	var trace phaseTrace
+	req, err := http.NewRequestWithContext(httptrace.WithClientTrace(ctx, trace.hooks()), step.Method, r.URL, strings.NewReader(body))
+	if err != nil {
+		r.Error = err.Error()
+		return r
+	}
+	req.Header.Set("User-Agent", "Pulsar-Monitor/1.0 (Compatible; Go-http-client/1.1; +https://github.com/barkinrl/pulsar)")
+	req.Header.Set("Accept", "*/*")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		r.Latency = time.Since(start)
+		r.Error = err.Error()
+		return r
+	}
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxScriptBody))
+	resp.Body.Close()
+	end := time.Now()
+	r.StatusCode, r.Latency, r.Timing = resp.StatusCode, end.Sub(start), trace.timing(start, end)
+	if err != nil {
+		r.Error = err.Error()
+		return r
+	}
+
+	check := stepResponse{resp: resp, body: respBody, jar: client.Jar}
+	statusChecked := false
+	for _, a := range step.Assert {
+		statusChecked = statusChecked || a.Source == "status"
+		if err := check.assert(a); err != nil {
+			r.Error = err.Error()
+			return r
+		}
+	}
+	if !statusChecked && (resp.StatusCode < 200 || resp.StatusCode > 299) {
+		r.Error = fmt.Sprintf("unexpected status %s", resp.Status)
+		return r
+	}
+	for _, e := range step.Extract {
+		v, ok := check.lookup(e.From, e.Path)
+		if !ok {
+			r.Error = fmt.Sprintf("%s %q not found for {{%s}}", e.From, e.Path, e.Var)
+			return r
+		}
+		vars[e.Var] = v
+	}
+	return r
+}
+
+// stepResponse, what assertions and extractions read from
+type stepResponse struct {
+	resp *http.Response
+	body []byte
+	jar  http.CookieJar
+
+	doc    any // body as JSON, parsed on first use
+	parsed bool
+}
+
+func (s *stepResponse) lookup(source, path string) (string, bool) {
+	switch source {
+	case "status":
+		return strconv.Itoa(s.resp.StatusCode), true
+	case "body":
+		return string(s.body), true
+	case "header":
+		values := s.resp.Header.Values(path)
+		return strings.Join(values, ", "), len(values) > 0
+	case "cookie":
+		// the jar has the cookies of every redirect, resp.Cookies() only the last
+		for _, c := range s.jar.Cookies(s.resp.Request.URL) {
+			if c.Name == path {
+				return c.Value, true
+			}
+		}
+		return "", false
+	case "json":
+		if !s.parsed {
+			dec := json.NewDecoder(bytes.NewReader(s.body))
+			dec.UseNumber() // keep big ids intact
+			if dec.Decode(&s.doc) != nil {
+				s.doc = nil
+			}
+			s.parsed = true
+		}
+		return jsonPath(s.doc, path)
+	}
+	return "", false
+}
+
+func (s *stepResponse) assert(a ScriptAssert) error {
+	actual, found := s.lookup(a.Source, a.Path)
+	what := a.Source
+	if a.Path != "" {
+		what += " " + a.Path
+	}
+	if !found {
+		return fmt.Errorf("%s not found", what)
+	}
+
+	ok := true
+	switch a.Op {
+	case "exists":
+	case "eq":
+		ok = actual == a.Value
+	case "ne":
+		ok = actual != a.Value
+	case "contains":
+		ok = strings.Contains(actual, a.Value)
+	case "lt", "gt":
+		got, err := strconv.ParseFloat(actual, 64)
+		want, _ := strconv.ParseFloat(a.Value, 64)
+		ok = err == nil && ((a.Op == "lt" && got < want) || (a.Op == "gt" && got > want))
+	}
+	if !ok {
+		if len(actual) > 100 {
+			actual = actual[:100] + "..."
+		}
+		return fmt.Errorf("%s %s %q failed, got %q", what, a.Op, a.Value, actual)
+	}
+	return nil
+}
+
+// jsonPath walks a dotted path (data.items.0.id) and returns the value as
+// text: strings as they are, anything else as JSON.
+func jsonPath(doc any, path string) (string, bool) {
+	cur := doc
+	for _, key := range strings.Split(path, ".") {
+		switch node := cur.(type) {
+		case map[string]any:
+			v, ok := node[key]
+			if !ok {
+				return "", false
+			}
+			cur = v
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(node) {
+				return "", false
+			}
+			cur = node[i]
+		default:
+			return "", false
+		}
+	}
+	if s, ok := cur.(string); ok {
+		return s, true
+	}
+	b, err := json.Marshal(cur)
+	return string(b), err == nil
+}
@@ -0,0 +1,150 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestJSONPath(t *testing.T) {
+	raw := `{"data": {"items": [{"id": 9007199254740993, "name": "a"}, {"tags": ["x", "y"]}], "ok": true, "none": null}}`
+	dec := json.NewDecoder(strings.NewReader(raw))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		path, want string
+		found      bool
+	}{
+		{"data.items.0.id", "9007199254740993", true}, // big ids intact
+		{"data.items.0.name", "a", true},
+		{"data.items.1.tags.1", "y", true},
+		{"data.items.1.tags", `["x","y"]`, true},
+		{"data.ok", "true", true},
+		{"data.none", "null", true},
+		{"data.items.2", "", false},
+		{"data.items.-1", "", false},
+		{"data.items.first", "", false},
+		{"data.missing", "", false},
+		{"data.ok.deeper", "", false},
+	}
+	for _, tt := range tests {
+		got, found := jsonPath(doc, tt.path)
+		if got != tt.want || found != tt.found {
+			t.Errorf("jsonPath(%q) = %q, %v; want %q, %v", tt.path, got, found, tt.want, tt.found)
+		}
+	}
+	if _, found := jsonPath(nil, "a"); found {
+		t.Error("jsonPath on a body that isn't JSON found something")
+	}
+}
+
+func TestStepAssert(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+	jar, _ := cookiejar.New(nil)
+	jar.SetCookies(req.URL, []*http.Cookie{{Name: "session", Value: "abc"}})
+	resp := &http.Response{
+		StatusCode: 201,
+		Header:     http.Header{"Content-Type": {"application/json"}, "X-Count": {"12"}},
+		Request:    req,
+	}
+	body := []byte(`{"user": {"name": "bob", "age": 42}, "items": []}`)
+
+	tests := []struct {
+		a    ScriptAssert
+		fail string // empty = passes
+	}{
+		{ScriptAssert{Source: "status", Op: "eq", Value: "201"}, ""},
+		{ScriptAssert{Source: "status", Op: "eq", Value: "200"}, `status eq "200" failed, got "201"`},
+		{ScriptAssert{Source: "status", Op: "lt", Value: "300"}, ""},
+		{ScriptAssert{Source: "header", Path: "content-type", Op: "contains", Value: "json"}, ""},
+		{ScriptAssert{Source: "header", Path: "X-Count", Op: "gt", Value: "20"}, `header X-Count gt "20" failed, got "12"`},
+		{ScriptAssert{Source: "header", Path: "X-Missing", Op: "exists"}, "header X-Missing not found"},
+		{ScriptAssert{Source: "json", Path: "user.name", Op: "eq", Value: "bob"}, ""},
+		{ScriptAssert{Source: "json", Path: "user.name", Op: "ne", Value: "bob"}, `json user.name ne "bob" failed, got "bob"`},
+		{ScriptAssert{Source: "json", Path: "user.age", Op: "gt", Value: "18"}, ""},
+		{ScriptAssert{Source: "json", Path: "user.name", Op: "lt", Value: "5"}, `json user.name lt "5" failed, got "bob"`},
+		{ScriptAssert{Source: "json", Path: "items", Op: "eq", Value: "[]"}, ""},
+		{ScriptAssert{Source: "json", Path: "items.0", Op: "exists"}, "json items.0 not found"},
+		{ScriptAssert{Source: "body", Op: "contains", Value: `"bob"`}, ""},
+		{ScriptAssert{Source: "cookie", Path: "session", Op: "eq", Value: "abc"}, ""},
+	}
+	for _, tt := range tests {
+		check := stepResponse{resp: resp, body: body, jar: jar}
+		err := check.assert(tt.a)
+		if got := fmt.Sprint(err); (tt.fail == "" && err != nil) || (tt.fail != "" && got != tt.fail) {
+			t.Errorf("assert %+v = %v, want %q", tt.a, err, tt.fail)
+		}
+	}
+
+	check := stepResponse{resp: resp, body: bytes.Repeat([]byte("x"), 500), jar: jar}
+	err := check.assert(ScriptAssert{Source: "body", Op: "eq", Value: "y"})
+	if err == nil || !strings.HasSuffix(err.Error(), `..."`) || len(err.Error()) > 200 {
+		t.Errorf("long body not cut in %v", err)
+	}
+}
+
+func TestRunScript(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /login", func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "sid", Value: "s1", Path: "/"})
+		fmt.Fprint(w, `{"token": "t1", "user": {"id": 7}}`)
+	})
+	mux.HandleFunc("GET /users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		sid, err := r.Cookie("sid")
+		if r.Header.Get("Authorization") != "Bearer t1" || err != nil || sid.Value != "s1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprintf(w, `{"id": %s}`, r.PathValue("id"))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	login := ScriptStep{
+		Name: "login", Method: "POST", URL: srv.URL + "/login",
+		Extract: []ScriptExtract{{Var: "token", From: "json", Path: "token"}, {Var: "uid", From: "json", Path: "user.id"}},
+	}
+	profile := ScriptStep{
+		Name: "profile", URL: srv.URL + "/users/{{uid}}",
+		Headers: map[string]string{"Authorization": "Bearer {{ token }}"},
+		Assert:  []ScriptAssert{{Source: "json", Path: "id", Value: "7"}},
+	}
+
+	tests := []struct {
+		name   string
+		steps  []ScriptStep
+		errors []string // per step run
+	}{
+		{"variables and cookies carry over", []ScriptStep{login, profile}, []string{"", ""}},
+		{"undefined variable", []ScriptStep{profile, login}, []string{"undefined variable: uid, token"}},
+		{"non-2xx fails without a status assert", []ScriptStep{{URL: srv.URL + "/users/7"}, login}, []string{"unexpected status 401 Unauthorized"}},
+		{"status assert takes over", []ScriptStep{{URL: srv.URL + "/users/7", Assert: []ScriptAssert{{Source: "status", Value: "401"}}}}, []string{""}},
+		{"missing extract", []ScriptStep{{Method: "POST", URL: srv.URL + "/login", Extract: []ScriptExtract{{Var: "x", From: "header", Path: "X-Token"}}}}, []string{`header "X-Token" not found for {{x}}`}},
+	}
+	for _, tt := range tests {
+		cfg := ScriptConfig{Steps: tt.steps}.WithDefaults()
+		if err := cfg.Validate(); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		results := runScript(context.Background(), cfg, ProbeCredentials{})
+		if len(results) != len(tt.errors) {
+			t.Errorf("%s: %d steps ran, want %d", tt.name, len(results), len(tt.errors))
+			continue
+		}
+		for i, r := range results {
+			if r.Error != tt.errors[i] {
+				t.Errorf("%s: step %d error %q, want %q", tt.name, i+1, r.Error, tt.errors[i])
+			}
+		}
+	}
+}
@@ -3,16 +3,33 @@ package worker
 import (
 	"encoding/json"
 
+	"github.com/barkinrl/pulsar/internal/db"
 	"github.com/hibiken/asynq"
 )
 
 
-const TypePingMonitor = "monitor:ping"
+const (
+	TypePingMonitor   = "monitor:ping"
+	TypeICMPMonitor   = "monitor:icmp"
+	TypePushMonitor   = "monitor:push"
+	TypeGRPCMonitor   = "monitor:grpc"
+	TypeScriptMonitor = "monitor:script"
+)
+
+// Monitor types (monitors.type)
+const (
+	MonitorTypeHTTP   = "http"
+	MonitorTypeICMP   = "icmp"
+	MonitorTypePush   = "push" // heartbeats from the job, see HandlePushTask
+	MonitorTypeGRPC   = "grpc"
+	MonitorTypeScript = "script" // multi-step HTTP, see ScriptConfig
+)
 
 
 type MonitorTaskPayload struct {
-	MonitorID string `json:"monitor_id"`
-	URL       string `json:"url"`
+	MonitorID string          `json:"monitor_id"`
+	URL       string          `json:"url"`
+	Config    json.RawMessage `json:"config,omitempty"` // monitors.config, per type
 }
 
 func NewPingTask(monitorID string, url string) (*asynq.Task, error) {
@@ -24,4 +41,37 @@ func NewPingTask(monitorID string, url string) (*asynq.Task, error) {
 		return nil, err
 	}
 	return asynq.NewTask(TypePingMonitor, payload), nil
-}
\ No newline at end of file
+}
+
+func NewPushTask(payload MonitorTaskPayload) (*asynq.Task, error) {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypePushMonitor, raw), nil
+}
+
+// NewMonitorTask builds the check task of a monitor, by its type.
+func NewMonitorTask(m db.Monitor) (*asynq.Task, error) {
+	taskType := TypePingMonitor
+	switch m.Type {
+	case MonitorTypeICMP:
+		taskType = TypeICMPMonitor
+	case MonitorTypePush:
+		taskType = TypePushMonitor
+	case MonitorTypeGRPC:
+		taskType = TypeGRPCMonitor
+	case MonitorTypeScript:
+		taskType = TypeScriptMonitor
+	}
+
+	payload, err := json.Marshal(MonitorTaskPayload{
+		MonitorID: pgUUIDToString(m.ID),
+		URL:       m.Url,
+		Config:    m.Config,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(taskType, payload), nil
+}
@@ -0,0 +1,60 @@
+package worker
+
+import (
+	"crypto/tls"
+	"net/http/httptrace"
+	"time"
+
+	pulsarv1 "github.com/barkinrl/pulsar/gen/go/proto/pulsar/v1"
+)
+
+// phaseTrace collects the waterfall of one request.
+type phaseTrace struct {
+	dnsStart, dnsDone   time.Time
+	connStart, connDone time.Time
+	tlsStart, tlsDone   time.Time
+	gotFirstByte        time.Time
+}
+
+func (pt *phaseTrace) hooks() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		DNSStart:     func(_ httptrace.DNSStartInfo) { pt.dnsStart = time.Now() },
+		DNSDone:      func(_ httptrace.DNSDoneInfo) { pt.dnsDone = time.Now() },
+		ConnectStart: func(_, _ string) { pt.connStart = time.Now() },
+		ConnectDone: func(_, _ string, err error) {
+			if err == nil {
+				pt.connDone = time.Now()
+			}
+		},
+		TLSHandshakeStart:    func() { pt.tlsStart = time.Now() },
+		TLSHandshakeDone:     func(_ tls.ConnectionState, _ error) { pt.tlsDone = time.Now() },
+		GotFirstResponseByte: func() { pt.gotFirstByte = time.Now() },
+	}
+}
+
+// timing turns the trace into ms. Phases that didn't happen (a reused
+// connection has no dns, connect or tls) stay 0.
+func (pt *phaseTrace) timing(start, end time.Time) *pulsarv1.MonitorTiming {
+	timing := &pulsarv1.MonitorTiming{}
+	if !pt.dnsStart.IsZero() && !pt.dnsDone.IsZero() {
+		timing.Dns = int32(pt.dnsDone.Sub(pt.dnsStart).Milliseconds())
+	}
+	if !pt.connStart.IsZero() && !pt.connDone.IsZero() {
+		timing.Tcp = int32(pt.connDone.Sub(pt.connStart).Milliseconds())
+	}
+	if !pt.tlsStart.IsZero() && !pt.tlsDone.IsZero() {
+		timing.Tls = int32(pt.tlsDone.Sub(pt.tlsStart).Milliseconds())
+	}
+	// the request goes out once the connection is up
+	sent := start
+	for _, t := range []time.Time{pt.connDone, pt.tlsDone} {
+		if t.After(sent) {
+			sent = t
+		}
+	}
+	if !pt.gotFirstByte.IsZero() {
+		timing.Ttfb = int32(pt.gotFirstByte.Sub(sent).Milliseconds())
+		timing.Download = int32(end.Sub(pt.gotFirstByte).Milliseconds())
+	}
+	return timing
+}
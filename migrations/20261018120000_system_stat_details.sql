@@ -0,0 +1,68 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+-- 1. Load Averages
+ALTER TABLE system_stats
+    ADD COLUMN load_1 DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ADD COLUMN load_5 DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ADD COLUMN load_15 DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+-- 2. Per-Mountpoint Disk Usage & IO
+CREATE TABLE system_disk_stats (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    system_stat_id UUID NOT NULL REFERENCES system_stats(id) ON DELETE CASCADE,
+
+    mountpoint TEXT NOT NULL,
+    device TEXT NOT NULL,
+    fstype TEXT NOT NULL,
+    used_bytes BIGINT NOT NULL,
+    total_bytes BIGINT NOT NULL,
+    used_percent DOUBLE PRECISION NOT NULL,
+    read_kb_s DOUBLE PRECISION NOT NULL DEFAULT 0,
+    write_kb_s DOUBLE PRECISION NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_system_disk_stats_stat ON system_disk_stats(system_stat_id);
+CREATE INDEX idx_system_disk_stats_mount_created ON system_disk_stats(mountpoint, created_at DESC);
+
+-- 3. Per-Interface Network Throughput
+CREATE TABLE system_net_stats (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    system_stat_id UUID NOT NULL REFERENCES system_stats(id) ON DELETE CASCADE,
+
+    interface TEXT NOT NULL,
+    rx_kb_s DOUBLE PRECISION NOT NULL,
+    tx_kb_s DOUBLE PRECISION NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_system_net_stats_stat ON system_net_stats(system_stat_id);
+CREATE INDEX idx_system_net_stats_iface_created ON system_net_stats(interface, created_at DESC);
+
+-- 4. Per-Core CPU Usage
+CREATE TABLE system_cpu_stats (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    system_stat_id UUID NOT NULL REFERENCES system_stats(id) ON DELETE CASCADE,
+
+    core INT NOT NULL,
+    percent DOUBLE PRECISION NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_system_cpu_stats_stat ON system_cpu_stats(system_stat_id);
+
+
+-- +goose Down
+-- SQL in section 'Down' is executed when this migration is rolled back
+
+DROP TABLE IF EXISTS system_cpu_stats;
+DROP TABLE IF EXISTS system_net_stats;
+DROP TABLE IF EXISTS system_disk_stats;
+ALTER TABLE system_stats
+    DROP COLUMN IF EXISTS load_15,
+    DROP COLUMN IF EXISTS load_5,
+    DROP COLUMN IF EXISTS load_1;
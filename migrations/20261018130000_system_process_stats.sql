@@ -0,0 +1,28 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+-- Top Process Snapshots (by CPU and RSS)
+CREATE TABLE system_process_stats (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    system_stat_id UUID NOT NULL REFERENCES system_stats(id) ON DELETE CASCADE,
+
+    pid INT NOT NULL,
+    name TEXT NOT NULL,
+    username TEXT NOT NULL,
+    state TEXT NOT NULL,
+    threads INT NOT NULL,
+    cpu_percent DOUBLE PRECISION NOT NULL,
+    rss_bytes BIGINT NOT NULL,
+    memory_percent DOUBLE PRECISION NOT NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_system_process_stats_stat ON system_process_stats(system_stat_id);
+CREATE INDEX idx_system_process_stats_created ON system_process_stats(created_at DESC);
+
+
+-- +goose Down
+-- SQL in section 'Down' is executed when this migration is rolled back
+
+DROP TABLE IF EXISTS system_process_stats;
@@ -0,0 +1,43 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+-- 1. System Alert Rules
+CREATE TABLE alert_rules (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name TEXT NOT NULL,
+    metric TEXT NOT NULL,
+    comparison TEXT NOT NULL DEFAULT '>' CHECK (comparison IN ('>', '>=', '<', '<=')),
+    threshold DOUBLE PRECISION NOT NULL,
+    duration_seconds INT NOT NULL DEFAULT 0, -- how long the condition must hold
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- The old hardcoded thresholds
+INSERT INTO alert_rules (name, metric, comparison, threshold, duration_seconds) VALUES
+    ('High CPU', 'cpu_percent', '>', 80, 60),
+    ('High Memory', 'memory_percent', '>', 90, 60),
+    ('Disk Almost Full', 'disk_percent', '>', 95, 0),
+    ('Too Many Processes', 'threads_total', '>', 3000, 0);
+
+-- 2. Incidents (one open incident per rule at most)
+CREATE TABLE incidents (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    rule_id UUID NOT NULL REFERENCES alert_rules(id) ON DELETE CASCADE,
+
+    value DOUBLE PRECISION NOT NULL, -- when it was opened
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL, -- when the condition began
+    opened_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX idx_incidents_open ON incidents(rule_id) WHERE resolved_at IS NULL;
+CREATE INDEX idx_incidents_started ON incidents(started_at DESC);
+
+
+-- +goose Down
+-- SQL in section 'Down' is executed when this migration is rolled back
+
+DROP TABLE IF EXISTS incidents;
+DROP TABLE IF EXISTS alert_rules;
@@ -0,0 +1,34 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+-- 1. System Stats Keyed By Host ('local' = the worker's own host)
+ALTER TABLE system_stats ADD COLUMN host TEXT NOT NULL DEFAULT 'local';
+CREATE INDEX idx_system_stats_host_created ON system_stats(host, created_at DESC);
+
+-- 2. Incidents Per Host
+ALTER TABLE incidents ADD COLUMN host TEXT NOT NULL DEFAULT 'local';
+DROP INDEX idx_incidents_open;
+CREATE UNIQUE INDEX idx_incidents_open ON incidents(rule_id, host) WHERE resolved_at IS NULL;
+
+-- 3. Remote Agents (token is only stored hashed)
+CREATE TABLE agents (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    host TEXT NOT NULL UNIQUE,
+    token_hash TEXT NOT NULL UNIQUE, -- sha256, hex
+    last_seen_at TIMESTAMP WITH TIME ZONE,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+
+-- +goose Down
+-- SQL in section 'Down' is executed when this migration is rolled back
+
+DROP TABLE IF EXISTS agents;
+DROP INDEX IF EXISTS idx_incidents_open;
+DELETE FROM incidents WHERE host <> 'local';
+CREATE UNIQUE INDEX idx_incidents_open ON incidents(rule_id) WHERE resolved_at IS NULL;
+ALTER TABLE incidents DROP COLUMN IF EXISTS host;
+DROP INDEX IF EXISTS idx_system_stats_host_created;
+DELETE FROM system_stats WHERE host <> 'local';
+ALTER TABLE system_stats DROP COLUMN IF EXISTS host;
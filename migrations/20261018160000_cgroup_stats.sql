@@ -0,0 +1,30 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+-- 1. Per-Cgroup (Container / Service) Usage
+CREATE TABLE cgroup_stats (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    system_stat_id UUID NOT NULL REFERENCES system_stats(id) ON DELETE CASCADE,
+
+    path TEXT NOT NULL,
+    container_id TEXT NOT NULL DEFAULT '',
+    cpu_percent DOUBLE PRECISION NOT NULL,
+    cpu_limit DOUBLE PRECISION NOT NULL DEFAULT 0, -- cores, 0 = unlimited
+    memory_bytes BIGINT NOT NULL,
+    memory_limit BIGINT NOT NULL DEFAULT 0,
+    io_read_kb_s DOUBLE PRECISION NOT NULL DEFAULT 0,
+    io_write_kb_s DOUBLE PRECISION NOT NULL DEFAULT 0,
+    pids INTEGER NOT NULL,
+    pids_limit INTEGER NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_cgroup_stats_stat ON cgroup_stats(system_stat_id);
+CREATE INDEX idx_cgroup_stats_created ON cgroup_stats(created_at DESC);
+
+
+-- +goose Down
+-- SQL in section 'Down' is executed when this migration is rolled back
+
+DROP TABLE IF EXISTS cgroup_stats;
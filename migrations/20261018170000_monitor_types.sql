@@ -0,0 +1,30 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+-- 1. Monitor Types ('http', 'icmp') & Their Settings
+ALTER TABLE monitors
+    ADD COLUMN type TEXT NOT NULL DEFAULT 'http',
+    ADD COLUMN config JSONB NOT NULL DEFAULT '{}';
+
+-- 2. ICMP Results (ms / percent, NULL for other types)
+ALTER TABLE monitor_results
+    ADD COLUMN rtt_min DOUBLE PRECISION,
+    ADD COLUMN rtt_avg DOUBLE PRECISION,
+    ADD COLUMN rtt_max DOUBLE PRECISION,
+    ADD COLUMN jitter DOUBLE PRECISION,
+    ADD COLUMN packet_loss DOUBLE PRECISION;
+
+
+-- +goose Down
+-- SQL in section 'Down' is executed when this migration is rolled back
+
+ALTER TABLE monitor_results
+    DROP COLUMN IF EXISTS packet_loss,
+    DROP COLUMN IF EXISTS jitter,
+    DROP COLUMN IF EXISTS rtt_max,
+    DROP COLUMN IF EXISTS rtt_avg,
+    DROP COLUMN IF EXISTS rtt_min;
+DELETE FROM monitors WHERE type <> 'http';
+ALTER TABLE monitors
+    DROP COLUMN IF EXISTS config,
+    DROP COLUMN IF EXISTS type;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+-- 1. Push (Heartbeat) Monitors: secret of the /push/<token> URL & last ping
+ALTER TABLE monitors
+    ADD COLUMN push_token TEXT UNIQUE,
+    ADD COLUMN last_heartbeat TIMESTAMP WITH TIME ZONE;
+
+
+-- +goose Down
+-- SQL in section 'Down' is executed when this migration is rolled back
+
+DELETE FROM monitors WHERE type = 'push';
+ALTER TABLE monitors
+    DROP COLUMN IF EXISTS last_heartbeat,
+    DROP COLUMN IF EXISTS push_token;
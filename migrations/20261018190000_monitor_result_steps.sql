@@ -0,0 +1,34 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+-- 1. Script Monitor Steps (children of one monitor_results row)
+CREATE TABLE monitor_result_steps (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    result_id UUID NOT NULL REFERENCES monitor_results(id) ON DELETE CASCADE,
+
+    position INTEGER NOT NULL, -- 0-based, in script order
+    name TEXT NOT NULL,
+    method TEXT NOT NULL,
+    url TEXT NOT NULL, -- after variable substitution
+    status_code INTEGER NOT NULL, -- 0 when the request failed
+    latency INTEGER NOT NULL,
+    error TEXT NOT NULL DEFAULT '', -- empty = passed
+
+    -- Waterfall (Trace)
+    timing_dns INTEGER NOT NULL DEFAULT 0,
+    timing_tcp INTEGER NOT NULL DEFAULT 0,
+    timing_tls INTEGER NOT NULL DEFAULT 0,
+    timing_ttfb INTEGER NOT NULL DEFAULT 0,
+    timing_download INTEGER NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_result_steps_result ON monitor_result_steps(result_id, position);
+
+
+-- +goose Down
+-- SQL in section 'Down' is executed when this migration is rolled back
+
+DROP TABLE IF EXISTS monitor_result_steps;
+DELETE FROM monitors WHERE type = 'script';
@@ -0,0 +1,23 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+-- 1. Content Snapshots (http monitors with content change detection)
+-- A row per change: the first look at the page and every differing one.
+CREATE TABLE content_snapshots (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    monitor_id UUID NOT NULL REFERENCES monitors(id) ON DELETE CASCADE,
+
+    hash TEXT NOT NULL, -- sha256 of content
+    content TEXT NOT NULL, -- normalized text
+    diff TEXT NOT NULL DEFAULT '', -- unified diff against the previous snapshot
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_content_snapshots_monitor ON content_snapshots(monitor_id, created_at DESC);
+
+
+-- +goose Down
+-- SQL in section 'Down' is executed when this migration is rolled back
+
+DROP TABLE IF EXISTS content_snapshots;
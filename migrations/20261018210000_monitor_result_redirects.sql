@@ -0,0 +1,29 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+-- 1. Redirect Chains (http results that were redirected or broke the policy)
+CREATE TABLE monitor_result_redirects (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    result_id UUID NOT NULL REFERENCES monitor_results(id) ON DELETE CASCADE,
+
+    position INTEGER NOT NULL, -- 0 = the monitor's own URL
+    url TEXT NOT NULL,
+    status_code INTEGER NOT NULL, -- 0 when the request failed
+    latency INTEGER NOT NULL, -- until the response headers
+
+    -- Waterfall (Trace)
+    timing_dns INTEGER NOT NULL DEFAULT 0,
+    timing_tcp INTEGER NOT NULL DEFAULT 0,
+    timing_tls INTEGER NOT NULL DEFAULT 0,
+    timing_ttfb INTEGER NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_result_redirects_result ON monitor_result_redirects(result_id, position);
+
+
+-- +goose Down
+-- SQL in section 'Down' is executed when this migration is rolled back
+
+DROP TABLE IF EXISTS monitor_result_redirects;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+-- 1. Probe Credentials (proxy & client certificates, never copied into monitors.config)
+CREATE TABLE monitor_credentials (
+    monitor_id UUID PRIMARY KEY REFERENCES monitors(id) ON DELETE CASCADE,
+
+    proxy_url TEXT NOT NULL DEFAULT '', -- http, https or socks5, may carry user:password
+    client_cert TEXT NOT NULL DEFAULT '', -- PEM
+    client_key TEXT NOT NULL DEFAULT '', -- PEM
+    ca_bundle TEXT NOT NULL DEFAULT '', -- PEM, trusted next to the system roots
+
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+
+-- +goose Down
+-- SQL in section 'Down' is executed when this migration is rolled back
+
+DROP TABLE IF EXISTS monitor_credentials;
@@ -0,0 +1,24 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+-- 1. Secrets (envelope encrypted, referenced as {{secret:<id>}})
+CREATE TABLE secrets (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name TEXT NOT NULL UNIQUE,
+    monitor_id UUID REFERENCES monitors(id) ON DELETE CASCADE, -- set for secrets sealed from a monitor's credentials
+
+    ciphertext BYTEA NOT NULL, -- nonce || AES-256-GCM(data key, value)
+    wrapped_key BYTEA NOT NULL, -- nonce || AES-256-GCM(master key, data key)
+    key_id TEXT NOT NULL, -- fingerprint of the master key
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_secrets_key ON secrets(key_id);
+
+
+-- +goose Down
+-- SQL in section 'Down' is executed when this migration is rolled back
+
+DROP TABLE IF EXISTS secrets;
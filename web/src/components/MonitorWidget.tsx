@@ -33,6 +33,23 @@ interface MonitorTiming {
   download: number;
 }
 
+// script monitors: one per step, up to the one that failed
+interface StepResult {
+  name: string;
+  method: string;
+  url: string;
+  code: number;
+  latency: number;
+  error: string;
+}
+
+// http monitors: every request when the check was redirected
+interface RedirectHop {
+  url: string;
+  code: number;
+  latency: number;
+}
+
 interface Props {
   monitor: any;
   onDelete: (id: string) => void;
@@ -48,6 +65,8 @@ interface ChartDataPoint {
   code: number;
   status: string;
   timing?: MonitorTiming;
+  steps?: StepResult[];
+  redirects?: RedirectHop[];
 }
 
 // --- SETTINGS ---
@@ -162,6 +181,68 @@ const WaterfallBar = ({
   );
 };
 
+// Script steps
+const StepList = ({ steps }: { steps: StepResult[] }) => (
+  <div className="mt-6 p-4 bg-gray-900/40 rounded-xl border border-gray-800/50">
+    <h3 className="text-[10px] font-bold text-gray-500 uppercase tracking-widest mb-3 flex items-center gap-2">
+      <Activity size={12} /> Script Steps
+    </h3>
+    <div className="flex flex-col gap-2 text-[11px] font-mono">
+      {steps.map((step, i) => (
+        <div key={i} className="flex flex-col gap-0.5">
+          <div className="flex items-center gap-2">
+            <span
+              className={`w-2 h-2 rounded-full ${
+                step.error ? "bg-rose-500" : "bg-emerald-500"
+              }`}
+            ></span>
+            <span className="text-gray-200 font-bold">{step.name}</span>
+            <span className="text-gray-500 truncate" title={step.url}>
+              {step.method} {step.url}
+            </span>
+            <span className="ml-auto text-gray-400">
+              {step.code || "-"} · {step.latency}ms
+            </span>
+          </div>
+          {step.error && (
+            <span className="pl-4 text-rose-400 break-all">{step.error}</span>
+          )}
+        </div>
+      ))}
+    </div>
+  </div>
+);
+
+// Redirect chain
+const RedirectChain = ({ hops }: { hops: RedirectHop[] }) => (
+  <div className="mt-6 p-4 bg-gray-900/40 rounded-xl border border-gray-800/50">
+    <h3 className="text-[10px] font-bold text-gray-500 uppercase tracking-widest mb-3 flex items-center gap-2">
+      <CornerDownRight size={12} /> Redirect Chain
+    </h3>
+    <div className="flex flex-col gap-1 text-[11px] font-mono">
+      {hops.map((hop, i) => (
+        <div key={i} className="flex items-center gap-2">
+          <span
+            className={
+              hop.code >= 300 && hop.code < 400
+                ? "text-amber-400"
+                : hop.code >= 200 && hop.code < 300
+                ? "text-emerald-400"
+                : "text-rose-400"
+            }
+          >
+            {hop.code || "ERR"}
+          </span>
+          <span className="text-gray-300 truncate" title={hop.url}>
+            {hop.url}
+          </span>
+          <span className="ml-auto text-gray-500">{hop.latency}ms</span>
+        </div>
+      ))}
+    </div>
+  </div>
+);
+
 export function MonitorWidget({
   monitor,
   onDelete,
@@ -213,6 +294,23 @@ export function MonitorWidget({
                   download: s.timing.download,
                 }
               : undefined,
+            steps: s.steps.length
+              ? s.steps.map((st) => ({
+                  name: st.name,
+                  method: st.method,
+                  url: st.url,
+                  code: st.code,
+                  latency: st.latency,
+                  error: st.error,
+                }))
+              : undefined,
+            redirects: s.redirects.length
+              ? s.redirects.map((h) => ({
+                  url: h.url,
+                  code: h.code,
+                  latency: h.latency,
+                }))
+              : undefined,
           };
         });
         setHistory(historicalData.reverse());
@@ -240,6 +338,8 @@ export function MonitorWidget({
           fullDate: dateStr,
           timestamp: now.getTime(),
           timing: liveData.timing,
+          steps: liveData.steps,
+          redirects: liveData.redirects,
         },
       ];
       if (newData.length > MAX_HISTORY_SIZE)
@@ -451,7 +551,19 @@ export function MonitorWidget({
             </ResponsiveContainer>
           </div>
 
-          {activeDisplayData && activeDisplayData.timing && (
+          {activeDisplayData && activeDisplayData.redirects && (
+            <div className="mb-4 animate-in fade-in slide-in-from-bottom-4 duration-500">
+              <RedirectChain hops={activeDisplayData.redirects} />
+            </div>
+          )}
+
+          {activeDisplayData && activeDisplayData.steps && (
+            <div className="mb-4 animate-in fade-in slide-in-from-bottom-4 duration-500">
+              <StepList steps={activeDisplayData.steps} />
+            </div>
+          )}
+
+          {activeDisplayData && !activeDisplayData.steps && activeDisplayData.timing && (
             <div className="mb-4 animate-in fade-in slide-in-from-bottom-4 duration-500">
               <WaterfallBar
                 timing={activeDisplayData.timing}
@@ -621,8 +733,11 @@ export function MonitorWidget({
             {monitor.children && monitor.children.length > 0 ? (
               monitor.children.map((child: any) => {
                 const childData = allLiveData ? allLiveData[child.id] : null;
+                // icmp monitors have no code, only UP/DEGRADED/DOWN
                 const isUp = childData
-                  ? childData.code >= 200 && childData.code < 300
+                  ? (childData.code >= 200 && childData.code < 300) ||
+                    childData.status === "UP" ||
+                    childData.status === "DEGRADED"
                   : true;
                 const latency = childData ? childData.latency : 0;
                 return (
@@ -0,0 +1,101 @@
+// @generated by protoc-gen-es v1.10.1 with parameter "target=ts"
+// @generated from file proto/pulsar/v1/events.proto (package pulsar.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { ContentChange, Incident, MonitorUpdate, SystemStatsResponse } from "./monitor_pb.js";
+
+/**
+ * Event, what the worker publishes on the pulsar:events Redis stream
+ * (binary protobuf). schema_version only changes on breaking changes;
+ * adding fields keeps it.
+ *
+ * @generated from message pulsar.v1.Event
+ */
+export class Event extends Message<Event> {
+  /**
+   * @generated from field: uint32 schema_version = 1;
+   */
+  schemaVersion = 0;
+
+  /**
+   * unix ms
+   *
+   * @generated from field: int64 published_at = 2;
+   */
+  publishedAt = protoInt64.zero;
+
+  /**
+   * bus-wide, assigned by the publisher
+   *
+   * @generated from field: int64 seq = 3;
+   */
+  seq = protoInt64.zero;
+
+  /**
+   * @generated from oneof pulsar.v1.Event.payload
+   */
+  payload: {
+    /**
+     * @generated from field: pulsar.v1.MonitorUpdate monitor_update = 10;
+     */
+    value: MonitorUpdate;
+    case: "monitorUpdate";
+  } | {
+    /**
+     * @generated from field: pulsar.v1.SystemStatsResponse system = 11;
+     */
+    value: SystemStatsResponse;
+    case: "system";
+  } | {
+    /**
+     * opened or resolved
+     *
+     * @generated from field: pulsar.v1.Incident incident = 12;
+     */
+    value: Incident;
+    case: "incident";
+  } | {
+    /**
+     * @generated from field: pulsar.v1.ContentChange content_change = 13;
+     */
+    value: ContentChange;
+    case: "contentChange";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<Event>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.Event";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "schema_version", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "published_at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "seq", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "monitor_update", kind: "message", T: MonitorUpdate, oneof: "payload" },
+    { no: 11, name: "system", kind: "message", T: SystemStatsResponse, oneof: "payload" },
+    { no: 12, name: "incident", kind: "message", T: Incident, oneof: "payload" },
+    { no: 13, name: "content_change", kind: "message", T: ContentChange, oneof: "payload" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Event {
+    return new Event().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Event {
+    return new Event().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Event {
+    return new Event().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Event | PlainMessage<Event> | undefined, b: Event | PlainMessage<Event> | undefined): boolean {
+    return proto3.util.equals(Event, a, b);
+  }
+}
+
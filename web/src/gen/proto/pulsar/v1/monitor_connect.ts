@@ -3,8 +3,8 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateMonitorRequest, CreateMonitorResponse, DeleteMonitorRequest, DeleteMonitorResponse, GetMonitorStatsRequest, GetMonitorStatsResponse, ListMonitorsRequest, ListMonitorsResponse, SystemStatsResponse } from "./monitor_pb.js";
-import { Empty, MethodKind } from "@bufbuild/protobuf";
+import { CreateAlertRuleRequest, CreateAlertRuleResponse, CreateMonitorRequest, CreateMonitorResponse, CreateSecretRequest, CreateSecretResponse, DeleteAgentRequest, DeleteAgentResponse, DeleteAlertRuleRequest, DeleteAlertRuleResponse, DeleteMonitorRequest, DeleteMonitorResponse, DeleteSecretRequest, DeleteSecretResponse, GetCgroupStatsRequest, GetCgroupStatsResponse, GetMonitorStatsRequest, GetMonitorStatsResponse, GetProcessSnapshotRequest, GetProcessSnapshotResponse, GetSystemStatsHistoryRequest, GetSystemStatsHistoryResponse, GetSystemStatsRequest, IngestSystemStatsRequest, IngestSystemStatsResponse, ListAgentsRequest, ListAgentsResponse, ListAlertRulesRequest, ListAlertRulesResponse, ListIncidentsRequest, ListIncidentsResponse, ListMonitorsRequest, ListMonitorsResponse, ListSecretsRequest, ListSecretsResponse, MonitorUpdate, RegisterAgentRequest, RegisterAgentResponse, SetMonitorCredentialsRequest, SetMonitorCredentialsResponse, SystemStatsResponse, UpdateAlertRuleRequest, UpdateAlertRuleResponse, UpdateSecretRequest, UpdateSecretResponse, WatchMonitorsRequest } from "./monitor_pb.js";
+import { MethodKind } from "@bufbuild/protobuf";
 
 /**
  * @generated from service pulsar.v1.MonitorService
@@ -39,6 +39,17 @@ export const MonitorService = {
       O: DeleteMonitorResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Replaces the proxy & client certificate settings; empty clears them.
+     *
+     * @generated from rpc pulsar.v1.MonitorService.SetMonitorCredentials
+     */
+    setMonitorCredentials: {
+      name: "SetMonitorCredentials",
+      I: SetMonitorCredentialsRequest,
+      O: SetMonitorCredentialsResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Geçmiş verileri (History) çekmek için
      *
@@ -57,10 +68,169 @@ export const MonitorService = {
      */
     getSystemStats: {
       name: "GetSystemStats",
-      I: Empty,
+      I: GetSystemStatsRequest,
       O: SystemStatsResponse,
       kind: MethodKind.ServerStreaming,
     },
+    /**
+     * @generated from rpc pulsar.v1.MonitorService.GetSystemStatsHistory
+     */
+    getSystemStatsHistory: {
+      name: "GetSystemStatsHistory",
+      I: GetSystemStatsHistoryRequest,
+      O: GetSystemStatsHistoryResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pulsar.v1.MonitorService.WatchMonitors
+     */
+    watchMonitors: {
+      name: "WatchMonitors",
+      I: WatchMonitorsRequest,
+      O: MonitorUpdate,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * @generated from rpc pulsar.v1.MonitorService.GetProcessSnapshot
+     */
+    getProcessSnapshot: {
+      name: "GetProcessSnapshot",
+      I: GetProcessSnapshotRequest,
+      O: GetProcessSnapshotResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pulsar.v1.MonitorService.GetCgroupStats
+     */
+    getCgroupStats: {
+      name: "GetCgroupStats",
+      I: GetCgroupStatsRequest,
+      O: GetCgroupStatsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pulsar.v1.MonitorService.ListAlertRules
+     */
+    listAlertRules: {
+      name: "ListAlertRules",
+      I: ListAlertRulesRequest,
+      O: ListAlertRulesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pulsar.v1.MonitorService.CreateAlertRule
+     */
+    createAlertRule: {
+      name: "CreateAlertRule",
+      I: CreateAlertRuleRequest,
+      O: CreateAlertRuleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pulsar.v1.MonitorService.UpdateAlertRule
+     */
+    updateAlertRule: {
+      name: "UpdateAlertRule",
+      I: UpdateAlertRuleRequest,
+      O: UpdateAlertRuleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pulsar.v1.MonitorService.DeleteAlertRule
+     */
+    deleteAlertRule: {
+      name: "DeleteAlertRule",
+      I: DeleteAlertRuleRequest,
+      O: DeleteAlertRuleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pulsar.v1.MonitorService.ListIncidents
+     */
+    listIncidents: {
+      name: "ListIncidents",
+      I: ListIncidentsRequest,
+      O: ListIncidentsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Remote agents: RegisterAgent returns the token IngestSystemStats expects
+     * as "Authorization: Bearer <token>".
+     *
+     * @generated from rpc pulsar.v1.MonitorService.RegisterAgent
+     */
+    registerAgent: {
+      name: "RegisterAgent",
+      I: RegisterAgentRequest,
+      O: RegisterAgentResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pulsar.v1.MonitorService.ListAgents
+     */
+    listAgents: {
+      name: "ListAgents",
+      I: ListAgentsRequest,
+      O: ListAgentsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pulsar.v1.MonitorService.DeleteAgent
+     */
+    deleteAgent: {
+      name: "DeleteAgent",
+      I: DeleteAgentRequest,
+      O: DeleteAgentResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pulsar.v1.MonitorService.IngestSystemStats
+     */
+    ingestSystemStats: {
+      name: "IngestSystemStats",
+      I: IngestSystemStatsRequest,
+      O: IngestSystemStatsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Secrets are referenced from monitor configs as {{secret:<id>}}; their
+     * values are never returned.
+     *
+     * @generated from rpc pulsar.v1.MonitorService.ListSecrets
+     */
+    listSecrets: {
+      name: "ListSecrets",
+      I: ListSecretsRequest,
+      O: ListSecretsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pulsar.v1.MonitorService.CreateSecret
+     */
+    createSecret: {
+      name: "CreateSecret",
+      I: CreateSecretRequest,
+      O: CreateSecretResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pulsar.v1.MonitorService.UpdateSecret
+     */
+    updateSecret: {
+      name: "UpdateSecret",
+      I: UpdateSecretRequest,
+      O: UpdateSecretResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc pulsar.v1.MonitorService.DeleteSecret
+     */
+    deleteSecret: {
+      name: "DeleteSecret",
+      I: DeleteSecretRequest,
+      O: DeleteSecretResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -37,6 +37,69 @@ export class Monitor extends Message<Monitor> {
    */
   lastCheck = protoInt64.zero;
 
+  /**
+   * "http", "icmp", "push", "grpc" or "script"
+   *
+   * @generated from field: string type = 6;
+   */
+  type = "";
+
+  /**
+   * type icmp only
+   *
+   * @generated from field: pulsar.v1.IcmpConfig icmp = 7;
+   */
+  icmp?: IcmpConfig;
+
+  /**
+   * type push only, where the job reports
+   *
+   * @generated from field: string push_url = 8;
+   */
+  pushUrl = "";
+
+  /**
+   * type push only
+   *
+   * @generated from field: pulsar.v1.PushConfig push = 9;
+   */
+  push?: PushConfig;
+
+  /**
+   * type grpc only
+   *
+   * @generated from field: pulsar.v1.GrpcConfig grpc = 10;
+   */
+  grpc?: GrpcConfig;
+
+  /**
+   * type script only
+   *
+   * @generated from field: pulsar.v1.ScriptConfig script = 11;
+   */
+  script?: ScriptConfig;
+
+  /**
+   * type http only
+   *
+   * @generated from field: pulsar.v1.ContentCheck content = 12;
+   */
+  content?: ContentCheck;
+
+  /**
+   * type http only
+   *
+   * @generated from field: pulsar.v1.RedirectPolicy redirects = 13;
+   */
+  redirects?: RedirectPolicy;
+
+  /**
+   * what is set, never the secrets themselves
+   *
+   * @generated from field: pulsar.v1.CredentialsInfo credentials = 14;
+   */
+  credentials?: CredentialsInfo;
+
   constructor(data?: PartialMessage<Monitor>) {
     super();
     proto3.util.initPartial(data, this);
@@ -50,6 +113,15 @@ export class Monitor extends Message<Monitor> {
     { no: 3, name: "interval_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 4, name: "is_active", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 5, name: "last_check", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "icmp", kind: "message", T: IcmpConfig },
+    { no: 8, name: "push_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "push", kind: "message", T: PushConfig },
+    { no: 10, name: "grpc", kind: "message", T: GrpcConfig },
+    { no: 11, name: "script", kind: "message", T: ScriptConfig },
+    { no: 12, name: "content", kind: "message", T: ContentCheck },
+    { no: 13, name: "redirects", kind: "message", T: RedirectPolicy },
+    { no: 14, name: "credentials", kind: "message", T: CredentialsInfo },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Monitor {
@@ -70,753 +142,4466 @@ export class Monitor extends Message<Monitor> {
 }
 
 /**
- * @generated from message pulsar.v1.CreateMonitorRequest
+ * ICMP monitors ping the host in url; zero values take the defaults.
+ *
+ * @generated from message pulsar.v1.IcmpConfig
  */
-export class CreateMonitorRequest extends Message<CreateMonitorRequest> {
+export class IcmpConfig extends Message<IcmpConfig> {
   /**
-   * @generated from field: string url = 1;
+   * echo requests per check, default 5
+   *
+   * @generated from field: int32 count = 1;
    */
-  url = "";
+  count = 0;
 
   /**
-   * @generated from field: int32 interval_seconds = 2;
+   * per request, default 1000
+   *
+   * @generated from field: int32 timeout_ms = 2;
    */
-  intervalSeconds = 0;
+  timeoutMs = 0;
 
-  constructor(data?: PartialMessage<CreateMonitorRequest>) {
+  /**
+   * percent, DEGRADED from here, default 20
+   *
+   * @generated from field: double degraded_loss = 3;
+   */
+  degradedLoss = 0;
+
+  /**
+   * percent, DOWN from here, default 100
+   *
+   * @generated from field: double down_loss = 4;
+   */
+  downLoss = 0;
+
+  constructor(data?: PartialMessage<IcmpConfig>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "pulsar.v1.CreateMonitorRequest";
+  static readonly typeName = "pulsar.v1.IcmpConfig";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "interval_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 1, name: "count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "timeout_ms", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "degraded_loss", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 4, name: "down_loss", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateMonitorRequest {
-    return new CreateMonitorRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IcmpConfig {
+    return new IcmpConfig().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateMonitorRequest {
-    return new CreateMonitorRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IcmpConfig {
+    return new IcmpConfig().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateMonitorRequest {
-    return new CreateMonitorRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IcmpConfig {
+    return new IcmpConfig().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateMonitorRequest | PlainMessage<CreateMonitorRequest> | undefined, b: CreateMonitorRequest | PlainMessage<CreateMonitorRequest> | undefined): boolean {
-    return proto3.util.equals(CreateMonitorRequest, a, b);
+  static equals(a: IcmpConfig | PlainMessage<IcmpConfig> | undefined, b: IcmpConfig | PlainMessage<IcmpConfig> | undefined): boolean {
+    return proto3.util.equals(IcmpConfig, a, b);
   }
 }
 
 /**
- * @generated from message pulsar.v1.CreateMonitorResponse
+ * Push monitors wait for the job to call push_url at least once every
+ * interval_seconds; they go DOWN grace_seconds after a missed heartbeat.
+ *
+ * @generated from message pulsar.v1.PushConfig
  */
-export class CreateMonitorResponse extends Message<CreateMonitorResponse> {
+export class PushConfig extends Message<PushConfig> {
   /**
-   * @generated from field: pulsar.v1.Monitor monitor = 1;
+   * default 60
+   *
+   * @generated from field: int32 grace_seconds = 1;
    */
-  monitor?: Monitor;
+  graceSeconds = 0;
 
-  constructor(data?: PartialMessage<CreateMonitorResponse>) {
+  constructor(data?: PartialMessage<PushConfig>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "pulsar.v1.CreateMonitorResponse";
+  static readonly typeName = "pulsar.v1.PushConfig";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "monitor", kind: "message", T: Monitor },
+    { no: 1, name: "grace_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateMonitorResponse {
-    return new CreateMonitorResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PushConfig {
+    return new PushConfig().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateMonitorResponse {
-    return new CreateMonitorResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PushConfig {
+    return new PushConfig().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateMonitorResponse {
-    return new CreateMonitorResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PushConfig {
+    return new PushConfig().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateMonitorResponse | PlainMessage<CreateMonitorResponse> | undefined, b: CreateMonitorResponse | PlainMessage<CreateMonitorResponse> | undefined): boolean {
-    return proto3.util.equals(CreateMonitorResponse, a, b);
+  static equals(a: PushConfig | PlainMessage<PushConfig> | undefined, b: PushConfig | PlainMessage<PushConfig> | undefined): boolean {
+    return proto3.util.equals(PushConfig, a, b);
   }
 }
 
 /**
- * @generated from message pulsar.v1.ListMonitorsRequest
+ * How http monitors treat redirects. Breaking the policy makes the check
+ * DOWN with a "REDIRECT: ..." status.
+ *
+ * @generated from message pulsar.v1.RedirectPolicy
  */
-export class ListMonitorsRequest extends Message<ListMonitorsRequest> {
-  constructor(data?: PartialMessage<ListMonitorsRequest>) {
+export class RedirectPolicy extends Message<RedirectPolicy> {
+  /**
+   * "follow" (default) or "none": 3xx answers are the result and count as UP
+   *
+   * @generated from field: string mode = 1;
+   */
+  mode = "";
+
+  /**
+   * default 10
+   *
+   * @generated from field: int32 max_hops = 2;
+   */
+  maxHops = 0;
+
+  /**
+   * no https -> http hop, and the final URL must be https
+   *
+   * @generated from field: bool require_https = 3;
+   */
+  requireHttps = false;
+
+  /**
+   * every hop stays on the monitor's host
+   *
+   * @generated from field: bool same_host = 4;
+   */
+  sameHost = false;
+
+  constructor(data?: PartialMessage<RedirectPolicy>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "pulsar.v1.ListMonitorsRequest";
+  static readonly typeName = "pulsar.v1.RedirectPolicy";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "mode", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "max_hops", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "require_https", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "same_host", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMonitorsRequest {
-    return new ListMonitorsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RedirectPolicy {
+    return new RedirectPolicy().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListMonitorsRequest {
-    return new ListMonitorsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RedirectPolicy {
+    return new RedirectPolicy().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListMonitorsRequest {
-    return new ListMonitorsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RedirectPolicy {
+    return new RedirectPolicy().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListMonitorsRequest | PlainMessage<ListMonitorsRequest> | undefined, b: ListMonitorsRequest | PlainMessage<ListMonitorsRequest> | undefined): boolean {
-    return proto3.util.equals(ListMonitorsRequest, a, b);
+  static equals(a: RedirectPolicy | PlainMessage<RedirectPolicy> | undefined, b: RedirectPolicy | PlainMessage<RedirectPolicy> | undefined): boolean {
+    return proto3.util.equals(RedirectPolicy, a, b);
   }
 }
 
 /**
- * @generated from message pulsar.v1.ListMonitorsResponse
+ * Content change detection for http monitors: the worker reads the whole
+ * body, keeps a normalized snapshot and publishes a ContentChange when it
+ * differs from the last one.
+ *
+ * @generated from message pulsar.v1.ContentCheck
  */
-export class ListMonitorsResponse extends Message<ListMonitorsResponse> {
+export class ContentCheck extends Message<ContentCheck> {
   /**
-   * @generated from field: repeated pulsar.v1.Monitor monitors = 1;
+   * @generated from field: bool enabled = 1;
    */
-  monitors: Monitor[] = [];
+  enabled = false;
 
-  constructor(data?: PartialMessage<ListMonitorsResponse>) {
+  /**
+   * body size cap, default 1 MiB
+   *
+   * @generated from field: int32 max_bytes = 2;
+   */
+  maxBytes = 0;
+
+  /**
+   * HTML elements left out: tag, #id, .class, [attr=value]
+   *
+   * @generated from field: repeated string ignore_selectors = 3;
+   */
+  ignoreSelectors: string[] = [];
+
+  /**
+   * regexps cut out of the text
+   *
+   * @generated from field: repeated string ignore_patterns = 4;
+   */
+  ignorePatterns: string[] = [];
+
+  constructor(data?: PartialMessage<ContentCheck>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "pulsar.v1.ListMonitorsResponse";
+  static readonly typeName = "pulsar.v1.ContentCheck";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "monitors", kind: "message", T: Monitor, repeated: true },
+    { no: 1, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "max_bytes", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "ignore_selectors", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "ignore_patterns", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMonitorsResponse {
-    return new ListMonitorsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ContentCheck {
+    return new ContentCheck().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListMonitorsResponse {
-    return new ListMonitorsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ContentCheck {
+    return new ContentCheck().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListMonitorsResponse {
-    return new ListMonitorsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ContentCheck {
+    return new ContentCheck().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListMonitorsResponse | PlainMessage<ListMonitorsResponse> | undefined, b: ListMonitorsResponse | PlainMessage<ListMonitorsResponse> | undefined): boolean {
-    return proto3.util.equals(ListMonitorsResponse, a, b);
+  static equals(a: ContentCheck | PlainMessage<ContentCheck> | undefined, b: ContentCheck | PlainMessage<ContentCheck> | undefined): boolean {
+    return proto3.util.equals(ContentCheck, a, b);
   }
 }
 
 /**
- * @generated from message pulsar.v1.DeleteMonitorRequest
+ * gRPC monitors call grpc.health.v1.Health/Check on the host:port in url.
+ * SERVING is UP, anything else DOWN.
+ *
+ * @generated from message pulsar.v1.GrpcConfig
  */
-export class DeleteMonitorRequest extends Message<DeleteMonitorRequest> {
+export class GrpcConfig extends Message<GrpcConfig> {
   /**
-   * @generated from field: string monitor_id = 1;
+   * empty = the whole server
+   *
+   * @generated from field: string service = 1;
    */
-  monitorId = "";
+  service = "";
 
-  constructor(data?: PartialMessage<DeleteMonitorRequest>) {
+  /**
+   * plaintext (h2c) otherwise
+   *
+   * @generated from field: bool tls = 2;
+   */
+  tls = false;
+
+  /**
+   * @generated from field: bool insecure_skip_verify = 3;
+   */
+  insecureSkipVerify = false;
+
+  /**
+   * default 5000
+   *
+   * @generated from field: int32 timeout_ms = 4;
+   */
+  timeoutMs = 0;
+
+  constructor(data?: PartialMessage<GrpcConfig>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "pulsar.v1.DeleteMonitorRequest";
+  static readonly typeName = "pulsar.v1.GrpcConfig";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "monitor_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "service", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "tls", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "insecure_skip_verify", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "timeout_ms", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteMonitorRequest {
-    return new DeleteMonitorRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GrpcConfig {
+    return new GrpcConfig().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteMonitorRequest {
-    return new DeleteMonitorRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GrpcConfig {
+    return new GrpcConfig().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteMonitorRequest {
-    return new DeleteMonitorRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GrpcConfig {
+    return new GrpcConfig().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteMonitorRequest | PlainMessage<DeleteMonitorRequest> | undefined, b: DeleteMonitorRequest | PlainMessage<DeleteMonitorRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteMonitorRequest, a, b);
+  static equals(a: GrpcConfig | PlainMessage<GrpcConfig> | undefined, b: GrpcConfig | PlainMessage<GrpcConfig> | undefined): boolean {
+    return proto3.util.equals(GrpcConfig, a, b);
   }
 }
 
 /**
- * @generated from message pulsar.v1.DeleteMonitorResponse
+ * Script monitors run HTTP steps in order with one cookie jar, like a user
+ * going through login -> search -> checkout. The check is DOWN at the first
+ * step that fails.
+ *
+ * @generated from message pulsar.v1.ScriptConfig
  */
-export class DeleteMonitorResponse extends Message<DeleteMonitorResponse> {
+export class ScriptConfig extends Message<ScriptConfig> {
   /**
-   * @generated from field: bool success = 1;
+   * @generated from field: repeated pulsar.v1.ScriptStep steps = 1;
    */
-  success = false;
+  steps: ScriptStep[] = [];
 
-  constructor(data?: PartialMessage<DeleteMonitorResponse>) {
+  /**
+   * whole script, default 30000
+   *
+   * @generated from field: int32 timeout_ms = 2;
+   */
+  timeoutMs = 0;
+
+  constructor(data?: PartialMessage<ScriptConfig>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "pulsar.v1.DeleteMonitorResponse";
+  static readonly typeName = "pulsar.v1.ScriptConfig";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "success", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "steps", kind: "message", T: ScriptStep, repeated: true },
+    { no: 2, name: "timeout_ms", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteMonitorResponse {
-    return new DeleteMonitorResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScriptConfig {
+    return new ScriptConfig().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteMonitorResponse {
-    return new DeleteMonitorResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScriptConfig {
+    return new ScriptConfig().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteMonitorResponse {
-    return new DeleteMonitorResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScriptConfig {
+    return new ScriptConfig().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteMonitorResponse | PlainMessage<DeleteMonitorResponse> | undefined, b: DeleteMonitorResponse | PlainMessage<DeleteMonitorResponse> | undefined): boolean {
-    return proto3.util.equals(DeleteMonitorResponse, a, b);
+  static equals(a: ScriptConfig | PlainMessage<ScriptConfig> | undefined, b: ScriptConfig | PlainMessage<ScriptConfig> | undefined): boolean {
+    return proto3.util.equals(ScriptConfig, a, b);
   }
 }
 
 /**
- * @generated from message pulsar.v1.GetMonitorStatsRequest
+ * {{name}} in url, headers and body is replaced with an extracted variable,
+ * {{secret:<id>}} with a secret. Credential headers (Authorization, Cookie,
+ * API keys...) only take secrets.
+ *
+ * @generated from message pulsar.v1.ScriptStep
  */
-export class GetMonitorStatsRequest extends Message<GetMonitorStatsRequest> {
+export class ScriptStep extends Message<ScriptStep> {
   /**
-   * @generated from field: string monitor_id = 1;
+   * @generated from field: string name = 1;
    */
-  monitorId = "";
+  name = "";
 
-  constructor(data?: PartialMessage<GetMonitorStatsRequest>) {
+  /**
+   * default GET
+   *
+   * @generated from field: string method = 2;
+   */
+  method = "";
+
+  /**
+   * @generated from field: string url = 3;
+   */
+  url = "";
+
+  /**
+   * @generated from field: map<string, string> headers = 4;
+   */
+  headers: { [key: string]: string } = {};
+
+  /**
+   * @generated from field: string body = 5;
+   */
+  body = "";
+
+  /**
+   * @generated from field: repeated pulsar.v1.ScriptExtract extract = 6;
+   */
+  extract: ScriptExtract[] = [];
+
+  /**
+   * none = the status must be 2xx
+   *
+   * @generated from field: repeated pulsar.v1.ScriptAssert assert = 7;
+   */
+  assert: ScriptAssert[] = [];
+
+  constructor(data?: PartialMessage<ScriptStep>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "pulsar.v1.GetMonitorStatsRequest";
+  static readonly typeName = "pulsar.v1.ScriptStep";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "monitor_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "method", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "headers", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 5, name: "body", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "extract", kind: "message", T: ScriptExtract, repeated: true },
+    { no: 7, name: "assert", kind: "message", T: ScriptAssert, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMonitorStatsRequest {
-    return new GetMonitorStatsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScriptStep {
+    return new ScriptStep().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMonitorStatsRequest {
-    return new GetMonitorStatsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScriptStep {
+    return new ScriptStep().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMonitorStatsRequest {
-    return new GetMonitorStatsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScriptStep {
+    return new ScriptStep().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetMonitorStatsRequest | PlainMessage<GetMonitorStatsRequest> | undefined, b: GetMonitorStatsRequest | PlainMessage<GetMonitorStatsRequest> | undefined): boolean {
-    return proto3.util.equals(GetMonitorStatsRequest, a, b);
+  static equals(a: ScriptStep | PlainMessage<ScriptStep> | undefined, b: ScriptStep | PlainMessage<ScriptStep> | undefined): boolean {
+    return proto3.util.equals(ScriptStep, a, b);
   }
 }
 
 /**
- * @generated from message pulsar.v1.GetMonitorStatsResponse
+ * @generated from message pulsar.v1.ScriptExtract
  */
-export class GetMonitorStatsResponse extends Message<GetMonitorStatsResponse> {
+export class ScriptExtract extends Message<ScriptExtract> {
   /**
-   * @generated from field: repeated pulsar.v1.MonitorStat stats = 1;
+   * @generated from field: string var = 1;
    */
-  stats: MonitorStat[] = [];
+  var = "";
 
-  constructor(data?: PartialMessage<GetMonitorStatsResponse>) {
+  /**
+   * "json", "header" or "cookie"
+   *
+   * @generated from field: string from = 2;
+   */
+  from = "";
+
+  /**
+   * dotted JSON path (items.0.id), header or cookie name
+   *
+   * @generated from field: string path = 3;
+   */
+  path = "";
+
+  constructor(data?: PartialMessage<ScriptExtract>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "pulsar.v1.GetMonitorStatsResponse";
+  static readonly typeName = "pulsar.v1.ScriptExtract";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "stats", kind: "message", T: MonitorStat, repeated: true },
+    { no: 1, name: "var", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "from", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMonitorStatsResponse {
-    return new GetMonitorStatsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScriptExtract {
+    return new ScriptExtract().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMonitorStatsResponse {
-    return new GetMonitorStatsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScriptExtract {
+    return new ScriptExtract().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMonitorStatsResponse {
-    return new GetMonitorStatsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScriptExtract {
+    return new ScriptExtract().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetMonitorStatsResponse | PlainMessage<GetMonitorStatsResponse> | undefined, b: GetMonitorStatsResponse | PlainMessage<GetMonitorStatsResponse> | undefined): boolean {
-    return proto3.util.equals(GetMonitorStatsResponse, a, b);
+  static equals(a: ScriptExtract | PlainMessage<ScriptExtract> | undefined, b: ScriptExtract | PlainMessage<ScriptExtract> | undefined): boolean {
+    return proto3.util.equals(ScriptExtract, a, b);
   }
 }
 
 /**
- * Tek bir ping işleminin detaylı sonucu
- *
- * @generated from message pulsar.v1.MonitorStat
+ * @generated from message pulsar.v1.ScriptAssert
  */
-export class MonitorStat extends Message<MonitorStat> {
+export class ScriptAssert extends Message<ScriptAssert> {
   /**
-   * Toplam süre (ms)
+   * "status", "header", "json" or "body"
    *
-   * @generated from field: int32 latency = 1;
+   * @generated from field: string source = 1;
    */
-  latency = 0;
+  source = "";
 
   /**
-   * HTTP Status Code (200, 404, 500...)
+   * header name or JSON path
    *
-   * @generated from field: int32 code = 2;
+   * @generated from field: string path = 2;
    */
-  code = 0;
+  path = "";
 
   /**
-   * Status Text (OK, DOWN...)
+   * "eq" (default), "ne", "contains", "exists", "lt", "gt"
    *
-   * @generated from field: string status = 3;
+   * @generated from field: string op = 3;
    */
-  status = "";
+  op = "";
 
   /**
-   * Zaman damgası (ISO String)
+   * @generated from field: string value = 4;
+   */
+  value = "";
+
+  constructor(data?: PartialMessage<ScriptAssert>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.ScriptAssert";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "op", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScriptAssert {
+    return new ScriptAssert().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScriptAssert {
+    return new ScriptAssert().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScriptAssert {
+    return new ScriptAssert().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ScriptAssert | PlainMessage<ScriptAssert> | undefined, b: ScriptAssert | PlainMessage<ScriptAssert> | undefined): boolean {
+    return proto3.util.equals(ScriptAssert, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.CreateMonitorRequest
+ */
+export class CreateMonitorRequest extends Message<CreateMonitorRequest> {
+  /**
+   * @generated from field: string url = 1;
+   */
+  url = "";
+
+  /**
+   * @generated from field: int32 interval_seconds = 2;
+   */
+  intervalSeconds = 0;
+
+  /**
+   * empty = "http"
    *
-   * @generated from field: string time = 4;
+   * @generated from field: string type = 3;
    */
-  time = "";
+  type = "";
 
   /**
-   * Waterfall detayları
+   * @generated from field: pulsar.v1.IcmpConfig icmp = 4;
+   */
+  icmp?: IcmpConfig;
+
+  /**
+   * @generated from field: pulsar.v1.PushConfig push = 5;
+   */
+  push?: PushConfig;
+
+  /**
+   * @generated from field: pulsar.v1.GrpcConfig grpc = 6;
+   */
+  grpc?: GrpcConfig;
+
+  /**
+   * @generated from field: pulsar.v1.ScriptConfig script = 7;
+   */
+  script?: ScriptConfig;
+
+  /**
+   * @generated from field: pulsar.v1.ContentCheck content = 8;
+   */
+  content?: ContentCheck;
+
+  /**
+   * @generated from field: pulsar.v1.RedirectPolicy redirects = 9;
+   */
+  redirects?: RedirectPolicy;
+
+  /**
+   * http, script and grpc
    *
-   * @generated from field: pulsar.v1.MonitorTiming timing = 5;
+   * @generated from field: pulsar.v1.ProbeCredentials credentials = 10;
    */
-  timing?: MonitorTiming;
+  credentials?: ProbeCredentials;
 
-  constructor(data?: PartialMessage<MonitorStat>) {
+  constructor(data?: PartialMessage<CreateMonitorRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "pulsar.v1.MonitorStat";
+  static readonly typeName = "pulsar.v1.CreateMonitorRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "latency", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 2, name: "code", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 3, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "time", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "timing", kind: "message", T: MonitorTiming },
+    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "interval_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "icmp", kind: "message", T: IcmpConfig },
+    { no: 5, name: "push", kind: "message", T: PushConfig },
+    { no: 6, name: "grpc", kind: "message", T: GrpcConfig },
+    { no: 7, name: "script", kind: "message", T: ScriptConfig },
+    { no: 8, name: "content", kind: "message", T: ContentCheck },
+    { no: 9, name: "redirects", kind: "message", T: RedirectPolicy },
+    { no: 10, name: "credentials", kind: "message", T: ProbeCredentials },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MonitorStat {
-    return new MonitorStat().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateMonitorRequest {
+    return new CreateMonitorRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MonitorStat {
-    return new MonitorStat().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateMonitorRequest {
+    return new CreateMonitorRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MonitorStat {
-    return new MonitorStat().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateMonitorRequest {
+    return new CreateMonitorRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: MonitorStat | PlainMessage<MonitorStat> | undefined, b: MonitorStat | PlainMessage<MonitorStat> | undefined): boolean {
-    return proto3.util.equals(MonitorStat, a, b);
+  static equals(a: CreateMonitorRequest | PlainMessage<CreateMonitorRequest> | undefined, b: CreateMonitorRequest | PlainMessage<CreateMonitorRequest> | undefined): boolean {
+    return proto3.util.equals(CreateMonitorRequest, a, b);
   }
 }
 
 /**
- * Waterfall grafiği için detaylı süreler
+ * How the worker reaches the target: through a proxy and/or with a client
+ * certificate (mTLS). Write-only, responses carry a CredentialsInfo. The
+ * client key and the proxy password are sealed into secrets; any field may
+ * also refer to an existing one as {{secret:<id>}}.
  *
- * @generated from message pulsar.v1.MonitorTiming
+ * @generated from message pulsar.v1.ProbeCredentials
  */
-export class MonitorTiming extends Message<MonitorTiming> {
+export class ProbeCredentials extends Message<ProbeCredentials> {
   /**
-   * @generated from field: int32 dns = 1;
+   * http://, https:// or socks5://, user:password@ allowed
+   *
+   * @generated from field: string proxy_url = 1;
    */
-  dns = 0;
+  proxyUrl = "";
 
   /**
-   * @generated from field: int32 tcp = 2;
+   * PEM, with client_key
+   *
+   * @generated from field: string client_cert = 2;
    */
-  tcp = 0;
+  clientCert = "";
 
   /**
-   * @generated from field: int32 tls = 3;
+   * PEM
+   *
+   * @generated from field: string client_key = 3;
    */
-  tls = 0;
+  clientKey = "";
 
   /**
-   * @generated from field: int32 ttfb = 4;
+   * PEM, trusted next to the system roots
+   *
+   * @generated from field: string ca_bundle = 4;
    */
-  ttfb = 0;
+  caBundle = "";
+
+  constructor(data?: PartialMessage<ProbeCredentials>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.ProbeCredentials";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "proxy_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "client_cert", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "client_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "ca_bundle", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProbeCredentials {
+    return new ProbeCredentials().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProbeCredentials {
+    return new ProbeCredentials().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProbeCredentials {
+    return new ProbeCredentials().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProbeCredentials | PlainMessage<ProbeCredentials> | undefined, b: ProbeCredentials | PlainMessage<ProbeCredentials> | undefined): boolean {
+    return proto3.util.equals(ProbeCredentials, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.CredentialsInfo
+ */
+export class CredentialsInfo extends Message<CredentialsInfo> {
+  /**
+   * password masked
+   *
+   * @generated from field: string proxy_url = 1;
+   */
+  proxyUrl = "";
+
+  /**
+   * @generated from field: bool client_cert = 2;
+   */
+  clientCert = false;
+
+  /**
+   * @generated from field: string client_cert_subject = 3;
+   */
+  clientCertSubject = "";
+
+  /**
+   * unix seconds
+   *
+   * @generated from field: int64 client_cert_expires = 4;
+   */
+  clientCertExpires = protoInt64.zero;
+
+  /**
+   * @generated from field: bool ca_bundle = 5;
+   */
+  caBundle = false;
+
+  constructor(data?: PartialMessage<CredentialsInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.CredentialsInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "proxy_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "client_cert", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "client_cert_subject", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "client_cert_expires", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "ca_bundle", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CredentialsInfo {
+    return new CredentialsInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CredentialsInfo {
+    return new CredentialsInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CredentialsInfo {
+    return new CredentialsInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CredentialsInfo | PlainMessage<CredentialsInfo> | undefined, b: CredentialsInfo | PlainMessage<CredentialsInfo> | undefined): boolean {
+    return proto3.util.equals(CredentialsInfo, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.CreateMonitorResponse
+ */
+export class CreateMonitorResponse extends Message<CreateMonitorResponse> {
+  /**
+   * @generated from field: pulsar.v1.Monitor monitor = 1;
+   */
+  monitor?: Monitor;
+
+  constructor(data?: PartialMessage<CreateMonitorResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.CreateMonitorResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "monitor", kind: "message", T: Monitor },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateMonitorResponse {
+    return new CreateMonitorResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateMonitorResponse {
+    return new CreateMonitorResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateMonitorResponse {
+    return new CreateMonitorResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateMonitorResponse | PlainMessage<CreateMonitorResponse> | undefined, b: CreateMonitorResponse | PlainMessage<CreateMonitorResponse> | undefined): boolean {
+    return proto3.util.equals(CreateMonitorResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.ListMonitorsRequest
+ */
+export class ListMonitorsRequest extends Message<ListMonitorsRequest> {
+  constructor(data?: PartialMessage<ListMonitorsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.ListMonitorsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMonitorsRequest {
+    return new ListMonitorsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListMonitorsRequest {
+    return new ListMonitorsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListMonitorsRequest {
+    return new ListMonitorsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListMonitorsRequest | PlainMessage<ListMonitorsRequest> | undefined, b: ListMonitorsRequest | PlainMessage<ListMonitorsRequest> | undefined): boolean {
+    return proto3.util.equals(ListMonitorsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.ListMonitorsResponse
+ */
+export class ListMonitorsResponse extends Message<ListMonitorsResponse> {
+  /**
+   * @generated from field: repeated pulsar.v1.Monitor monitors = 1;
+   */
+  monitors: Monitor[] = [];
+
+  constructor(data?: PartialMessage<ListMonitorsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.ListMonitorsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "monitors", kind: "message", T: Monitor, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMonitorsResponse {
+    return new ListMonitorsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListMonitorsResponse {
+    return new ListMonitorsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListMonitorsResponse {
+    return new ListMonitorsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListMonitorsResponse | PlainMessage<ListMonitorsResponse> | undefined, b: ListMonitorsResponse | PlainMessage<ListMonitorsResponse> | undefined): boolean {
+    return proto3.util.equals(ListMonitorsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.DeleteMonitorRequest
+ */
+export class DeleteMonitorRequest extends Message<DeleteMonitorRequest> {
+  /**
+   * @generated from field: string monitor_id = 1;
+   */
+  monitorId = "";
+
+  constructor(data?: PartialMessage<DeleteMonitorRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.DeleteMonitorRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "monitor_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteMonitorRequest {
+    return new DeleteMonitorRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteMonitorRequest {
+    return new DeleteMonitorRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteMonitorRequest {
+    return new DeleteMonitorRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteMonitorRequest | PlainMessage<DeleteMonitorRequest> | undefined, b: DeleteMonitorRequest | PlainMessage<DeleteMonitorRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteMonitorRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.DeleteMonitorResponse
+ */
+export class DeleteMonitorResponse extends Message<DeleteMonitorResponse> {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success = false;
+
+  constructor(data?: PartialMessage<DeleteMonitorResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.DeleteMonitorResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "success", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteMonitorResponse {
+    return new DeleteMonitorResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteMonitorResponse {
+    return new DeleteMonitorResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteMonitorResponse {
+    return new DeleteMonitorResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteMonitorResponse | PlainMessage<DeleteMonitorResponse> | undefined, b: DeleteMonitorResponse | PlainMessage<DeleteMonitorResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteMonitorResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.SetMonitorCredentialsRequest
+ */
+export class SetMonitorCredentialsRequest extends Message<SetMonitorCredentialsRequest> {
+  /**
+   * @generated from field: string monitor_id = 1;
+   */
+  monitorId = "";
+
+  /**
+   * @generated from field: pulsar.v1.ProbeCredentials credentials = 2;
+   */
+  credentials?: ProbeCredentials;
+
+  constructor(data?: PartialMessage<SetMonitorCredentialsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.SetMonitorCredentialsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "monitor_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "credentials", kind: "message", T: ProbeCredentials },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetMonitorCredentialsRequest {
+    return new SetMonitorCredentialsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetMonitorCredentialsRequest {
+    return new SetMonitorCredentialsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetMonitorCredentialsRequest {
+    return new SetMonitorCredentialsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetMonitorCredentialsRequest | PlainMessage<SetMonitorCredentialsRequest> | undefined, b: SetMonitorCredentialsRequest | PlainMessage<SetMonitorCredentialsRequest> | undefined): boolean {
+    return proto3.util.equals(SetMonitorCredentialsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.SetMonitorCredentialsResponse
+ */
+export class SetMonitorCredentialsResponse extends Message<SetMonitorCredentialsResponse> {
+  /**
+   * @generated from field: pulsar.v1.Monitor monitor = 1;
+   */
+  monitor?: Monitor;
+
+  constructor(data?: PartialMessage<SetMonitorCredentialsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.SetMonitorCredentialsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "monitor", kind: "message", T: Monitor },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetMonitorCredentialsResponse {
+    return new SetMonitorCredentialsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetMonitorCredentialsResponse {
+    return new SetMonitorCredentialsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetMonitorCredentialsResponse {
+    return new SetMonitorCredentialsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetMonitorCredentialsResponse | PlainMessage<SetMonitorCredentialsResponse> | undefined, b: SetMonitorCredentialsResponse | PlainMessage<SetMonitorCredentialsResponse> | undefined): boolean {
+    return proto3.util.equals(SetMonitorCredentialsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.GetMonitorStatsRequest
+ */
+export class GetMonitorStatsRequest extends Message<GetMonitorStatsRequest> {
+  /**
+   * @generated from field: string monitor_id = 1;
+   */
+  monitorId = "";
+
+  constructor(data?: PartialMessage<GetMonitorStatsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.GetMonitorStatsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "monitor_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMonitorStatsRequest {
+    return new GetMonitorStatsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMonitorStatsRequest {
+    return new GetMonitorStatsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMonitorStatsRequest {
+    return new GetMonitorStatsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMonitorStatsRequest | PlainMessage<GetMonitorStatsRequest> | undefined, b: GetMonitorStatsRequest | PlainMessage<GetMonitorStatsRequest> | undefined): boolean {
+    return proto3.util.equals(GetMonitorStatsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.GetMonitorStatsResponse
+ */
+export class GetMonitorStatsResponse extends Message<GetMonitorStatsResponse> {
+  /**
+   * @generated from field: repeated pulsar.v1.MonitorStat stats = 1;
+   */
+  stats: MonitorStat[] = [];
+
+  constructor(data?: PartialMessage<GetMonitorStatsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.GetMonitorStatsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stats", kind: "message", T: MonitorStat, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMonitorStatsResponse {
+    return new GetMonitorStatsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMonitorStatsResponse {
+    return new GetMonitorStatsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMonitorStatsResponse {
+    return new GetMonitorStatsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMonitorStatsResponse | PlainMessage<GetMonitorStatsResponse> | undefined, b: GetMonitorStatsResponse | PlainMessage<GetMonitorStatsResponse> | undefined): boolean {
+    return proto3.util.equals(GetMonitorStatsResponse, a, b);
+  }
+}
+
+/**
+ * Tek bir ping işleminin detaylı sonucu
+ *
+ * @generated from message pulsar.v1.MonitorStat
+ */
+export class MonitorStat extends Message<MonitorStat> {
+  /**
+   * Toplam süre (ms)
+   *
+   * @generated from field: int32 latency = 1;
+   */
+  latency = 0;
+
+  /**
+   * HTTP Status Code (200, 404, 500...)
+   *
+   * @generated from field: int32 code = 2;
+   */
+  code = 0;
+
+  /**
+   * Status Text (OK, DOWN...)
+   *
+   * @generated from field: string status = 3;
+   */
+  status = "";
+
+  /**
+   * Zaman damgası (ISO String)
+   *
+   * @generated from field: string time = 4;
+   */
+  time = "";
+
+  /**
+   * Waterfall detayları
+   *
+   * @generated from field: pulsar.v1.MonitorTiming timing = 5;
+   */
+  timing?: MonitorTiming;
+
+  /**
+   * icmp monitors only
+   *
+   * @generated from field: pulsar.v1.IcmpStats icmp = 6;
+   */
+  icmp?: IcmpStats;
+
+  /**
+   * script monitors only
+   *
+   * @generated from field: repeated pulsar.v1.StepResult steps = 7;
+   */
+  steps: StepResult[] = [];
+
+  /**
+   * http monitors, when redirected
+   *
+   * @generated from field: repeated pulsar.v1.RedirectHop redirects = 8;
+   */
+  redirects: RedirectHop[] = [];
+
+  constructor(data?: PartialMessage<MonitorStat>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.MonitorStat";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "latency", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "code", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "time", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "timing", kind: "message", T: MonitorTiming },
+    { no: 6, name: "icmp", kind: "message", T: IcmpStats },
+    { no: 7, name: "steps", kind: "message", T: StepResult, repeated: true },
+    { no: 8, name: "redirects", kind: "message", T: RedirectHop, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MonitorStat {
+    return new MonitorStat().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MonitorStat {
+    return new MonitorStat().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MonitorStat {
+    return new MonitorStat().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MonitorStat | PlainMessage<MonitorStat> | undefined, b: MonitorStat | PlainMessage<MonitorStat> | undefined): boolean {
+    return proto3.util.equals(MonitorStat, a, b);
+  }
+}
+
+/**
+ * Live check results, same feed as the "monitor_update" WebSocket messages
+ *
+ * @generated from message pulsar.v1.WatchMonitorsRequest
+ */
+export class WatchMonitorsRequest extends Message<WatchMonitorsRequest> {
+  /**
+   * empty = all monitors
+   *
+   * @generated from field: repeated string monitor_ids = 1;
+   */
+  monitorIds: string[] = [];
+
+  constructor(data?: PartialMessage<WatchMonitorsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.WatchMonitorsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "monitor_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchMonitorsRequest {
+    return new WatchMonitorsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchMonitorsRequest {
+    return new WatchMonitorsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchMonitorsRequest {
+    return new WatchMonitorsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WatchMonitorsRequest | PlainMessage<WatchMonitorsRequest> | undefined, b: WatchMonitorsRequest | PlainMessage<WatchMonitorsRequest> | undefined): boolean {
+    return proto3.util.equals(WatchMonitorsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.MonitorUpdate
+ */
+export class MonitorUpdate extends Message<MonitorUpdate> {
+  /**
+   * @generated from field: string monitor_id = 1;
+   */
+  monitorId = "";
+
+  /**
+   * @generated from field: string url = 2;
+   */
+  url = "";
+
+  /**
+   * @generated from field: string status = 3;
+   */
+  status = "";
+
+  /**
+   * @generated from field: int32 code = 4;
+   */
+  code = 0;
+
+  /**
+   * ms
+   *
+   * @generated from field: int32 latency = 5;
+   */
+  latency = 0;
+
+  /**
+   * @generated from field: pulsar.v1.MonitorTiming timing = 6;
+   */
+  timing?: MonitorTiming;
+
+  /**
+   * RFC3339
+   *
+   * @generated from field: string time = 7;
+   */
+  time = "";
+
+  /**
+   * icmp monitors only
+   *
+   * @generated from field: pulsar.v1.IcmpStats icmp = 8;
+   */
+  icmp?: IcmpStats;
+
+  /**
+   * script monitors only
+   *
+   * @generated from field: repeated pulsar.v1.StepResult steps = 9;
+   */
+  steps: StepResult[] = [];
+
+  /**
+   * http monitors, when redirected
+   *
+   * @generated from field: repeated pulsar.v1.RedirectHop redirects = 10;
+   */
+  redirects: RedirectHop[] = [];
+
+  constructor(data?: PartialMessage<MonitorUpdate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.MonitorUpdate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "monitor_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "code", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "latency", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "timing", kind: "message", T: MonitorTiming },
+    { no: 7, name: "time", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "icmp", kind: "message", T: IcmpStats },
+    { no: 9, name: "steps", kind: "message", T: StepResult, repeated: true },
+    { no: 10, name: "redirects", kind: "message", T: RedirectHop, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MonitorUpdate {
+    return new MonitorUpdate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MonitorUpdate {
+    return new MonitorUpdate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MonitorUpdate {
+    return new MonitorUpdate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MonitorUpdate | PlainMessage<MonitorUpdate> | undefined, b: MonitorUpdate | PlainMessage<MonitorUpdate> | undefined): boolean {
+    return proto3.util.equals(MonitorUpdate, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.IcmpStats
+ */
+export class IcmpStats extends Message<IcmpStats> {
+  /**
+   * ms
+   *
+   * @generated from field: double rtt_min = 1;
+   */
+  rttMin = 0;
+
+  /**
+   * @generated from field: double rtt_avg = 2;
+   */
+  rttAvg = 0;
+
+  /**
+   * @generated from field: double rtt_max = 3;
+   */
+  rttMax = 0;
+
+  /**
+   * ms, mean difference between consecutive replies
+   *
+   * @generated from field: double jitter = 4;
+   */
+  jitter = 0;
+
+  /**
+   * percent
+   *
+   * @generated from field: double packet_loss = 5;
+   */
+  packetLoss = 0;
+
+  constructor(data?: PartialMessage<IcmpStats>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.IcmpStats";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rtt_min", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 2, name: "rtt_avg", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "rtt_max", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 4, name: "jitter", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 5, name: "packet_loss", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IcmpStats {
+    return new IcmpStats().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IcmpStats {
+    return new IcmpStats().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IcmpStats {
+    return new IcmpStats().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: IcmpStats | PlainMessage<IcmpStats> | undefined, b: IcmpStats | PlainMessage<IcmpStats> | undefined): boolean {
+    return proto3.util.equals(IcmpStats, a, b);
+  }
+}
+
+/**
+ * One request of a redirect chain, the monitor's own URL first.
+ *
+ * @generated from message pulsar.v1.RedirectHop
+ */
+export class RedirectHop extends Message<RedirectHop> {
+  /**
+   * @generated from field: string url = 1;
+   */
+  url = "";
+
+  /**
+   * 0 when the request failed
+   *
+   * @generated from field: int32 code = 2;
+   */
+  code = 0;
+
+  /**
+   * ms, until the response headers
+   *
+   * @generated from field: int32 latency = 3;
+   */
+  latency = 0;
+
+  /**
+   * download is always 0
+   *
+   * @generated from field: pulsar.v1.MonitorTiming timing = 4;
+   */
+  timing?: MonitorTiming;
+
+  constructor(data?: PartialMessage<RedirectHop>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.RedirectHop";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "code", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "latency", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "timing", kind: "message", T: MonitorTiming },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RedirectHop {
+    return new RedirectHop().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RedirectHop {
+    return new RedirectHop().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RedirectHop {
+    return new RedirectHop().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RedirectHop | PlainMessage<RedirectHop> | undefined, b: RedirectHop | PlainMessage<RedirectHop> | undefined): boolean {
+    return proto3.util.equals(RedirectHop, a, b);
+  }
+}
+
+/**
+ * The page of a monitor changed since its last snapshot.
+ *
+ * @generated from message pulsar.v1.ContentChange
+ */
+export class ContentChange extends Message<ContentChange> {
+  /**
+   * @generated from field: string monitor_id = 1;
+   */
+  monitorId = "";
+
+  /**
+   * @generated from field: string url = 2;
+   */
+  url = "";
+
+  /**
+   * @generated from field: string old_hash = 3;
+   */
+  oldHash = "";
+
+  /**
+   * sha256 of the normalized content
+   *
+   * @generated from field: string new_hash = 4;
+   */
+  newHash = "";
+
+  /**
+   * lines
+   *
+   * @generated from field: int32 added = 5;
+   */
+  added = 0;
+
+  /**
+   * @generated from field: int32 removed = 6;
+   */
+  removed = 0;
+
+  /**
+   * unified, previous -> current
+   *
+   * @generated from field: string diff = 7;
+   */
+  diff = "";
+
+  /**
+   * RFC3339
+   *
+   * @generated from field: string time = 8;
+   */
+  time = "";
+
+  constructor(data?: PartialMessage<ContentChange>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.ContentChange";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "monitor_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "old_hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "new_hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "added", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "removed", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "diff", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "time", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ContentChange {
+    return new ContentChange().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ContentChange {
+    return new ContentChange().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ContentChange {
+    return new ContentChange().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ContentChange | PlainMessage<ContentChange> | undefined, b: ContentChange | PlainMessage<ContentChange> | undefined): boolean {
+    return proto3.util.equals(ContentChange, a, b);
+  }
+}
+
+/**
+ * One step of a script check; the steps after a failed one are not run.
+ *
+ * @generated from message pulsar.v1.StepResult
+ */
+export class StepResult extends Message<StepResult> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string method = 2;
+   */
+  method = "";
+
+  /**
+   * @generated from field: string url = 3;
+   */
+  url = "";
+
+  /**
+   * 0 when the request failed
+   *
+   * @generated from field: int32 code = 4;
+   */
+  code = 0;
+
+  /**
+   * ms
+   *
+   * @generated from field: int32 latency = 5;
+   */
+  latency = 0;
+
+  /**
+   * @generated from field: pulsar.v1.MonitorTiming timing = 6;
+   */
+  timing?: MonitorTiming;
+
+  /**
+   * empty = passed
+   *
+   * @generated from field: string error = 7;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<StepResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.StepResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "method", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "code", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "latency", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "timing", kind: "message", T: MonitorTiming },
+    { no: 7, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StepResult {
+    return new StepResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StepResult {
+    return new StepResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StepResult {
+    return new StepResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StepResult | PlainMessage<StepResult> | undefined, b: StepResult | PlainMessage<StepResult> | undefined): boolean {
+    return proto3.util.equals(StepResult, a, b);
+  }
+}
+
+/**
+ * Waterfall grafiği için detaylı süreler
+ *
+ * @generated from message pulsar.v1.MonitorTiming
+ */
+export class MonitorTiming extends Message<MonitorTiming> {
+  /**
+   * @generated from field: int32 dns = 1;
+   */
+  dns = 0;
+
+  /**
+   * @generated from field: int32 tcp = 2;
+   */
+  tcp = 0;
+
+  /**
+   * @generated from field: int32 tls = 3;
+   */
+  tls = 0;
+
+  /**
+   * @generated from field: int32 ttfb = 4;
+   */
+  ttfb = 0;
+
+  /**
+   * @generated from field: int32 download = 5;
+   */
+  download = 0;
+
+  constructor(data?: PartialMessage<MonitorTiming>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.MonitorTiming";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "dns", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "tcp", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "tls", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "ttfb", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "download", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MonitorTiming {
+    return new MonitorTiming().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MonitorTiming {
+    return new MonitorTiming().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MonitorTiming {
+    return new MonitorTiming().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MonitorTiming | PlainMessage<MonitorTiming> | undefined, b: MonitorTiming | PlainMessage<MonitorTiming> | undefined): boolean {
+    return proto3.util.equals(MonitorTiming, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.GetSystemStatsRequest
+ */
+export class GetSystemStatsRequest extends Message<GetSystemStatsRequest> {
+  /**
+   * empty = the worker's own host
+   *
+   * @generated from field: string host = 1;
+   */
+  host = "";
+
+  constructor(data?: PartialMessage<GetSystemStatsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.GetSystemStatsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSystemStatsRequest {
+    return new GetSystemStatsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSystemStatsRequest {
+    return new GetSystemStatsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSystemStatsRequest {
+    return new GetSystemStatsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSystemStatsRequest | PlainMessage<GetSystemStatsRequest> | undefined, b: GetSystemStatsRequest | PlainMessage<GetSystemStatsRequest> | undefined): boolean {
+    return proto3.util.equals(GetSystemStatsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.GetSystemStatsHistoryRequest
+ */
+export class GetSystemStatsHistoryRequest extends Message<GetSystemStatsHistoryRequest> {
+  /**
+   * empty = the worker's own host
+   *
+   * @generated from field: string host = 1;
+   */
+  host = "";
+
+  /**
+   * unix seconds, 0 = a day before to
+   *
+   * @generated from field: int64 from = 2;
+   */
+  from = protoInt64.zero;
+
+  /**
+   * unix seconds, 0 = now
+   *
+   * @generated from field: int64 to = 3;
+   */
+  to = protoInt64.zero;
+
+  /**
+   * wanted number of buckets, 0 = 300
+   *
+   * @generated from field: int32 points = 4;
+   */
+  points = 0;
+
+  constructor(data?: PartialMessage<GetSystemStatsHistoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.GetSystemStatsHistoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "from", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "to", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "points", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSystemStatsHistoryRequest {
+    return new GetSystemStatsHistoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSystemStatsHistoryRequest {
+    return new GetSystemStatsHistoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSystemStatsHistoryRequest {
+    return new GetSystemStatsHistoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSystemStatsHistoryRequest | PlainMessage<GetSystemStatsHistoryRequest> | undefined, b: GetSystemStatsHistoryRequest | PlainMessage<GetSystemStatsHistoryRequest> | undefined): boolean {
+    return proto3.util.equals(GetSystemStatsHistoryRequest, a, b);
+  }
+}
+
+/**
+ * Downsampled history: one entry per bucket that has samples, so series can
+ * have gaps and are shorter than points.
+ *
+ * @generated from message pulsar.v1.GetSystemStatsHistoryResponse
+ */
+export class GetSystemStatsHistoryResponse extends Message<GetSystemStatsHistoryResponse> {
+  /**
+   * @generated from field: int64 bucket_seconds = 1;
+   */
+  bucketSeconds = protoInt64.zero;
+
+  /**
+   * RFC3339, bucket starts
+   *
+   * @generated from field: repeated string times = 2;
+   */
+  times: string[] = [];
+
+  /**
+   * percent
+   *
+   * @generated from field: pulsar.v1.StatSeries cpu = 3;
+   */
+  cpu?: StatSeries;
+
+  /**
+   * percent
+   *
+   * @generated from field: pulsar.v1.StatSeries memory = 4;
+   */
+  memory?: StatSeries;
+
+  /**
+   * percent
+   *
+   * @generated from field: pulsar.v1.StatSeries disk = 5;
+   */
+  disk?: StatSeries;
+
+  /**
+   * KB/s
+   *
+   * @generated from field: pulsar.v1.StatSeries network = 6;
+   */
+  network?: StatSeries;
+
+  /**
+   * total processes
+   *
+   * @generated from field: pulsar.v1.StatSeries threads = 7;
+   */
+  threads?: StatSeries;
+
+  /**
+   * @generated from field: pulsar.v1.StatSeries load1 = 8;
+   */
+  load1?: StatSeries;
+
+  constructor(data?: PartialMessage<GetSystemStatsHistoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.GetSystemStatsHistoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "bucket_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "times", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "cpu", kind: "message", T: StatSeries },
+    { no: 4, name: "memory", kind: "message", T: StatSeries },
+    { no: 5, name: "disk", kind: "message", T: StatSeries },
+    { no: 6, name: "network", kind: "message", T: StatSeries },
+    { no: 7, name: "threads", kind: "message", T: StatSeries },
+    { no: 8, name: "load1", kind: "message", T: StatSeries },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSystemStatsHistoryResponse {
+    return new GetSystemStatsHistoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSystemStatsHistoryResponse {
+    return new GetSystemStatsHistoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSystemStatsHistoryResponse {
+    return new GetSystemStatsHistoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSystemStatsHistoryResponse | PlainMessage<GetSystemStatsHistoryResponse> | undefined, b: GetSystemStatsHistoryResponse | PlainMessage<GetSystemStatsHistoryResponse> | undefined): boolean {
+    return proto3.util.equals(GetSystemStatsHistoryResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.StatSeries
+ */
+export class StatSeries extends Message<StatSeries> {
+  /**
+   * @generated from field: repeated double min = 1;
+   */
+  min: number[] = [];
+
+  /**
+   * @generated from field: repeated double avg = 2;
+   */
+  avg: number[] = [];
+
+  /**
+   * @generated from field: repeated double max = 3;
+   */
+  max: number[] = [];
+
+  constructor(data?: PartialMessage<StatSeries>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.StatSeries";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "min", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, repeated: true },
+    { no: 2, name: "avg", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, repeated: true },
+    { no: 3, name: "max", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatSeries {
+    return new StatSeries().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StatSeries {
+    return new StatSeries().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StatSeries {
+    return new StatSeries().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StatSeries | PlainMessage<StatSeries> | undefined, b: StatSeries | PlainMessage<StatSeries> | undefined): boolean {
+    return proto3.util.equals(StatSeries, a, b);
+  }
+}
+
+/**
+ * --- SİSTEM İSTATİSTİKLERİ ---
+ *
+ * @generated from message pulsar.v1.SystemStatsResponse
+ */
+export class SystemStatsResponse extends Message<SystemStatsResponse> {
+  /**
+   * @generated from field: pulsar.v1.ResourceUsage cpu = 1;
+   */
+  cpu?: ResourceUsage;
+
+  /**
+   * @generated from field: pulsar.v1.ResourceUsage memory = 2;
+   */
+  memory?: ResourceUsage;
+
+  /**
+   * @generated from field: pulsar.v1.ResourceUsage disk = 3;
+   */
+  disk?: ResourceUsage;
+
+  /**
+   * @generated from field: pulsar.v1.ResourceUsage network = 4;
+   */
+  network?: ResourceUsage;
+
+  /**
+   * @generated from field: pulsar.v1.ThreadUsage threads = 5;
+   */
+  threads?: ThreadUsage;
+
+  /**
+   * @generated from field: pulsar.v1.SystemInfo info = 6;
+   */
+  info?: SystemInfo;
+
+  /**
+   * per mountpoint
+   *
+   * @generated from field: repeated pulsar.v1.DiskUsage disks = 7;
+   */
+  disks: DiskUsage[] = [];
+
+  /**
+   * @generated from field: repeated pulsar.v1.InterfaceUsage interfaces = 8;
+   */
+  interfaces: InterfaceUsage[] = [];
+
+  /**
+   * percent, per core
+   *
+   * @generated from field: repeated double cpu_cores = 9;
+   */
+  cpuCores: number[] = [];
+
+  /**
+   * @generated from field: pulsar.v1.LoadAverage load = 10;
+   */
+  load?: LoadAverage;
+
+  /**
+   * @generated from field: repeated pulsar.v1.ProcessInfo top_cpu = 11;
+   */
+  topCpu: ProcessInfo[] = [];
+
+  /**
+   * by RSS
+   *
+   * @generated from field: repeated pulsar.v1.ProcessInfo top_memory = 12;
+   */
+  topMemory: ProcessInfo[] = [];
+
+  /**
+   * "local" = the worker's own host
+   *
+   * @generated from field: string host = 13;
+   */
+  host = "";
+
+  /**
+   * by memory
+   *
+   * @generated from field: repeated pulsar.v1.CgroupUsage cgroups = 14;
+   */
+  cgroups: CgroupUsage[] = [];
+
+  constructor(data?: PartialMessage<SystemStatsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.SystemStatsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cpu", kind: "message", T: ResourceUsage },
+    { no: 2, name: "memory", kind: "message", T: ResourceUsage },
+    { no: 3, name: "disk", kind: "message", T: ResourceUsage },
+    { no: 4, name: "network", kind: "message", T: ResourceUsage },
+    { no: 5, name: "threads", kind: "message", T: ThreadUsage },
+    { no: 6, name: "info", kind: "message", T: SystemInfo },
+    { no: 7, name: "disks", kind: "message", T: DiskUsage, repeated: true },
+    { no: 8, name: "interfaces", kind: "message", T: InterfaceUsage, repeated: true },
+    { no: 9, name: "cpu_cores", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, repeated: true },
+    { no: 10, name: "load", kind: "message", T: LoadAverage },
+    { no: 11, name: "top_cpu", kind: "message", T: ProcessInfo, repeated: true },
+    { no: 12, name: "top_memory", kind: "message", T: ProcessInfo, repeated: true },
+    { no: 13, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 14, name: "cgroups", kind: "message", T: CgroupUsage, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SystemStatsResponse {
+    return new SystemStatsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SystemStatsResponse {
+    return new SystemStatsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SystemStatsResponse {
+    return new SystemStatsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SystemStatsResponse | PlainMessage<SystemStatsResponse> | undefined, b: SystemStatsResponse | PlainMessage<SystemStatsResponse> | undefined): boolean {
+    return proto3.util.equals(SystemStatsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.ProcessInfo
+ */
+export class ProcessInfo extends Message<ProcessInfo> {
+  /**
+   * @generated from field: int32 pid = 1;
+   */
+  pid = 0;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string user = 3;
+   */
+  user = "";
+
+  /**
+   * @generated from field: string state = 4;
+   */
+  state = "";
+
+  /**
+   * @generated from field: int32 threads = 5;
+   */
+  threads = 0;
+
+  /**
+   * @generated from field: double cpu_percent = 6;
+   */
+  cpuPercent = 0;
+
+  /**
+   * @generated from field: uint64 rss_bytes = 7;
+   */
+  rssBytes = protoInt64.zero;
+
+  /**
+   * @generated from field: double memory_percent = 8;
+   */
+  memoryPercent = 0;
+
+  constructor(data?: PartialMessage<ProcessInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.ProcessInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pid", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "user", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "threads", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "cpu_percent", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 7, name: "rss_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 8, name: "memory_percent", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProcessInfo {
+    return new ProcessInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProcessInfo {
+    return new ProcessInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProcessInfo {
+    return new ProcessInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProcessInfo | PlainMessage<ProcessInfo> | undefined, b: ProcessInfo | PlainMessage<ProcessInfo> | undefined): boolean {
+    return proto3.util.equals(ProcessInfo, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.GetProcessSnapshotRequest
+ */
+export class GetProcessSnapshotRequest extends Message<GetProcessSnapshotRequest> {
+  /**
+   * unix seconds, 0 = latest
+   *
+   * @generated from field: int64 at = 1;
+   */
+  at = protoInt64.zero;
+
+  /**
+   * empty = the worker's own host
+   *
+   * @generated from field: string host = 2;
+   */
+  host = "";
+
+  constructor(data?: PartialMessage<GetProcessSnapshotRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.GetProcessSnapshotRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "at", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetProcessSnapshotRequest {
+    return new GetProcessSnapshotRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetProcessSnapshotRequest {
+    return new GetProcessSnapshotRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetProcessSnapshotRequest {
+    return new GetProcessSnapshotRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetProcessSnapshotRequest | PlainMessage<GetProcessSnapshotRequest> | undefined, b: GetProcessSnapshotRequest | PlainMessage<GetProcessSnapshotRequest> | undefined): boolean {
+    return proto3.util.equals(GetProcessSnapshotRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.GetProcessSnapshotResponse
+ */
+export class GetProcessSnapshotResponse extends Message<GetProcessSnapshotResponse> {
+  /**
+   * RFC3339, when the snapshot was taken
+   *
+   * @generated from field: string time = 1;
+   */
+  time = "";
+
+  /**
+   * by RSS
+   *
+   * @generated from field: repeated pulsar.v1.ProcessInfo processes = 2;
+   */
+  processes: ProcessInfo[] = [];
+
+  constructor(data?: PartialMessage<GetProcessSnapshotResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.GetProcessSnapshotResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "time", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "processes", kind: "message", T: ProcessInfo, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetProcessSnapshotResponse {
+    return new GetProcessSnapshotResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetProcessSnapshotResponse {
+    return new GetProcessSnapshotResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetProcessSnapshotResponse {
+    return new GetProcessSnapshotResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetProcessSnapshotResponse | PlainMessage<GetProcessSnapshotResponse> | undefined, b: GetProcessSnapshotResponse | PlainMessage<GetProcessSnapshotResponse> | undefined): boolean {
+    return proto3.util.equals(GetProcessSnapshotResponse, a, b);
+  }
+}
+
+/**
+ * One cgroup v2 group: a container, a systemd service...
+ *
+ * @generated from message pulsar.v1.CgroupUsage
+ */
+export class CgroupUsage extends Message<CgroupUsage> {
+  /**
+   * relative to the cgroup root
+   *
+   * @generated from field: string path = 1;
+   */
+  path = "";
+
+  /**
+   * short id, empty if it's not a container
+   *
+   * @generated from field: string container_id = 2;
+   */
+  containerId = "";
+
+  /**
+   * 100 = one full core
+   *
+   * @generated from field: double cpu_percent = 3;
+   */
+  cpuPercent = 0;
+
+  /**
+   * cores, 0 = unlimited
+   *
+   * @generated from field: double cpu_limit = 4;
+   */
+  cpuLimit = 0;
+
+  /**
+   * @generated from field: uint64 memory_bytes = 5;
+   */
+  memoryBytes = protoInt64.zero;
+
+  /**
+   * 0 = unlimited
+   *
+   * @generated from field: uint64 memory_limit = 6;
+   */
+  memoryLimit = protoInt64.zero;
+
+  /**
+   * @generated from field: double io_read_kbs = 7;
+   */
+  ioReadKbs = 0;
+
+  /**
+   * @generated from field: double io_write_kbs = 8;
+   */
+  ioWriteKbs = 0;
+
+  /**
+   * @generated from field: int32 pids = 9;
+   */
+  pids = 0;
+
+  /**
+   * 0 = unlimited
+   *
+   * @generated from field: int32 pids_limit = 10;
+   */
+  pidsLimit = 0;
+
+  /**
+   * only in GetCgroupStats
+   *
+   * @generated from field: repeated pulsar.v1.CgroupPoint history = 11;
+   */
+  history: CgroupPoint[] = [];
+
+  constructor(data?: PartialMessage<CgroupUsage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.CgroupUsage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "container_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "cpu_percent", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 4, name: "cpu_limit", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 5, name: "memory_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 6, name: "memory_limit", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 7, name: "io_read_kbs", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 8, name: "io_write_kbs", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 9, name: "pids", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 10, name: "pids_limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 11, name: "history", kind: "message", T: CgroupPoint, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CgroupUsage {
+    return new CgroupUsage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CgroupUsage {
+    return new CgroupUsage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CgroupUsage {
+    return new CgroupUsage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CgroupUsage | PlainMessage<CgroupUsage> | undefined, b: CgroupUsage | PlainMessage<CgroupUsage> | undefined): boolean {
+    return proto3.util.equals(CgroupUsage, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.CgroupPoint
+ */
+export class CgroupPoint extends Message<CgroupPoint> {
+  /**
+   * RFC3339
+   *
+   * @generated from field: string time = 1;
+   */
+  time = "";
+
+  /**
+   * @generated from field: double cpu_percent = 2;
+   */
+  cpuPercent = 0;
+
+  /**
+   * @generated from field: uint64 memory_bytes = 3;
+   */
+  memoryBytes = protoInt64.zero;
+
+  /**
+   * @generated from field: double io_read_kbs = 4;
+   */
+  ioReadKbs = 0;
+
+  /**
+   * @generated from field: double io_write_kbs = 5;
+   */
+  ioWriteKbs = 0;
+
+  /**
+   * @generated from field: int32 pids = 6;
+   */
+  pids = 0;
+
+  constructor(data?: PartialMessage<CgroupPoint>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.CgroupPoint";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "time", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "cpu_percent", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "memory_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "io_read_kbs", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 5, name: "io_write_kbs", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 6, name: "pids", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CgroupPoint {
+    return new CgroupPoint().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CgroupPoint {
+    return new CgroupPoint().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CgroupPoint {
+    return new CgroupPoint().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CgroupPoint | PlainMessage<CgroupPoint> | undefined, b: CgroupPoint | PlainMessage<CgroupPoint> | undefined): boolean {
+    return proto3.util.equals(CgroupPoint, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.GetCgroupStatsRequest
+ */
+export class GetCgroupStatsRequest extends Message<GetCgroupStatsRequest> {
+  /**
+   * empty = the worker's own host
+   *
+   * @generated from field: string host = 1;
+   */
+  host = "";
+
+  /**
+   * unix seconds, 0 = the last hour
+   *
+   * @generated from field: int64 since = 2;
+   */
+  since = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetCgroupStatsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.GetCgroupStatsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "since", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCgroupStatsRequest {
+    return new GetCgroupStatsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCgroupStatsRequest {
+    return new GetCgroupStatsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCgroupStatsRequest {
+    return new GetCgroupStatsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetCgroupStatsRequest | PlainMessage<GetCgroupStatsRequest> | undefined, b: GetCgroupStatsRequest | PlainMessage<GetCgroupStatsRequest> | undefined): boolean {
+    return proto3.util.equals(GetCgroupStatsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.GetCgroupStatsResponse
+ */
+export class GetCgroupStatsResponse extends Message<GetCgroupStatsResponse> {
+  /**
+   * latest values, by memory
+   *
+   * @generated from field: repeated pulsar.v1.CgroupUsage cgroups = 1;
+   */
+  cgroups: CgroupUsage[] = [];
+
+  constructor(data?: PartialMessage<GetCgroupStatsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.GetCgroupStatsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cgroups", kind: "message", T: CgroupUsage, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCgroupStatsResponse {
+    return new GetCgroupStatsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCgroupStatsResponse {
+    return new GetCgroupStatsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCgroupStatsResponse {
+    return new GetCgroupStatsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetCgroupStatsResponse | PlainMessage<GetCgroupStatsResponse> | undefined, b: GetCgroupStatsResponse | PlainMessage<GetCgroupStatsResponse> | undefined): boolean {
+    return proto3.util.equals(GetCgroupStatsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.DiskUsage
+ */
+export class DiskUsage extends Message<DiskUsage> {
+  /**
+   * @generated from field: string mountpoint = 1;
+   */
+  mountpoint = "";
+
+  /**
+   * @generated from field: string device = 2;
+   */
+  device = "";
+
+  /**
+   * @generated from field: string fstype = 3;
+   */
+  fstype = "";
+
+  /**
+   * GB
+   *
+   * @generated from field: double used = 4;
+   */
+  used = 0;
+
+  /**
+   * GB
+   *
+   * @generated from field: double total = 5;
+   */
+  total = 0;
+
+  /**
+   * @generated from field: double percent = 6;
+   */
+  percent = 0;
+
+  /**
+   * @generated from field: double read_kbs = 7;
+   */
+  readKbs = 0;
+
+  /**
+   * @generated from field: double write_kbs = 8;
+   */
+  writeKbs = 0;
+
+  /**
+   * @generated from field: bool is_warning = 9;
+   */
+  isWarning = false;
+
+  /**
+   * percent
+   *
+   * @generated from field: repeated double history = 10;
+   */
+  history: number[] = [];
+
+  constructor(data?: PartialMessage<DiskUsage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.DiskUsage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "mountpoint", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "device", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "fstype", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "used", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 5, name: "total", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 6, name: "percent", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 7, name: "read_kbs", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 8, name: "write_kbs", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 9, name: "is_warning", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "history", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DiskUsage {
+    return new DiskUsage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DiskUsage {
+    return new DiskUsage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DiskUsage {
+    return new DiskUsage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DiskUsage | PlainMessage<DiskUsage> | undefined, b: DiskUsage | PlainMessage<DiskUsage> | undefined): boolean {
+    return proto3.util.equals(DiskUsage, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.InterfaceUsage
+ */
+export class InterfaceUsage extends Message<InterfaceUsage> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: double rx_kbs = 2;
+   */
+  rxKbs = 0;
+
+  /**
+   * @generated from field: double tx_kbs = 3;
+   */
+  txKbs = 0;
+
+  constructor(data?: PartialMessage<InterfaceUsage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.InterfaceUsage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rx_kbs", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "tx_kbs", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InterfaceUsage {
+    return new InterfaceUsage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): InterfaceUsage {
+    return new InterfaceUsage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): InterfaceUsage {
+    return new InterfaceUsage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: InterfaceUsage | PlainMessage<InterfaceUsage> | undefined, b: InterfaceUsage | PlainMessage<InterfaceUsage> | undefined): boolean {
+    return proto3.util.equals(InterfaceUsage, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.LoadAverage
+ */
+export class LoadAverage extends Message<LoadAverage> {
+  /**
+   * @generated from field: double load1 = 1;
+   */
+  load1 = 0;
+
+  /**
+   * @generated from field: double load5 = 2;
+   */
+  load5 = 0;
+
+  /**
+   * @generated from field: double load15 = 3;
+   */
+  load15 = 0;
+
+  constructor(data?: PartialMessage<LoadAverage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.LoadAverage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "load1", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 2, name: "load5", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "load15", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LoadAverage {
+    return new LoadAverage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LoadAverage {
+    return new LoadAverage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LoadAverage {
+    return new LoadAverage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LoadAverage | PlainMessage<LoadAverage> | undefined, b: LoadAverage | PlainMessage<LoadAverage> | undefined): boolean {
+    return proto3.util.equals(LoadAverage, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.ThreadUsage
+ */
+export class ThreadUsage extends Message<ThreadUsage> {
+  /**
+   * @generated from field: int32 total = 1;
+   */
+  total = 0;
+
+  /**
+   * @generated from field: int32 running = 2;
+   */
+  running = 0;
+
+  /**
+   * @generated from field: int32 sleeping = 3;
+   */
+  sleeping = 0;
+
+  /**
+   * @generated from field: int32 zombie = 4;
+   */
+  zombie = 0;
+
+  /**
+   * @generated from field: bool is_warning = 5;
+   */
+  isWarning = false;
+
+  /**
+   * @generated from field: repeated pulsar.v1.ThreadHistory history = 6;
+   */
+  history: ThreadHistory[] = [];
+
+  constructor(data?: PartialMessage<ThreadUsage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.ThreadUsage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "total", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "running", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "sleeping", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "zombie", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "is_warning", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "history", kind: "message", T: ThreadHistory, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ThreadUsage {
+    return new ThreadUsage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ThreadUsage {
+    return new ThreadUsage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ThreadUsage {
+    return new ThreadUsage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ThreadUsage | PlainMessage<ThreadUsage> | undefined, b: ThreadUsage | PlainMessage<ThreadUsage> | undefined): boolean {
+    return proto3.util.equals(ThreadUsage, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.ThreadHistory
+ */
+export class ThreadHistory extends Message<ThreadHistory> {
+  /**
+   * @generated from field: int32 running = 1;
+   */
+  running = 0;
+
+  /**
+   * @generated from field: int32 sleeping = 2;
+   */
+  sleeping = 0;
+
+  /**
+   * @generated from field: int32 zombie = 3;
+   */
+  zombie = 0;
+
+  /**
+   * @generated from field: string time = 4;
+   */
+  time = "";
+
+  constructor(data?: PartialMessage<ThreadHistory>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.ThreadHistory";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "running", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "sleeping", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "zombie", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "time", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ThreadHistory {
+    return new ThreadHistory().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ThreadHistory {
+    return new ThreadHistory().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ThreadHistory {
+    return new ThreadHistory().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ThreadHistory | PlainMessage<ThreadHistory> | undefined, b: ThreadHistory | PlainMessage<ThreadHistory> | undefined): boolean {
+    return proto3.util.equals(ThreadHistory, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.ResourceUsage
+ */
+export class ResourceUsage extends Message<ResourceUsage> {
+  /**
+   * @generated from field: double used = 1;
+   */
+  used = 0;
+
+  /**
+   * @generated from field: double total = 2;
+   */
+  total = 0;
+
+  /**
+   * @generated from field: double percent = 3;
+   */
+  percent = 0;
+
+  /**
+   * @generated from field: string unit = 4;
+   */
+  unit = "";
+
+  /**
+   * @generated from field: bool is_warning = 5;
+   */
+  isWarning = false;
+
+  /**
+   * @generated from field: repeated double history = 6;
+   */
+  history: number[] = [];
+
+  constructor(data?: PartialMessage<ResourceUsage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.ResourceUsage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "used", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 2, name: "total", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "percent", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 4, name: "unit", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "is_warning", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "history", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResourceUsage {
+    return new ResourceUsage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResourceUsage {
+    return new ResourceUsage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResourceUsage {
+    return new ResourceUsage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResourceUsage | PlainMessage<ResourceUsage> | undefined, b: ResourceUsage | PlainMessage<ResourceUsage> | undefined): boolean {
+    return proto3.util.equals(ResourceUsage, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.SystemInfo
+ */
+export class SystemInfo extends Message<SystemInfo> {
+  /**
+   * @generated from field: string hostname = 1;
+   */
+  hostname = "";
+
+  /**
+   * @generated from field: string os = 2;
+   */
+  os = "";
+
+  /**
+   * @generated from field: uint64 uptime_seconds = 3;
+   */
+  uptimeSeconds = protoInt64.zero;
+
+  /**
+   * @generated from field: string platform = 4;
+   */
+  platform = "";
+
+  /**
+   * @generated from field: string platform_version = 5;
+   */
+  platformVersion = "";
+
+  constructor(data?: PartialMessage<SystemInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.SystemInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "hostname", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "os", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "uptime_seconds", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "platform", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "platform_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SystemInfo {
+    return new SystemInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SystemInfo {
+    return new SystemInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SystemInfo {
+    return new SystemInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SystemInfo | PlainMessage<SystemInfo> | undefined, b: SystemInfo | PlainMessage<SystemInfo> | undefined): boolean {
+    return proto3.util.equals(SystemInfo, a, b);
+  }
+}
+
+/**
+ * AlertRule, a threshold on a system metric. An incident opens once the
+ * comparison has held for duration_seconds and resolves when it stops.
+ *
+ * @generated from message pulsar.v1.AlertRule
+ */
+export class AlertRule extends Message<AlertRule> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * cpu_percent, memory_percent, disk_percent, network_kb_s, threads_total, threads_zombie, load_1, load_5, load_15
+   *
+   * @generated from field: string metric = 3;
+   */
+  metric = "";
+
+  /**
+   * >, >=, <, <=
+   *
+   * @generated from field: string comparison = 4;
+   */
+  comparison = "";
+
+  /**
+   * @generated from field: double threshold = 5;
+   */
+  threshold = 0;
+
+  /**
+   * @generated from field: int32 duration_seconds = 6;
+   */
+  durationSeconds = 0;
+
+  /**
+   * @generated from field: bool is_active = 7;
+   */
+  isActive = false;
+
+  constructor(data?: PartialMessage<AlertRule>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.AlertRule";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "metric", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "comparison", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "threshold", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 6, name: "duration_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "is_active", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlertRule {
+    return new AlertRule().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AlertRule {
+    return new AlertRule().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AlertRule {
+    return new AlertRule().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AlertRule | PlainMessage<AlertRule> | undefined, b: AlertRule | PlainMessage<AlertRule> | undefined): boolean {
+    return proto3.util.equals(AlertRule, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.Incident
+ */
+export class Incident extends Message<Incident> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string rule_id = 2;
+   */
+  ruleId = "";
+
+  /**
+   * @generated from field: string rule_name = 3;
+   */
+  ruleName = "";
+
+  /**
+   * @generated from field: string metric = 4;
+   */
+  metric = "";
+
+  /**
+   * @generated from field: string comparison = 5;
+   */
+  comparison = "";
+
+  /**
+   * @generated from field: double threshold = 6;
+   */
+  threshold = 0;
+
+  /**
+   * when it was opened
+   *
+   * @generated from field: double value = 7;
+   */
+  value = 0;
+
+  /**
+   * RFC3339, when the condition began
+   *
+   * @generated from field: string started_at = 8;
+   */
+  startedAt = "";
+
+  /**
+   * RFC3339, empty while open
+   *
+   * @generated from field: string resolved_at = 9;
+   */
+  resolvedAt = "";
+
+  /**
+   * @generated from field: string host = 10;
+   */
+  host = "";
+
+  constructor(data?: PartialMessage<Incident>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.Incident";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rule_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "rule_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "metric", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "comparison", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "threshold", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 7, name: "value", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 8, name: "started_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "resolved_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Incident {
+    return new Incident().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Incident {
+    return new Incident().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Incident {
+    return new Incident().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Incident | PlainMessage<Incident> | undefined, b: Incident | PlainMessage<Incident> | undefined): boolean {
+    return proto3.util.equals(Incident, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.ListAlertRulesRequest
+ */
+export class ListAlertRulesRequest extends Message<ListAlertRulesRequest> {
+  constructor(data?: PartialMessage<ListAlertRulesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.ListAlertRulesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAlertRulesRequest {
+    return new ListAlertRulesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAlertRulesRequest {
+    return new ListAlertRulesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAlertRulesRequest {
+    return new ListAlertRulesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAlertRulesRequest | PlainMessage<ListAlertRulesRequest> | undefined, b: ListAlertRulesRequest | PlainMessage<ListAlertRulesRequest> | undefined): boolean {
+    return proto3.util.equals(ListAlertRulesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.ListAlertRulesResponse
+ */
+export class ListAlertRulesResponse extends Message<ListAlertRulesResponse> {
+  /**
+   * @generated from field: repeated pulsar.v1.AlertRule rules = 1;
+   */
+  rules: AlertRule[] = [];
+
+  constructor(data?: PartialMessage<ListAlertRulesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.ListAlertRulesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rules", kind: "message", T: AlertRule, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAlertRulesResponse {
+    return new ListAlertRulesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAlertRulesResponse {
+    return new ListAlertRulesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAlertRulesResponse {
+    return new ListAlertRulesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAlertRulesResponse | PlainMessage<ListAlertRulesResponse> | undefined, b: ListAlertRulesResponse | PlainMessage<ListAlertRulesResponse> | undefined): boolean {
+    return proto3.util.equals(ListAlertRulesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.CreateAlertRuleRequest
+ */
+export class CreateAlertRuleRequest extends Message<CreateAlertRuleRequest> {
+  /**
+   * id is ignored
+   *
+   * @generated from field: pulsar.v1.AlertRule rule = 1;
+   */
+  rule?: AlertRule;
+
+  constructor(data?: PartialMessage<CreateAlertRuleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.CreateAlertRuleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rule", kind: "message", T: AlertRule },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAlertRuleRequest {
+    return new CreateAlertRuleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateAlertRuleRequest {
+    return new CreateAlertRuleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateAlertRuleRequest {
+    return new CreateAlertRuleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateAlertRuleRequest | PlainMessage<CreateAlertRuleRequest> | undefined, b: CreateAlertRuleRequest | PlainMessage<CreateAlertRuleRequest> | undefined): boolean {
+    return proto3.util.equals(CreateAlertRuleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.CreateAlertRuleResponse
+ */
+export class CreateAlertRuleResponse extends Message<CreateAlertRuleResponse> {
+  /**
+   * @generated from field: pulsar.v1.AlertRule rule = 1;
+   */
+  rule?: AlertRule;
+
+  constructor(data?: PartialMessage<CreateAlertRuleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.CreateAlertRuleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rule", kind: "message", T: AlertRule },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAlertRuleResponse {
+    return new CreateAlertRuleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateAlertRuleResponse {
+    return new CreateAlertRuleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateAlertRuleResponse {
+    return new CreateAlertRuleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateAlertRuleResponse | PlainMessage<CreateAlertRuleResponse> | undefined, b: CreateAlertRuleResponse | PlainMessage<CreateAlertRuleResponse> | undefined): boolean {
+    return proto3.util.equals(CreateAlertRuleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.UpdateAlertRuleRequest
+ */
+export class UpdateAlertRuleRequest extends Message<UpdateAlertRuleRequest> {
+  /**
+   * @generated from field: pulsar.v1.AlertRule rule = 1;
+   */
+  rule?: AlertRule;
+
+  constructor(data?: PartialMessage<UpdateAlertRuleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.UpdateAlertRuleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rule", kind: "message", T: AlertRule },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateAlertRuleRequest {
+    return new UpdateAlertRuleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateAlertRuleRequest {
+    return new UpdateAlertRuleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateAlertRuleRequest {
+    return new UpdateAlertRuleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateAlertRuleRequest | PlainMessage<UpdateAlertRuleRequest> | undefined, b: UpdateAlertRuleRequest | PlainMessage<UpdateAlertRuleRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateAlertRuleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.UpdateAlertRuleResponse
+ */
+export class UpdateAlertRuleResponse extends Message<UpdateAlertRuleResponse> {
+  /**
+   * @generated from field: pulsar.v1.AlertRule rule = 1;
+   */
+  rule?: AlertRule;
+
+  constructor(data?: PartialMessage<UpdateAlertRuleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.UpdateAlertRuleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rule", kind: "message", T: AlertRule },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateAlertRuleResponse {
+    return new UpdateAlertRuleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateAlertRuleResponse {
+    return new UpdateAlertRuleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateAlertRuleResponse {
+    return new UpdateAlertRuleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateAlertRuleResponse | PlainMessage<UpdateAlertRuleResponse> | undefined, b: UpdateAlertRuleResponse | PlainMessage<UpdateAlertRuleResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateAlertRuleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.DeleteAlertRuleRequest
+ */
+export class DeleteAlertRuleRequest extends Message<DeleteAlertRuleRequest> {
+  /**
+   * @generated from field: string rule_id = 1;
+   */
+  ruleId = "";
+
+  constructor(data?: PartialMessage<DeleteAlertRuleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.DeleteAlertRuleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rule_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAlertRuleRequest {
+    return new DeleteAlertRuleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAlertRuleRequest {
+    return new DeleteAlertRuleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAlertRuleRequest {
+    return new DeleteAlertRuleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteAlertRuleRequest | PlainMessage<DeleteAlertRuleRequest> | undefined, b: DeleteAlertRuleRequest | PlainMessage<DeleteAlertRuleRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteAlertRuleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.DeleteAlertRuleResponse
+ */
+export class DeleteAlertRuleResponse extends Message<DeleteAlertRuleResponse> {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success = false;
+
+  constructor(data?: PartialMessage<DeleteAlertRuleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.DeleteAlertRuleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "success", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAlertRuleResponse {
+    return new DeleteAlertRuleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAlertRuleResponse {
+    return new DeleteAlertRuleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAlertRuleResponse {
+    return new DeleteAlertRuleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteAlertRuleResponse | PlainMessage<DeleteAlertRuleResponse> | undefined, b: DeleteAlertRuleResponse | PlainMessage<DeleteAlertRuleResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteAlertRuleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.ListIncidentsRequest
+ */
+export class ListIncidentsRequest extends Message<ListIncidentsRequest> {
+  constructor(data?: PartialMessage<ListIncidentsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.ListIncidentsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListIncidentsRequest {
+    return new ListIncidentsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListIncidentsRequest {
+    return new ListIncidentsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListIncidentsRequest {
+    return new ListIncidentsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListIncidentsRequest | PlainMessage<ListIncidentsRequest> | undefined, b: ListIncidentsRequest | PlainMessage<ListIncidentsRequest> | undefined): boolean {
+    return proto3.util.equals(ListIncidentsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.ListIncidentsResponse
+ */
+export class ListIncidentsResponse extends Message<ListIncidentsResponse> {
+  /**
+   * newest first
+   *
+   * @generated from field: repeated pulsar.v1.Incident incidents = 1;
+   */
+  incidents: Incident[] = [];
+
+  constructor(data?: PartialMessage<ListIncidentsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.ListIncidentsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "incidents", kind: "message", T: Incident, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListIncidentsResponse {
+    return new ListIncidentsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListIncidentsResponse {
+    return new ListIncidentsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListIncidentsResponse {
+    return new ListIncidentsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListIncidentsResponse | PlainMessage<ListIncidentsResponse> | undefined, b: ListIncidentsResponse | PlainMessage<ListIncidentsResponse> | undefined): boolean {
+    return proto3.util.equals(ListIncidentsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.Agent
+ */
+export class Agent extends Message<Agent> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string host = 2;
+   */
+  host = "";
+
+  /**
+   * RFC3339, empty if it never reported
+   *
+   * @generated from field: string last_seen = 3;
+   */
+  lastSeen = "";
+
+  constructor(data?: PartialMessage<Agent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.Agent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "last_seen", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Agent {
+    return new Agent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Agent {
+    return new Agent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Agent {
+    return new Agent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Agent | PlainMessage<Agent> | undefined, b: Agent | PlainMessage<Agent> | undefined): boolean {
+    return proto3.util.equals(Agent, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.RegisterAgentRequest
+ */
+export class RegisterAgentRequest extends Message<RegisterAgentRequest> {
+  /**
+   * @generated from field: string host = 1;
+   */
+  host = "";
+
+  constructor(data?: PartialMessage<RegisterAgentRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.RegisterAgentRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RegisterAgentRequest {
+    return new RegisterAgentRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RegisterAgentRequest {
+    return new RegisterAgentRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RegisterAgentRequest {
+    return new RegisterAgentRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RegisterAgentRequest | PlainMessage<RegisterAgentRequest> | undefined, b: RegisterAgentRequest | PlainMessage<RegisterAgentRequest> | undefined): boolean {
+    return proto3.util.equals(RegisterAgentRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.RegisterAgentResponse
+ */
+export class RegisterAgentResponse extends Message<RegisterAgentResponse> {
+  /**
+   * @generated from field: pulsar.v1.Agent agent = 1;
+   */
+  agent?: Agent;
+
+  /**
+   * only shown once
+   *
+   * @generated from field: string token = 2;
+   */
+  token = "";
+
+  constructor(data?: PartialMessage<RegisterAgentResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.RegisterAgentResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "agent", kind: "message", T: Agent },
+    { no: 2, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RegisterAgentResponse {
+    return new RegisterAgentResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RegisterAgentResponse {
+    return new RegisterAgentResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RegisterAgentResponse {
+    return new RegisterAgentResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RegisterAgentResponse | PlainMessage<RegisterAgentResponse> | undefined, b: RegisterAgentResponse | PlainMessage<RegisterAgentResponse> | undefined): boolean {
+    return proto3.util.equals(RegisterAgentResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.ListAgentsRequest
+ */
+export class ListAgentsRequest extends Message<ListAgentsRequest> {
+  constructor(data?: PartialMessage<ListAgentsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.ListAgentsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAgentsRequest {
+    return new ListAgentsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAgentsRequest {
+    return new ListAgentsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAgentsRequest {
+    return new ListAgentsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAgentsRequest | PlainMessage<ListAgentsRequest> | undefined, b: ListAgentsRequest | PlainMessage<ListAgentsRequest> | undefined): boolean {
+    return proto3.util.equals(ListAgentsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.ListAgentsResponse
+ */
+export class ListAgentsResponse extends Message<ListAgentsResponse> {
+  /**
+   * @generated from field: repeated pulsar.v1.Agent agents = 1;
+   */
+  agents: Agent[] = [];
+
+  constructor(data?: PartialMessage<ListAgentsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.ListAgentsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "agents", kind: "message", T: Agent, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAgentsResponse {
+    return new ListAgentsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAgentsResponse {
+    return new ListAgentsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAgentsResponse {
+    return new ListAgentsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAgentsResponse | PlainMessage<ListAgentsResponse> | undefined, b: ListAgentsResponse | PlainMessage<ListAgentsResponse> | undefined): boolean {
+    return proto3.util.equals(ListAgentsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.DeleteAgentRequest
+ */
+export class DeleteAgentRequest extends Message<DeleteAgentRequest> {
+  /**
+   * @generated from field: string agent_id = 1;
+   */
+  agentId = "";
+
+  constructor(data?: PartialMessage<DeleteAgentRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.DeleteAgentRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "agent_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAgentRequest {
+    return new DeleteAgentRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAgentRequest {
+    return new DeleteAgentRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAgentRequest {
+    return new DeleteAgentRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteAgentRequest | PlainMessage<DeleteAgentRequest> | undefined, b: DeleteAgentRequest | PlainMessage<DeleteAgentRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteAgentRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.DeleteAgentResponse
+ */
+export class DeleteAgentResponse extends Message<DeleteAgentResponse> {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success = false;
+
+  constructor(data?: PartialMessage<DeleteAgentResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.DeleteAgentResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "success", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAgentResponse {
+    return new DeleteAgentResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAgentResponse {
+    return new DeleteAgentResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAgentResponse {
+    return new DeleteAgentResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteAgentResponse | PlainMessage<DeleteAgentResponse> | undefined, b: DeleteAgentResponse | PlainMessage<DeleteAgentResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteAgentResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.Secret
+ */
+export class Secret extends Message<Secret> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * "{{secret:<id>}}", what configs use
+   *
+   * @generated from field: string ref = 3;
+   */
+  ref = "";
+
+  /**
+   * set for secrets sealed from a monitor's credentials
+   *
+   * @generated from field: string monitor_id = 4;
+   */
+  monitorId = "";
+
+  /**
+   * fingerprint of the master key it is sealed with
+   *
+   * @generated from field: string key_id = 5;
+   */
+  keyId = "";
+
+  /**
+   * RFC3339
+   *
+   * @generated from field: string created_at = 6;
+   */
+  createdAt = "";
+
+  /**
+   * RFC3339
+   *
+   * @generated from field: string updated_at = 7;
+   */
+  updatedAt = "";
+
+  constructor(data?: PartialMessage<Secret>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.Secret";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "ref", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "monitor_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "key_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "updated_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Secret {
+    return new Secret().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Secret {
+    return new Secret().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Secret {
+    return new Secret().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Secret | PlainMessage<Secret> | undefined, b: Secret | PlainMessage<Secret> | undefined): boolean {
+    return proto3.util.equals(Secret, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.ListSecretsRequest
+ */
+export class ListSecretsRequest extends Message<ListSecretsRequest> {
+  constructor(data?: PartialMessage<ListSecretsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.ListSecretsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSecretsRequest {
+    return new ListSecretsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSecretsRequest {
+    return new ListSecretsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSecretsRequest {
+    return new ListSecretsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSecretsRequest | PlainMessage<ListSecretsRequest> | undefined, b: ListSecretsRequest | PlainMessage<ListSecretsRequest> | undefined): boolean {
+    return proto3.util.equals(ListSecretsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.ListSecretsResponse
+ */
+export class ListSecretsResponse extends Message<ListSecretsResponse> {
+  /**
+   * @generated from field: repeated pulsar.v1.Secret secrets = 1;
+   */
+  secrets: Secret[] = [];
+
+  constructor(data?: PartialMessage<ListSecretsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.ListSecretsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "secrets", kind: "message", T: Secret, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSecretsResponse {
+    return new ListSecretsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSecretsResponse {
+    return new ListSecretsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSecretsResponse {
+    return new ListSecretsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSecretsResponse | PlainMessage<ListSecretsResponse> | undefined, b: ListSecretsResponse | PlainMessage<ListSecretsResponse> | undefined): boolean {
+    return proto3.util.equals(ListSecretsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.CreateSecretRequest
+ */
+export class CreateSecretRequest extends Message<CreateSecretRequest> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string value = 2;
+   */
+  value = "";
+
+  constructor(data?: PartialMessage<CreateSecretRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.CreateSecretRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSecretRequest {
+    return new CreateSecretRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSecretRequest {
+    return new CreateSecretRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSecretRequest {
+    return new CreateSecretRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateSecretRequest | PlainMessage<CreateSecretRequest> | undefined, b: CreateSecretRequest | PlainMessage<CreateSecretRequest> | undefined): boolean {
+    return proto3.util.equals(CreateSecretRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.CreateSecretResponse
+ */
+export class CreateSecretResponse extends Message<CreateSecretResponse> {
+  /**
+   * @generated from field: pulsar.v1.Secret secret = 1;
+   */
+  secret?: Secret;
+
+  constructor(data?: PartialMessage<CreateSecretResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.CreateSecretResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "secret", kind: "message", T: Secret },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSecretResponse {
+    return new CreateSecretResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSecretResponse {
+    return new CreateSecretResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSecretResponse {
+    return new CreateSecretResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateSecretResponse | PlainMessage<CreateSecretResponse> | undefined, b: CreateSecretResponse | PlainMessage<CreateSecretResponse> | undefined): boolean {
+    return proto3.util.equals(CreateSecretResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.UpdateSecretRequest
+ */
+export class UpdateSecretRequest extends Message<UpdateSecretRequest> {
+  /**
+   * @generated from field: string secret_id = 1;
+   */
+  secretId = "";
+
+  /**
+   * @generated from field: string value = 2;
+   */
+  value = "";
+
+  constructor(data?: PartialMessage<UpdateSecretRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.UpdateSecretRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "secret_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSecretRequest {
+    return new UpdateSecretRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSecretRequest {
+    return new UpdateSecretRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSecretRequest {
+    return new UpdateSecretRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateSecretRequest | PlainMessage<UpdateSecretRequest> | undefined, b: UpdateSecretRequest | PlainMessage<UpdateSecretRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateSecretRequest, a, b);
+  }
+}
 
+/**
+ * @generated from message pulsar.v1.UpdateSecretResponse
+ */
+export class UpdateSecretResponse extends Message<UpdateSecretResponse> {
   /**
-   * @generated from field: int32 download = 5;
+   * @generated from field: pulsar.v1.Secret secret = 1;
    */
-  download = 0;
+  secret?: Secret;
 
-  constructor(data?: PartialMessage<MonitorTiming>) {
+  constructor(data?: PartialMessage<UpdateSecretResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "pulsar.v1.MonitorTiming";
+  static readonly typeName = "pulsar.v1.UpdateSecretResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "dns", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 2, name: "tcp", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 3, name: "tls", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 4, name: "ttfb", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 5, name: "download", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 1, name: "secret", kind: "message", T: Secret },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MonitorTiming {
-    return new MonitorTiming().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSecretResponse {
+    return new UpdateSecretResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MonitorTiming {
-    return new MonitorTiming().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSecretResponse {
+    return new UpdateSecretResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MonitorTiming {
-    return new MonitorTiming().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSecretResponse {
+    return new UpdateSecretResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: MonitorTiming | PlainMessage<MonitorTiming> | undefined, b: MonitorTiming | PlainMessage<MonitorTiming> | undefined): boolean {
-    return proto3.util.equals(MonitorTiming, a, b);
+  static equals(a: UpdateSecretResponse | PlainMessage<UpdateSecretResponse> | undefined, b: UpdateSecretResponse | PlainMessage<UpdateSecretResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateSecretResponse, a, b);
   }
 }
 
 /**
- * --- SİSTEM İSTATİSTİKLERİ ---
- *
- * @generated from message pulsar.v1.SystemStatsResponse
+ * @generated from message pulsar.v1.DeleteSecretRequest
  */
-export class SystemStatsResponse extends Message<SystemStatsResponse> {
+export class DeleteSecretRequest extends Message<DeleteSecretRequest> {
   /**
-   * @generated from field: pulsar.v1.ResourceUsage cpu = 1;
+   * @generated from field: string secret_id = 1;
    */
-  cpu?: ResourceUsage;
+  secretId = "";
 
-  /**
-   * @generated from field: pulsar.v1.ResourceUsage memory = 2;
-   */
-  memory?: ResourceUsage;
+  constructor(data?: PartialMessage<DeleteSecretRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
 
-  /**
-   * @generated from field: pulsar.v1.ResourceUsage disk = 3;
-   */
-  disk?: ResourceUsage;
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.DeleteSecretRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "secret_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
 
-  /**
-   * @generated from field: pulsar.v1.ResourceUsage network = 4;
-   */
-  network?: ResourceUsage;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSecretRequest {
+    return new DeleteSecretRequest().fromBinary(bytes, options);
+  }
 
-  /**
-   * @generated from field: pulsar.v1.ThreadUsage threads = 5;
-   */
-  threads?: ThreadUsage;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSecretRequest {
+    return new DeleteSecretRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSecretRequest {
+    return new DeleteSecretRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteSecretRequest | PlainMessage<DeleteSecretRequest> | undefined, b: DeleteSecretRequest | PlainMessage<DeleteSecretRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteSecretRequest, a, b);
+  }
+}
 
+/**
+ * @generated from message pulsar.v1.DeleteSecretResponse
+ */
+export class DeleteSecretResponse extends Message<DeleteSecretResponse> {
   /**
-   * @generated from field: pulsar.v1.SystemInfo info = 6;
+   * @generated from field: bool success = 1;
    */
-  info?: SystemInfo;
+  success = false;
 
-  constructor(data?: PartialMessage<SystemStatsResponse>) {
+  constructor(data?: PartialMessage<DeleteSecretResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "pulsar.v1.SystemStatsResponse";
+  static readonly typeName = "pulsar.v1.DeleteSecretResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "cpu", kind: "message", T: ResourceUsage },
-    { no: 2, name: "memory", kind: "message", T: ResourceUsage },
-    { no: 3, name: "disk", kind: "message", T: ResourceUsage },
-    { no: 4, name: "network", kind: "message", T: ResourceUsage },
-    { no: 5, name: "threads", kind: "message", T: ThreadUsage },
-    { no: 6, name: "info", kind: "message", T: SystemInfo },
+    { no: 1, name: "success", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SystemStatsResponse {
-    return new SystemStatsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSecretResponse {
+    return new DeleteSecretResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SystemStatsResponse {
-    return new SystemStatsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSecretResponse {
+    return new DeleteSecretResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SystemStatsResponse {
-    return new SystemStatsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSecretResponse {
+    return new DeleteSecretResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SystemStatsResponse | PlainMessage<SystemStatsResponse> | undefined, b: SystemStatsResponse | PlainMessage<SystemStatsResponse> | undefined): boolean {
-    return proto3.util.equals(SystemStatsResponse, a, b);
+  static equals(a: DeleteSecretResponse | PlainMessage<DeleteSecretResponse> | undefined, b: DeleteSecretResponse | PlainMessage<DeleteSecretResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteSecretResponse, a, b);
   }
 }
 
 /**
- * @generated from message pulsar.v1.ThreadUsage
+ * SystemSample, one unrounded reading of a host, as pushed by agents
+ *
+ * @generated from message pulsar.v1.SystemSample
  */
-export class ThreadUsage extends Message<ThreadUsage> {
+export class SystemSample extends Message<SystemSample> {
   /**
-   * @generated from field: int32 total = 1;
+   * unix ms
+   *
+   * @generated from field: int64 time = 1;
    */
-  total = 0;
+  time = protoInt64.zero;
 
   /**
-   * @generated from field: int32 running = 2;
+   * @generated from field: double cpu_percent = 2;
    */
-  running = 0;
+  cpuPercent = 0;
 
   /**
-   * @generated from field: int32 sleeping = 3;
+   * @generated from field: repeated double cpu_cores = 3;
    */
-  sleeping = 0;
+  cpuCores: number[] = [];
 
   /**
-   * @generated from field: int32 zombie = 4;
+   * @generated from field: pulsar.v1.LoadAverage load = 4;
    */
-  zombie = 0;
+  load?: LoadAverage;
 
   /**
-   * @generated from field: bool is_warning = 5;
+   * @generated from field: double memory_percent = 5;
    */
-  isWarning = false;
+  memoryPercent = 0;
 
   /**
-   * @generated from field: repeated pulsar.v1.ThreadHistory history = 6;
+   * bytes
+   *
+   * @generated from field: uint64 memory_used = 6;
    */
-  history: ThreadHistory[] = [];
+  memoryUsed = protoInt64.zero;
 
-  constructor(data?: PartialMessage<ThreadUsage>) {
-    super();
-    proto3.util.initPartial(data, this);
-  }
+  /**
+   * @generated from field: uint64 memory_total = 7;
+   */
+  memoryTotal = protoInt64.zero;
 
-  static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "pulsar.v1.ThreadUsage";
-  static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "total", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 2, name: "running", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 3, name: "sleeping", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 4, name: "zombie", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 5, name: "is_warning", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 6, name: "history", kind: "message", T: ThreadHistory, repeated: true },
-  ]);
+  /**
+   * @generated from field: double disk_percent = 8;
+   */
+  diskPercent = 0;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ThreadUsage {
-    return new ThreadUsage().fromBinary(bytes, options);
-  }
+  /**
+   * bytes
+   *
+   * @generated from field: uint64 disk_used = 9;
+   */
+  diskUsed = protoInt64.zero;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ThreadUsage {
-    return new ThreadUsage().fromJson(jsonValue, options);
-  }
+  /**
+   * @generated from field: uint64 disk_total = 10;
+   */
+  diskTotal = protoInt64.zero;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ThreadUsage {
-    return new ThreadUsage().fromJsonString(jsonString, options);
-  }
+  /**
+   * @generated from field: double network_kbs = 11;
+   */
+  networkKbs = 0;
 
-  static equals(a: ThreadUsage | PlainMessage<ThreadUsage> | undefined, b: ThreadUsage | PlainMessage<ThreadUsage> | undefined): boolean {
-    return proto3.util.equals(ThreadUsage, a, b);
-  }
-}
+  /**
+   * @generated from field: repeated pulsar.v1.DiskSample disks = 12;
+   */
+  disks: DiskSample[] = [];
 
-/**
- * @generated from message pulsar.v1.ThreadHistory
- */
-export class ThreadHistory extends Message<ThreadHistory> {
   /**
-   * @generated from field: int32 running = 1;
+   * @generated from field: repeated pulsar.v1.InterfaceUsage interfaces = 13;
    */
-  running = 0;
+  interfaces: InterfaceUsage[] = [];
 
   /**
-   * @generated from field: int32 sleeping = 2;
+   * counts only
+   *
+   * @generated from field: pulsar.v1.ThreadUsage processes = 14;
    */
-  sleeping = 0;
+  processes?: ThreadUsage;
 
   /**
-   * @generated from field: int32 zombie = 3;
+   * @generated from field: repeated pulsar.v1.ProcessInfo top_cpu = 15;
    */
-  zombie = 0;
+  topCpu: ProcessInfo[] = [];
 
   /**
-   * @generated from field: string time = 4;
+   * @generated from field: repeated pulsar.v1.ProcessInfo top_memory = 16;
    */
-  time = "";
+  topMemory: ProcessInfo[] = [];
 
-  constructor(data?: PartialMessage<ThreadHistory>) {
+  /**
+   * @generated from field: pulsar.v1.SystemInfo info = 17;
+   */
+  info?: SystemInfo;
+
+  /**
+   * @generated from field: repeated pulsar.v1.CgroupUsage cgroups = 18;
+   */
+  cgroups: CgroupUsage[] = [];
+
+  constructor(data?: PartialMessage<SystemSample>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "pulsar.v1.ThreadHistory";
+  static readonly typeName = "pulsar.v1.SystemSample";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "running", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 2, name: "sleeping", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 3, name: "zombie", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 4, name: "time", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "time", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "cpu_percent", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "cpu_cores", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, repeated: true },
+    { no: 4, name: "load", kind: "message", T: LoadAverage },
+    { no: 5, name: "memory_percent", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 6, name: "memory_used", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 7, name: "memory_total", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 8, name: "disk_percent", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 9, name: "disk_used", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 10, name: "disk_total", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 11, name: "network_kbs", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 12, name: "disks", kind: "message", T: DiskSample, repeated: true },
+    { no: 13, name: "interfaces", kind: "message", T: InterfaceUsage, repeated: true },
+    { no: 14, name: "processes", kind: "message", T: ThreadUsage },
+    { no: 15, name: "top_cpu", kind: "message", T: ProcessInfo, repeated: true },
+    { no: 16, name: "top_memory", kind: "message", T: ProcessInfo, repeated: true },
+    { no: 17, name: "info", kind: "message", T: SystemInfo },
+    { no: 18, name: "cgroups", kind: "message", T: CgroupUsage, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ThreadHistory {
-    return new ThreadHistory().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SystemSample {
+    return new SystemSample().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ThreadHistory {
-    return new ThreadHistory().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SystemSample {
+    return new SystemSample().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ThreadHistory {
-    return new ThreadHistory().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SystemSample {
+    return new SystemSample().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ThreadHistory | PlainMessage<ThreadHistory> | undefined, b: ThreadHistory | PlainMessage<ThreadHistory> | undefined): boolean {
-    return proto3.util.equals(ThreadHistory, a, b);
+  static equals(a: SystemSample | PlainMessage<SystemSample> | undefined, b: SystemSample | PlainMessage<SystemSample> | undefined): boolean {
+    return proto3.util.equals(SystemSample, a, b);
   }
 }
 
 /**
- * @generated from message pulsar.v1.ResourceUsage
+ * @generated from message pulsar.v1.DiskSample
  */
-export class ResourceUsage extends Message<ResourceUsage> {
+export class DiskSample extends Message<DiskSample> {
   /**
-   * @generated from field: double used = 1;
+   * @generated from field: string mountpoint = 1;
    */
-  used = 0;
+  mountpoint = "";
 
   /**
-   * @generated from field: double total = 2;
+   * @generated from field: string device = 2;
    */
-  total = 0;
+  device = "";
 
   /**
-   * @generated from field: double percent = 3;
+   * @generated from field: string fstype = 3;
    */
-  percent = 0;
+  fstype = "";
 
   /**
-   * @generated from field: string unit = 4;
+   * bytes
+   *
+   * @generated from field: uint64 used = 4;
    */
-  unit = "";
+  used = protoInt64.zero;
 
   /**
-   * @generated from field: bool is_warning = 5;
+   * @generated from field: uint64 total = 5;
    */
-  isWarning = false;
+  total = protoInt64.zero;
 
   /**
-   * @generated from field: repeated double history = 6;
+   * @generated from field: double percent = 6;
    */
-  history: number[] = [];
+  percent = 0;
 
-  constructor(data?: PartialMessage<ResourceUsage>) {
+  /**
+   * @generated from field: double read_kbs = 7;
+   */
+  readKbs = 0;
+
+  /**
+   * @generated from field: double write_kbs = 8;
+   */
+  writeKbs = 0;
+
+  constructor(data?: PartialMessage<DiskSample>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "pulsar.v1.ResourceUsage";
+  static readonly typeName = "pulsar.v1.DiskSample";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "used", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
-    { no: 2, name: "total", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
-    { no: 3, name: "percent", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
-    { no: 4, name: "unit", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "is_warning", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 6, name: "history", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, repeated: true },
+    { no: 1, name: "mountpoint", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "device", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "fstype", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "used", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "total", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 6, name: "percent", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 7, name: "read_kbs", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 8, name: "write_kbs", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResourceUsage {
-    return new ResourceUsage().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DiskSample {
+    return new DiskSample().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResourceUsage {
-    return new ResourceUsage().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DiskSample {
+    return new DiskSample().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResourceUsage {
-    return new ResourceUsage().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DiskSample {
+    return new DiskSample().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ResourceUsage | PlainMessage<ResourceUsage> | undefined, b: ResourceUsage | PlainMessage<ResourceUsage> | undefined): boolean {
-    return proto3.util.equals(ResourceUsage, a, b);
+  static equals(a: DiskSample | PlainMessage<DiskSample> | undefined, b: DiskSample | PlainMessage<DiskSample> | undefined): boolean {
+    return proto3.util.equals(DiskSample, a, b);
   }
 }
 
 /**
- * @generated from message pulsar.v1.SystemInfo
+ * @generated from message pulsar.v1.IngestSystemStatsRequest
  */
-export class SystemInfo extends Message<SystemInfo> {
+export class IngestSystemStatsRequest extends Message<IngestSystemStatsRequest> {
   /**
-   * @generated from field: string hostname = 1;
+   * @generated from field: pulsar.v1.SystemSample sample = 1;
    */
-  hostname = "";
+  sample?: SystemSample;
 
-  /**
-   * @generated from field: string os = 2;
-   */
-  os = "";
+  constructor(data?: PartialMessage<IngestSystemStatsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
 
-  /**
-   * @generated from field: uint64 uptime_seconds = 3;
-   */
-  uptimeSeconds = protoInt64.zero;
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "pulsar.v1.IngestSystemStatsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sample", kind: "message", T: SystemSample },
+  ]);
 
-  /**
-   * @generated from field: string platform = 4;
-   */
-  platform = "";
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IngestSystemStatsRequest {
+    return new IngestSystemStatsRequest().fromBinary(bytes, options);
+  }
 
-  /**
-   * @generated from field: string platform_version = 5;
-   */
-  platformVersion = "";
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IngestSystemStatsRequest {
+    return new IngestSystemStatsRequest().fromJson(jsonValue, options);
+  }
 
-  constructor(data?: PartialMessage<SystemInfo>) {
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IngestSystemStatsRequest {
+    return new IngestSystemStatsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: IngestSystemStatsRequest | PlainMessage<IngestSystemStatsRequest> | undefined, b: IngestSystemStatsRequest | PlainMessage<IngestSystemStatsRequest> | undefined): boolean {
+    return proto3.util.equals(IngestSystemStatsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message pulsar.v1.IngestSystemStatsResponse
+ */
+export class IngestSystemStatsResponse extends Message<IngestSystemStatsResponse> {
+  constructor(data?: PartialMessage<IngestSystemStatsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "pulsar.v1.SystemInfo";
+  static readonly typeName = "pulsar.v1.IngestSystemStatsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "hostname", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "os", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "uptime_seconds", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 4, name: "platform", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "platform_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SystemInfo {
-    return new SystemInfo().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IngestSystemStatsResponse {
+    return new IngestSystemStatsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SystemInfo {
-    return new SystemInfo().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IngestSystemStatsResponse {
+    return new IngestSystemStatsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SystemInfo {
-    return new SystemInfo().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IngestSystemStatsResponse {
+    return new IngestSystemStatsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SystemInfo | PlainMessage<SystemInfo> | undefined, b: SystemInfo | PlainMessage<SystemInfo> | undefined): boolean {
-    return proto3.util.equals(SystemInfo, a, b);
+  static equals(a: IngestSystemStatsResponse | PlainMessage<IngestSystemStatsResponse> | undefined, b: IngestSystemStatsResponse | PlainMessage<IngestSystemStatsResponse> | undefined): boolean {
+    return proto3.util.equals(IngestSystemStatsResponse, a, b);
   }
 }
 